@@ -0,0 +1,207 @@
+// Package application holds the singletons shared by the whole process and
+// the lifecycle that starts and stops them.
+package application
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	attachmentController "github.com/vnnyx/golang-dot-api/controller/attachment"
+	authController "github.com/vnnyx/golang-dot-api/controller/auth"
+	budgetController "github.com/vnnyx/golang-dot-api/controller/budget"
+	categoryController "github.com/vnnyx/golang-dot-api/controller/category"
+	commentController "github.com/vnnyx/golang-dot-api/controller/comment"
+	disputeController "github.com/vnnyx/golang-dot-api/controller/dispute"
+	exportController "github.com/vnnyx/golang-dot-api/controller/export"
+	healthController "github.com/vnnyx/golang-dot-api/controller/health"
+	importController "github.com/vnnyx/golang-dot-api/controller/importer"
+	recurringController "github.com/vnnyx/golang-dot-api/controller/recurring"
+	reportController "github.com/vnnyx/golang-dot-api/controller/report"
+	searchController "github.com/vnnyx/golang-dot-api/controller/search"
+	statementController "github.com/vnnyx/golang-dot-api/controller/statement"
+	tagController "github.com/vnnyx/golang-dot-api/controller/tag"
+	transactionController "github.com/vnnyx/golang-dot-api/controller/transaction"
+	userController "github.com/vnnyx/golang-dot-api/controller/user"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	appMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/scheduler"
+	transactionService "github.com/vnnyx/golang-dot-api/service/transaction"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Controllers are the controllers routed by the application.
+type Controllers struct {
+	User        userController.UserController
+	Transaction transactionController.TransactionController
+	Auth        authController.AuthController
+	Category    categoryController.CategoryController
+	Tag         tagController.TagController
+	Recurring   recurringController.RecurringController
+	Attachment  attachmentController.AttachmentController
+	Comment     commentController.CommentController
+	Dispute     disputeController.DisputeController
+	Budget      budgetController.BudgetController
+	Report      reportController.ReportController
+	Export      exportController.ExportController
+	Import      importController.ImportController
+	Statement   statementController.StatementController
+	Search      searchController.SearchController
+	Health      healthController.HealthController
+}
+
+func (controllers Controllers) Route(app *echo.Echo) {
+	controllers.User.Route(app)
+	controllers.Transaction.Route(app)
+	controllers.Auth.Route(app)
+	controllers.Category.Route(app)
+	controllers.Tag.Route(app)
+	controllers.Recurring.Route(app)
+	controllers.Attachment.Route(app)
+	controllers.Comment.Route(app)
+	controllers.Dispute.Route(app)
+	controllers.Budget.Route(app)
+	controllers.Report.Route(app)
+	controllers.Export.Route(app)
+	controllers.Import.Route(app)
+	controllers.Statement.Route(app)
+	controllers.Search.Route(app)
+	controllers.Health.Route(app)
+}
+
+// Application is built once per process. Every controller, service and
+// worker shares its MySQL pool and Redis client.
+type Application struct {
+	Config             *infrastructure.Config
+	Logger             *zap.Logger
+	DB                 *gorm.DB
+	Redis              *redis.Client
+	HealthChecker      *health.Checker
+	RecurringScheduler *scheduler.RecurringScheduler
+	Echo               *echo.Echo
+	Lifecycle          *Lifecycle
+}
+
+// NewApplication routes the controllers and registers the hooks that install
+// the logger and the tracer provider globally on start, and on stop wait for
+// the budget checks still running, close the Redis client and the MySQL pool
+// and flush the buffered spans.
+func NewApplication(
+	configuration *infrastructure.Config,
+	logger *zap.Logger,
+	tracerProvider *sdktrace.TracerProvider,
+	db *gorm.DB,
+	client *redis.Client,
+	healthChecker *health.Checker,
+	recurringScheduler *scheduler.RecurringScheduler,
+	transactionService transactionService.TransactionService,
+	controllers Controllers,
+) *Application {
+	app := echo.New()
+	app.HideBanner = true
+	app.Use(appMiddleware.RequestID())
+	app.Use(appMiddleware.Tracing())
+	app.Use(appMiddleware.RequestLogger(logger))
+	app.Use(appMiddleware.Metrics())
+	app.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{DisablePrintStack: true}))
+	app.Use(middleware.CORS())
+	app.HTTPErrorHandler = exception.NewErrorHandler(configuration.ErrorFormat)
+	controllers.Route(app)
+	app.GET(appMiddleware.MetricsPath, echo.WrapHandler(promhttp.Handler()))
+
+	lifecycle := NewLifecycle()
+	lifecycle.Append(Hook{
+		Name: "telemetry",
+		OnStart: func(ctx context.Context) error {
+			zap.ReplaceGlobals(logger)
+			zap.RedirectStdLog(logger)
+			otel.SetTracerProvider(tracerProvider)
+			otel.SetTextMapPropagator(infrastructure.NewPropagator())
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			// Syncing stderr fails on some terminals, which is harmless.
+			_ = logger.Sync()
+			return tracerProvider.Shutdown(ctx)
+		},
+	})
+	lifecycle.Append(Hook{
+		Name: "mysql",
+		OnStop: func(ctx context.Context) error {
+			sqlDB, err := db.DB()
+			if err != nil {
+				return err
+			}
+			return sqlDB.Close()
+		},
+	})
+	lifecycle.Append(Hook{
+		Name: "redis",
+		OnStop: func(ctx context.Context) error {
+			return client.Close()
+		},
+	})
+	// Appended after the stores so it stops before them: the budget checks
+	// still read MySQL after the response is sent.
+	lifecycle.Append(Hook{
+		Name:   "budget checks",
+		OnStop: transactionService.WaitBudgetChecks,
+	})
+
+	return &Application{
+		Config:             configuration,
+		Logger:             logger,
+		DB:                 db,
+		Redis:              client,
+		HealthChecker:      healthChecker,
+		RecurringScheduler: recurringScheduler,
+		Echo:               app,
+		Lifecycle:          lifecycle,
+	}
+}
+
+// Start runs the start hooks of the lifecycle.
+func (application *Application) Start(ctx context.Context) error {
+	return application.Lifecycle.Start(ctx)
+}
+
+// Stop runs the stop hooks of the lifecycle within the deadline of ctx.
+func (application *Application) Stop(ctx context.Context) error {
+	return application.Lifecycle.Stop(ctx)
+}
+
+// RunRecurringScheduler registers a hook that runs the recurring scheduler in
+// the background from start, and on stop waits for the round it is running.
+// Only the server process runs it.
+func (application *Application) RunRecurringScheduler() {
+	var stopScheduler context.CancelFunc
+	schedulerDone := make(chan struct{})
+	application.Lifecycle.Append(Hook{
+		Name: "recurring scheduler",
+		OnStart: func(ctx context.Context) error {
+			schedulerCtx, cancel := context.WithCancel(context.Background())
+			stopScheduler = cancel
+			go func() {
+				defer close(schedulerDone)
+				application.RecurringScheduler.Start(schedulerCtx)
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			stopScheduler()
+			select {
+			case <-schedulerDone:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		},
+	})
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Hook is a step of the lifecycle. Either function may be nil.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+// Lifecycle starts its hooks in the order they were appended and stops them
+// in reverse order, so a hook is stopped before the ones it depends on.
+type Lifecycle struct {
+	mutex   sync.Mutex
+	hooks   []Hook
+	started int
+}
+
+func NewLifecycle() *Lifecycle {
+	return &Lifecycle{}
+}
+
+// Append adds a hook. Hooks must be appended before Start.
+func (lifecycle *Lifecycle) Append(hook Hook) {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+	lifecycle.hooks = append(lifecycle.hooks, hook)
+}
+
+// Start runs the OnStart of every hook. If one fails, the hooks already
+// started are stopped and its error is returned.
+func (lifecycle *Lifecycle) Start(ctx context.Context) error {
+	lifecycle.mutex.Lock()
+	for lifecycle.started < len(lifecycle.hooks) {
+		hook := lifecycle.hooks[lifecycle.started]
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				lifecycle.mutex.Unlock()
+				_ = lifecycle.Stop(ctx)
+				return fmt.Errorf("start %s: %w", hook.Name, err)
+			}
+		}
+		lifecycle.started++
+	}
+	lifecycle.mutex.Unlock()
+	return nil
+}
+
+// Stop runs the OnStop of every started hook, most recent first, even when
+// one of them fails, and returns the first error. Hooks are stopped once.
+func (lifecycle *Lifecycle) Stop(ctx context.Context) error {
+	lifecycle.mutex.Lock()
+	defer lifecycle.mutex.Unlock()
+
+	var firstErr error
+	for ; lifecycle.started > 0; lifecycle.started-- {
+		hook := lifecycle.hooks[lifecycle.started-1]
+		if hook.OnStop == nil {
+			continue
+		}
+		if err := hook.OnStop(ctx); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("stop %s: %w", hook.Name, err)
+		}
+	}
+	return firstErr
+}
@@ -1,33 +1,81 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	// The runtime image has no zoneinfo files, so statements, budgets and
+	// reports rely on the timezone database compiled into the binary.
+	_ "time/tzdata"
 
-	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
-	"github.com/vnnyx/golang-dot-api/exception"
 	"github.com/vnnyx/golang-dot-api/infrastructure"
 	"github.com/vnnyx/golang-dot-api/injector/wire"
 	"github.com/vnnyx/golang-dot-api/migration"
 	"github.com/vnnyx/golang-dot-api/model/entity"
+	"go.uber.org/zap"
 )
 
 func main() {
-	configuration := infrastructure.NewConfig(".env")
-	databases := infrastructure.NewMySQLDatabase(configuration)
-	migration.Migrate(databases, entity.Transaction{}, entity.User{})
-
-	userController := wire.InitializeUserController(".env")
-	transactionController := wire.InitializeTransactionController(".env")
-	authController := wire.InitializeAuthController(".env")
-
-	app := echo.New()
-	app.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{DisablePrintStack: true}))
-	app.Use(middleware.CORS())
-	app.HTTPErrorHandler = exception.ErrorHandler
-	userController.Route(app)
-	transactionController.Route(app)
-	authController.Route(app)
-	err := app.Start(fmt.Sprintf(":%v", configuration.AppPort))
-	exception.PanicIfNeeded(err)
+	app := wire.InitializeApplication(".env")
+	logger := app.Logger
+	migration.Migrate(app.DB, entity.Transaction{}, entity.User{}, entity.Category{}, entity.CategoryRule{}, entity.Tag{}, entity.RecurringTransaction{}, entity.Attachment{}, entity.TransactionSplit{}, entity.Budget{}, entity.BudgetAlert{}, entity.ExchangeRate{}, entity.Comment{}, entity.CommentRevision{}, entity.Dispute{}, entity.DisputeEvidence{})
+	migration.MigrateCommentAuthor(app.DB)
+
+	app.RunRecurringScheduler()
+	if err := app.Start(context.Background()); err != nil {
+		logger.Fatal("start", zap.Error(err))
+	}
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- app.Echo.Start(fmt.Sprintf(":%v", app.Config.AppPort))
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+	failed := false
+	select {
+	case received := <-signals:
+		logger.Info("shutting down", zap.String("signal", received.String()))
+		// Fail readiness first and give the load balancer time to notice, so
+		// no new request is sent to a server that no longer accepts them.
+		app.HealthChecker.ShutDown()
+		time.Sleep(time.Duration(app.Config.ShutdownDelaySecond) * time.Second)
+	case err := <-serverErr:
+		failed = !errors.Is(err, http.ErrServerClosed)
+		logger.Error("server stopped", zap.Error(err))
+		app.HealthChecker.ShutDown()
+	}
+	signal.Stop(signals)
+
+	// Stop accepting connections and wait for the in-flight requests.
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout(app.Config))
+	defer cancel()
+	if err := app.Echo.Shutdown(ctx); err != nil {
+		logger.Error("drain requests", zap.Error(err))
+	}
+
+	// Stop the workers within what is left of the deadline, then close the
+	// connections and flush the spans.
+	if err := app.Stop(ctx); err != nil {
+		logger.Error("stop", zap.Error(err))
+	}
+	logger.Info("shut down")
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// shutdownTimeout is the deadline to drain requests and stop the workers,
+// 30 seconds by default.
+func shutdownTimeout(configuration *infrastructure.Config) time.Duration {
+	if configuration.ShutdownTimeoutSecond <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(configuration.ShutdownTimeoutSecond) * time.Second
 }
@@ -0,0 +1,57 @@
+// Command exchange-rates loads daily exchange rates from a CSV file or an
+// ECB euro reference rate XML file, for example:
+//
+//	go run ./cmd/exchange-rates -file eurofxref-hist.xml
+//	go run ./cmd/exchange-rates -file rates.csv
+//
+// Rates already loaded for the same day and currency pair are replaced.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/injector/wire"
+	"github.com/vnnyx/golang-dot-api/migration"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+func main() {
+	path := flag.String("file", "", "CSV or ECB XML file to load")
+	format := flag.String("format", "", "csv or ecb, guessed from the file extension when empty")
+	configName := flag.String("config", ".env", "name of the config file")
+	flag.Parse()
+	if *path == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = model.RateFormatCSV
+		if strings.EqualFold(filepath.Ext(*path), ".xml") {
+			*format = model.RateFormatECB
+		}
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	configuration := infrastructure.NewConfig(*configName)
+	databases := infrastructure.NewMySQLDatabase(configuration, infrastructure.NewLogger(configuration))
+	migration.Migrate(databases, entity.ExchangeRate{})
+
+	exchangeRateService := wire.InitializeExchangeRateService(databases)
+	imported, err := exchangeRateService.ImportExchangeRates(context.Background(), *format, file)
+	if err != nil {
+		log.Fatalf("load %s: %v", *path, err)
+	}
+	log.Printf("loaded %d exchange rates from %s", imported, *path)
+}
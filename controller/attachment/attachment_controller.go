@@ -0,0 +1,11 @@
+package attachment
+
+import "github.com/labstack/echo/v4"
+
+type AttachmentController interface {
+	Route(e *echo.Echo)
+	UploadAttachment(c echo.Context) error
+	GetAttachmentByTransactionId(c echo.Context) error
+	DownloadAttachment(c echo.Context) error
+	RemoveAttachment(c echo.Context) error
+}
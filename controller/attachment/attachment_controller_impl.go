@@ -0,0 +1,105 @@
+package attachment
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/attachment"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type AttachmentControllerImpl struct {
+	attachment.AttachmentService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewAttachmentController(attachmentService attachment.AttachmentService, authMiddleware *authMiddleware.AuthMiddleware) AttachmentController {
+	return &AttachmentControllerImpl{AttachmentService: attachmentService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *AttachmentControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/transaction/:id/attachments", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.UploadAttachment)
+	api.GET("", controller.GetAttachmentByTransactionId)
+	api.GET("/:attachmentId", controller.DownloadAttachment)
+	api.DELETE("/:attachmentId", controller.RemoveAttachment)
+}
+
+func (controller *AttachmentControllerImpl) UploadAttachment(c echo.Context) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		exception.PanicIfNeeded(validation.FieldError("file", validator.ErrRequired))
+	}
+	file, err := fileHeader.Open()
+	exception.PanicIfNeeded(err)
+	defer file.Close()
+
+	request := web.AttachmentUploadRequest{
+		TransactionID: c.Param("id"),
+		UserID:        c.Get("currentId").(string),
+		FileName:      fileHeader.Filename,
+		Size:          fileHeader.Size,
+		Content:       file,
+	}
+	response, err := controller.AttachmentService.UploadAttachment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *AttachmentControllerImpl) GetAttachmentByTransactionId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	transactionId := c.Param("id")
+
+	response, err := controller.AttachmentService.GetAttachmentByTransactionId(c.Request().Context(), userId, transactionId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *AttachmentControllerImpl) DownloadAttachment(c echo.Context) error {
+	request := web.AttachmentRequest{
+		TransactionID: c.Param("id"),
+		AttachmentID:  c.Param("attachmentId"),
+		UserID:        c.Get("currentId").(string),
+	}
+
+	response, err := controller.AttachmentService.DownloadAttachment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+	defer response.Content.Close()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": response.FileName}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(response.Size, 10))
+	return c.Stream(http.StatusOK, response.ContentType, response.Content)
+}
+
+func (controller *AttachmentControllerImpl) RemoveAttachment(c echo.Context) error {
+	request := web.AttachmentRequest{
+		TransactionID: c.Param("id"),
+		AttachmentID:  c.Param("attachmentId"),
+		UserID:        c.Get("currentId").(string),
+	}
+
+	err := controller.AttachmentService.RemoveAttachment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
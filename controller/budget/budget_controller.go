@@ -0,0 +1,12 @@
+package budget
+
+import "github.com/labstack/echo/v4"
+
+type BudgetController interface {
+	Route(e *echo.Echo)
+	CreateBudget(c echo.Context) error
+	GetBudgetById(c echo.Context) error
+	GetBudgetByUserId(c echo.Context) error
+	UpdateBudget(c echo.Context) error
+	RemoveBudget(c echo.Context) error
+}
@@ -0,0 +1,108 @@
+package budget
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/budget"
+)
+
+type BudgetControllerImpl struct {
+	budget.BudgetService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewBudgetController(budgetService budget.BudgetService, authMiddleware *authMiddleware.AuthMiddleware) BudgetController {
+	return &BudgetControllerImpl{BudgetService: budgetService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *BudgetControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/budget", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.CreateBudget)
+	api.GET("", controller.GetBudgetByUserId)
+	api.GET("/:id", controller.GetBudgetById)
+	api.PUT("/:id", controller.UpdateBudget)
+	api.DELETE("/:id", controller.RemoveBudget)
+}
+
+func (controller *BudgetControllerImpl) CreateBudget(c echo.Context) error {
+	var request web.BudgetCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.BudgetService.CreateBudget(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *BudgetControllerImpl) GetBudgetById(c echo.Context) error {
+	request := web.BudgetRequest{
+		BudgetID: c.Param("id"),
+		UserID:   c.Get("currentId").(string),
+		Period:   c.QueryParam("period"),
+	}
+
+	response, err := controller.BudgetService.GetBudgetById(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *BudgetControllerImpl) GetBudgetByUserId(c echo.Context) error {
+	request := web.BudgetRequest{
+		UserID: c.Get("currentId").(string),
+		Period: c.QueryParam("period"),
+	}
+
+	response, err := controller.BudgetService.GetBudgetByUserId(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *BudgetControllerImpl) UpdateBudget(c echo.Context) error {
+	var request web.BudgetUpdateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.BudgetID = c.Param("id")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.BudgetService.UpdateBudget(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *BudgetControllerImpl) RemoveBudget(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	budgetId := c.Param("id")
+
+	err := controller.BudgetService.RemoveBudget(c.Request().Context(), userId, budgetId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
@@ -0,0 +1,15 @@
+package category
+
+import "github.com/labstack/echo/v4"
+
+type CategoryController interface {
+	Route(e *echo.Echo)
+	CreateCategory(c echo.Context) error
+	GetCategoryById(c echo.Context) error
+	GetCategoryByUserId(c echo.Context) error
+	UpdateCategory(c echo.Context) error
+	RemoveCategory(c echo.Context) error
+	CreateCategoryRule(c echo.Context) error
+	GetCategoryRuleByUserId(c echo.Context) error
+	RemoveCategoryRule(c echo.Context) error
+}
@@ -0,0 +1,147 @@
+package category
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/category"
+)
+
+type CategoryControllerImpl struct {
+	category.CategoryService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewCategoryController(categoryService category.CategoryService, authMiddleware *authMiddleware.AuthMiddleware) CategoryController {
+	return &CategoryControllerImpl{CategoryService: categoryService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *CategoryControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/category", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.CreateCategory)
+	api.GET("", controller.GetCategoryByUserId)
+	api.POST("/rule", controller.CreateCategoryRule)
+	api.GET("/rule", controller.GetCategoryRuleByUserId)
+	api.DELETE("/rule/:id", controller.RemoveCategoryRule)
+	api.GET("/:id", controller.GetCategoryById)
+	api.PUT("/:id", controller.UpdateCategory)
+	api.DELETE("/:id", controller.RemoveCategory)
+}
+
+func (controller *CategoryControllerImpl) CreateCategory(c echo.Context) error {
+	var request web.CategoryCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.CategoryService.CreateCategory(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) GetCategoryById(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	categoryId := c.Param("id")
+
+	response, err := controller.CategoryService.GetCategoryById(c.Request().Context(), userId, categoryId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) GetCategoryByUserId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+
+	response, err := controller.CategoryService.GetCategoryByUserId(c.Request().Context(), userId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) UpdateCategory(c echo.Context) error {
+	var request web.CategoryUpdateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.CategoryID = c.Param("id")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.CategoryService.UpdateCategory(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) RemoveCategory(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	categoryId := c.Param("id")
+
+	err := controller.CategoryService.RemoveCategory(c.Request().Context(), userId, categoryId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
+
+func (controller *CategoryControllerImpl) CreateCategoryRule(c echo.Context) error {
+	var request web.CategoryRuleCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.CategoryService.CreateCategoryRule(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) GetCategoryRuleByUserId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+
+	response, err := controller.CategoryService.GetCategoryRuleByUserId(c.Request().Context(), userId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CategoryControllerImpl) RemoveCategoryRule(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	ruleId := c.Param("id")
+
+	err := controller.CategoryService.RemoveCategoryRule(c.Request().Context(), userId, ruleId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
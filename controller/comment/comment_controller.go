@@ -0,0 +1,12 @@
+package comment
+
+import "github.com/labstack/echo/v4"
+
+type CommentController interface {
+	Route(e *echo.Echo)
+	CreateComment(c echo.Context) error
+	GetCommentByTransactionId(c echo.Context) error
+	GetCommentById(c echo.Context) error
+	UpdateComment(c echo.Context) error
+	RemoveComment(c echo.Context) error
+}
@@ -0,0 +1,111 @@
+package comment
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/comment"
+)
+
+type CommentControllerImpl struct {
+	comment.CommentService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewCommentController(commentService comment.CommentService, authMiddleware *authMiddleware.AuthMiddleware) CommentController {
+	return &CommentControllerImpl{CommentService: commentService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *CommentControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/transaction/:id/comments", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.CreateComment)
+	api.GET("", controller.GetCommentByTransactionId)
+	api.GET("/:commentId", controller.GetCommentById)
+	api.PUT("/:commentId", controller.UpdateComment)
+	api.DELETE("/:commentId", controller.RemoveComment)
+}
+
+func (controller *CommentControllerImpl) CreateComment(c echo.Context) error {
+	var request web.CommentRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.TransactionID = c.Param("id")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.CommentService.CreateComment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *CommentControllerImpl) GetCommentByTransactionId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	transactionId := c.Param("id")
+
+	response, err := controller.CommentService.GetCommentByTransactionId(c.Request().Context(), userId, transactionId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CommentControllerImpl) GetCommentById(c echo.Context) error {
+	request := web.CommentRequest{
+		TransactionID: c.Param("id"),
+		CommentID:     c.Param("commentId"),
+		UserID:        c.Get("currentId").(string),
+	}
+
+	response, err := controller.CommentService.GetCommentById(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CommentControllerImpl) UpdateComment(c echo.Context) error {
+	var request web.CommentRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.TransactionID = c.Param("id")
+	request.CommentID = c.Param("commentId")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.CommentService.UpdateComment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *CommentControllerImpl) RemoveComment(c echo.Context) error {
+	request := web.CommentRequest{
+		TransactionID: c.Param("id"),
+		CommentID:     c.Param("commentId"),
+		UserID:        c.Get("currentId").(string),
+	}
+
+	err := controller.CommentService.RemoveComment(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
@@ -0,0 +1,14 @@
+package dispute
+
+import "github.com/labstack/echo/v4"
+
+type DisputeController interface {
+	Route(e *echo.Echo)
+	OpenDispute(c echo.Context) error
+	GetDisputeByTransactionId(c echo.Context) error
+	GetDisputeById(c echo.Context) error
+	AddDisputeEvidence(c echo.Context) error
+	GetAllDispute(c echo.Context) error
+	ReviewDispute(c echo.Context) error
+	ResolveDispute(c echo.Context) error
+}
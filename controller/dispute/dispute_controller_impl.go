@@ -0,0 +1,151 @@
+package dispute
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/dispute"
+)
+
+type DisputeControllerImpl struct {
+	dispute.DisputeService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewDisputeController(disputeService dispute.DisputeService, authMiddleware *authMiddleware.AuthMiddleware) DisputeController {
+	return &DisputeControllerImpl{DisputeService: disputeService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *DisputeControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/transaction/:id/disputes", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.OpenDispute)
+	api.GET("", controller.GetDisputeByTransactionId)
+	api.GET("/:disputeId", controller.GetDisputeById)
+	api.POST("/:disputeId/evidence", controller.AddDisputeEvidence)
+
+	admin := e.Group("/dot-api/admin/disputes", controller.AuthMiddleware.CheckToken)
+	admin.GET("", controller.GetAllDispute)
+	admin.POST("/:disputeId/review", controller.ReviewDispute)
+	admin.POST("/:disputeId/resolve", controller.ResolveDispute)
+}
+
+func (controller *DisputeControllerImpl) OpenDispute(c echo.Context) error {
+	var request web.DisputeCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.TransactionID = c.Param("id")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.DisputeService.OpenDispute(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) GetDisputeByTransactionId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	transactionId := c.Param("id")
+
+	response, err := controller.DisputeService.GetDisputeByTransactionId(c.Request().Context(), userId, transactionId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) GetDisputeById(c echo.Context) error {
+	request := web.DisputeRequest{
+		TransactionID: c.Param("id"),
+		DisputeID:     c.Param("disputeId"),
+		UserID:        c.Get("currentId").(string),
+	}
+
+	response, err := controller.DisputeService.GetDisputeById(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) AddDisputeEvidence(c echo.Context) error {
+	var request web.DisputeEvidenceRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.TransactionID = c.Param("id")
+	request.DisputeID = c.Param("disputeId")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.DisputeService.AddDisputeEvidence(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) GetAllDispute(c echo.Context) error {
+	request := web.DisputeListRequest{
+		UserID: c.Get("currentId").(string),
+	}
+	if status := c.QueryParam("status"); status != "" {
+		request.Status = strings.Split(status, ",")
+	}
+
+	response, err := controller.DisputeService.GetAllDispute(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) ReviewDispute(c echo.Context) error {
+	request := web.DisputeRequest{
+		DisputeID: c.Param("disputeId"),
+		UserID:    c.Get("currentId").(string),
+	}
+
+	response, err := controller.DisputeService.ReviewDispute(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *DisputeControllerImpl) ResolveDispute(c echo.Context) error {
+	var request web.DisputeResolveRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.DisputeID = c.Param("disputeId")
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.DisputeService.ResolveDispute(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,9 @@
+package export
+
+import "github.com/labstack/echo/v4"
+
+type ExportController interface {
+	Route(e *echo.Echo)
+	ExportTransaction(c echo.Context) error
+	ExportUser(c echo.Context) error
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/export"
+)
+
+type ExportControllerImpl struct {
+	export.ExportService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewExportController(exportService export.ExportService, authMiddleware *authMiddleware.AuthMiddleware) ExportController {
+	return &ExportControllerImpl{ExportService: exportService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *ExportControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/export", controller.AuthMiddleware.CheckToken)
+	api.GET("/transactions", controller.ExportTransaction)
+	api.GET("/users", controller.ExportUser)
+}
+
+func (controller *ExportControllerImpl) ExportTransaction(c echo.Context) error {
+	request := web.TransactionExportRequest{
+		TransactionListRequest: web.TransactionListRequest{
+			UserID:     c.QueryParam("user_id"),
+			CategoryID: c.QueryParam("category_id"),
+			Tag:        c.QueryParam("tag"),
+		},
+		Format:  c.QueryParam("format"),
+		Columns: splitColumns(c.QueryParam("columns")),
+	}
+
+	response, err := controller.ExportService.ExportTransaction(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return writeExport(c, response)
+}
+
+func (controller *ExportControllerImpl) ExportUser(c echo.Context) error {
+	request := web.UserExportRequest{
+		Format:  c.QueryParam("format"),
+		Columns: splitColumns(c.QueryParam("columns")),
+	}
+
+	response, err := controller.ExportService.ExportUser(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return writeExport(c, response)
+}
+
+// writeExport streams the file to the client. Once the first bytes are sent
+// the status can no longer change, so later errors only end the response.
+func writeExport(c echo.Context, file web.ExportFile) error {
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}))
+	header.Set(echo.HeaderContentType, file.ContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	return file.Write(c.Response())
+}
+
+func splitColumns(columns string) []string {
+	if columns == "" {
+		return nil
+	}
+	return strings.Split(columns, ",")
+}
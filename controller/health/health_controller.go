@@ -0,0 +1,9 @@
+package health
+
+import "github.com/labstack/echo/v4"
+
+type HealthController interface {
+	Route(e *echo.Echo)
+	Liveness(c echo.Context) error
+	Readiness(c echo.Context) error
+}
@@ -0,0 +1,51 @@
+package health
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type HealthControllerImpl struct {
+	*health.Checker
+}
+
+func NewHealthController(checker *health.Checker) HealthController {
+	return &HealthControllerImpl{Checker: checker}
+}
+
+func (controller *HealthControllerImpl) Route(e *echo.Echo) {
+	e.GET("/healthz", controller.Liveness)
+	e.GET("/readyz", controller.Readiness)
+}
+
+// Liveness reports that the process can serve requests. It does not check
+// dependencies, so an outage of MySQL or Redis does not get the container
+// restarted.
+func (controller *HealthControllerImpl) Liveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   web.HealthResponse{Status: web.HealthStatusOK},
+	})
+}
+
+// Readiness checks the dependencies and responds with 503 when a critical
+// one is down, so the load balancer stops sending traffic.
+func (controller *HealthControllerImpl) Readiness(c echo.Context) error {
+	response := controller.Checker.Check(c.Request().Context())
+	if response.Status == web.HealthStatusUnavailable {
+		return c.JSON(http.StatusServiceUnavailable, web.WebResponse{
+			Code:   http.StatusServiceUnavailable,
+			Status: web.SERVICE_UNAVAILABLE,
+			Data:   response,
+		})
+	}
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,8 @@
+package importer
+
+import "github.com/labstack/echo/v4"
+
+type ImportController interface {
+	Route(e *echo.Echo)
+	ImportTransaction(c echo.Context) error
+}
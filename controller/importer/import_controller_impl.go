@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"encoding/json"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/importer"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const maxStatementSize = 5 << 20
+
+type ImportControllerImpl struct {
+	importer.ImportService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewImportController(importService importer.ImportService, authMiddleware *authMiddleware.AuthMiddleware) ImportController {
+	return &ImportControllerImpl{ImportService: importService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *ImportControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/import", controller.AuthMiddleware.CheckToken)
+	api.POST("/transactions", controller.ImportTransaction)
+}
+
+func (controller *ImportControllerImpl) ImportTransaction(c echo.Context) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		exception.PanicIfNeeded(validation.FieldError("file", validator.ErrRequired))
+	}
+	if fileHeader.Size > maxStatementSize {
+		exception.PanicIfNeeded(validation.FieldError("file", validation.ErrFileTooLarge.SetParams(map[string]interface{}{"max": maxStatementSize >> 20})))
+	}
+	file, err := fileHeader.Open()
+	exception.PanicIfNeeded(err)
+	defer file.Close()
+
+	request := web.ImportRequest{
+		UserID:     c.Get("currentId").(string),
+		Format:     strings.ToLower(c.FormValue("format")),
+		Content:    file,
+		DateFormat: c.FormValue("date_format"),
+	}
+	if request.Format == "" {
+		request.Format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileHeader.Filename)), ".")
+	}
+	if mapping := c.FormValue("mapping"); mapping != "" {
+		if json.Unmarshal([]byte(mapping), &request.Mapping) != nil {
+			exception.PanicIfNeeded(validation.FieldError("mapping", validation.ErrJSONObject))
+		}
+	}
+	request.DryRun, _ = strconv.ParseBool(c.FormValue("dry_run"))
+
+	response, err := controller.ImportService.ImportTransaction(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	code, status := http.StatusCreated, web.CREATED
+	if request.DryRun {
+		code, status = http.StatusOK, web.OK
+	}
+	return c.JSON(code, web.WebResponse{
+		Code:   code,
+		Status: status,
+		Data:   response,
+	})
+}
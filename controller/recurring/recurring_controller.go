@@ -0,0 +1,11 @@
+package recurring
+
+import "github.com/labstack/echo/v4"
+
+type RecurringController interface {
+	Route(e *echo.Echo)
+	CreateRecurring(c echo.Context) error
+	GetRecurringById(c echo.Context) error
+	GetRecurringByUserId(c echo.Context) error
+	RemoveRecurring(c echo.Context) error
+}
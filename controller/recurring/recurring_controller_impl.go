@@ -0,0 +1,84 @@
+package recurring
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/recurring"
+)
+
+type RecurringControllerImpl struct {
+	recurring.RecurringService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewRecurringController(recurringService recurring.RecurringService, authMiddleware *authMiddleware.AuthMiddleware) RecurringController {
+	return &RecurringControllerImpl{RecurringService: recurringService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *RecurringControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/recurring", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.CreateRecurring)
+	api.GET("", controller.GetRecurringByUserId)
+	api.GET("/:id", controller.GetRecurringById)
+	api.DELETE("/:id", controller.RemoveRecurring)
+}
+
+func (controller *RecurringControllerImpl) CreateRecurring(c echo.Context) error {
+	var request web.RecurringCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.RecurringService.CreateRecurring(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *RecurringControllerImpl) GetRecurringById(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	recurringId := c.Param("id")
+
+	response, err := controller.RecurringService.GetRecurringById(c.Request().Context(), userId, recurringId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *RecurringControllerImpl) GetRecurringByUserId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+
+	response, err := controller.RecurringService.GetRecurringByUserId(c.Request().Context(), userId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *RecurringControllerImpl) RemoveRecurring(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	recurringId := c.Param("id")
+
+	err := controller.RecurringService.RemoveRecurring(c.Request().Context(), userId, recurringId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
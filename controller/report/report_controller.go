@@ -0,0 +1,8 @@
+package report
+
+import "github.com/labstack/echo/v4"
+
+type ReportController interface {
+	Route(e *echo.Echo)
+	GetReport(c echo.Context) error
+}
@@ -0,0 +1,48 @@
+package report
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/report"
+)
+
+type ReportControllerImpl struct {
+	report.ReportService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewReportController(reportService report.ReportService, authMiddleware *authMiddleware.AuthMiddleware) ReportController {
+	return &ReportControllerImpl{ReportService: reportService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *ReportControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/reports", controller.AuthMiddleware.CheckToken)
+	api.GET("", controller.GetReport)
+}
+
+func (controller *ReportControllerImpl) GetReport(c echo.Context) error {
+	request := web.ReportRequest{
+		UserID:   c.Get("currentId").(string),
+		From:     c.QueryParam("from"),
+		To:       c.QueryParam("to"),
+		Timezone: c.QueryParam("timezone"),
+		Currency: c.QueryParam("currency"),
+	}
+	if groupBy := c.QueryParam("group_by"); groupBy != "" {
+		request.GroupBy = strings.Split(groupBy, ",")
+	}
+
+	response, err := controller.ReportService.GetReport(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
@@ -0,0 +1,8 @@
+package search
+
+import "github.com/labstack/echo/v4"
+
+type SearchController interface {
+	Route(e *echo.Echo)
+	Search(c echo.Context) error
+}
@@ -0,0 +1,54 @@
+package search
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/search"
+)
+
+type SearchControllerImpl struct {
+	search.SearchService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewSearchController(searchService search.SearchService, authMiddleware *authMiddleware.AuthMiddleware) SearchController {
+	return &SearchControllerImpl{SearchService: searchService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *SearchControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/search", controller.AuthMiddleware.CheckToken)
+	api.GET("", controller.Search)
+}
+
+func (controller *SearchControllerImpl) Search(c echo.Context) error {
+	request := web.SearchRequest{
+		UserID: c.Get("currentId").(string),
+		Query:  c.QueryParam("q"),
+	}
+	if types := c.QueryParam("type"); types != "" {
+		request.Types = strings.Split(types, ",")
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		var err error
+		request.Limit, err = strconv.Atoi(limit)
+		if err != nil {
+			// Out of range, so validation reports it like any bad limit.
+			request.Limit = -1
+		}
+	}
+
+	response, err := controller.SearchService.Search(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
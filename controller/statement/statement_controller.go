@@ -0,0 +1,8 @@
+package statement
+
+import "github.com/labstack/echo/v4"
+
+type StatementController interface {
+	Route(e *echo.Echo)
+	GetMonthlyStatement(c echo.Context) error
+}
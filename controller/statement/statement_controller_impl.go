@@ -0,0 +1,44 @@
+package statement
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/statement"
+)
+
+type StatementControllerImpl struct {
+	statement.StatementService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewStatementController(statementService statement.StatementService, authMiddleware *authMiddleware.AuthMiddleware) StatementController {
+	return &StatementControllerImpl{StatementService: statementService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *StatementControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/statement", controller.AuthMiddleware.CheckToken)
+	api.GET("/:period", controller.GetMonthlyStatement)
+}
+
+func (controller *StatementControllerImpl) GetMonthlyStatement(c echo.Context) error {
+	request := web.StatementRequest{
+		UserID:   c.Get("currentId").(string),
+		Period:   c.Param("period"),
+		Timezone: c.QueryParam("timezone"),
+		Currency: c.QueryParam("currency"),
+	}
+
+	response, err := controller.StatementService.GetMonthlyStatement(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": response.FileName}))
+	header.Set(echo.HeaderContentType, response.ContentType)
+	c.Response().WriteHeader(http.StatusOK)
+	return response.Write(c.Response())
+}
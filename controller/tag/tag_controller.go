@@ -0,0 +1,10 @@
+package tag
+
+import "github.com/labstack/echo/v4"
+
+type TagController interface {
+	Route(e *echo.Echo)
+	CreateTag(c echo.Context) error
+	GetTagByUserId(c echo.Context) error
+	RemoveTag(c echo.Context) error
+}
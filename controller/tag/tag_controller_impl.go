@@ -0,0 +1,69 @@
+package tag
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/tag"
+)
+
+type TagControllerImpl struct {
+	tag.TagService
+	*authMiddleware.AuthMiddleware
+}
+
+func NewTagController(tagService tag.TagService, authMiddleware *authMiddleware.AuthMiddleware) TagController {
+	return &TagControllerImpl{TagService: tagService, AuthMiddleware: authMiddleware}
+}
+
+func (controller *TagControllerImpl) Route(e *echo.Echo) {
+	api := e.Group("/dot-api/tag", controller.AuthMiddleware.CheckToken)
+	api.POST("", controller.CreateTag)
+	api.GET("", controller.GetTagByUserId)
+	api.DELETE("/:id", controller.RemoveTag)
+}
+
+func (controller *TagControllerImpl) CreateTag(c echo.Context) error {
+	var request web.TagCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.Get("currentId").(string)
+	response, err := controller.TagService.CreateTag(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusCreated, web.WebResponse{
+		Code:   http.StatusCreated,
+		Status: web.CREATED,
+		Data:   response,
+	})
+}
+
+func (controller *TagControllerImpl) GetTagByUserId(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+
+	response, err := controller.TagService.GetTagByUserId(c.Request().Context(), userId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
+func (controller *TagControllerImpl) RemoveTag(c echo.Context) error {
+	userId := c.Get("currentId").(string)
+	tagId := c.Param("id")
+
+	err := controller.TagService.RemoveTag(c.Request().Context(), userId, tagId)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+	})
+}
@@ -10,4 +10,7 @@ type TransactionController interface {
 	GetTransactionByUserId(c echo.Context) error
 	UpdateTransaction(c echo.Context) error
 	RemoveTransaction(c echo.Context) error
+	CreateTransactionBatch(c echo.Context) error
+	UpdateTransactionBatch(c echo.Context) error
+	RemoveTransactionBatch(c echo.Context) error
 }
@@ -1,6 +1,7 @@
 package transaction
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -27,6 +28,9 @@ func (controller *TransactionControllerImpl) Route(e *echo.Echo) {
 	api.GET("/user", controller.GetTransactionByUserId)
 	api.PATCH("/:id", controller.UpdateTransaction)
 	api.DELETE("/:id", controller.RemoveTransaction)
+	api.POST("/batch", controller.CreateTransactionBatch)
+	api.PUT("/batch", controller.UpdateTransactionBatch)
+	api.DELETE("/batch", controller.RemoveTransactionBatch)
 }
 
 func (controller *TransactionControllerImpl) CreateTransaction(c echo.Context) error {
@@ -70,9 +74,13 @@ func (controller *TransactionControllerImpl) GetAllTransaction(c echo.Context) e
 }
 
 func (controller *TransactionControllerImpl) GetTransactionByUserId(c echo.Context) error {
-	userId := c.QueryParam("user_id")
+	request := web.TransactionListRequest{
+		UserID:     c.QueryParam("user_id"),
+		CategoryID: c.QueryParam("category_id"),
+		Tag:        c.QueryParam("tag"),
+	}
 
-	response, err := controller.TransactionService.GetTransactionByUserId(c.Request().Context(), userId)
+	response, err := controller.TransactionService.GetTransactionByUserId(c.Request().Context(), request)
 	exception.PanicIfNeeded(err)
 
 	return c.JSON(http.StatusOK, web.WebResponse{
@@ -83,12 +91,15 @@ func (controller *TransactionControllerImpl) GetTransactionByUserId(c echo.Conte
 }
 
 func (controller *TransactionControllerImpl) UpdateTransaction(c echo.Context) error {
-	var request web.TransactionUpdateRequest
-	err := c.Bind(&request)
+	patch, err := io.ReadAll(c.Request().Body)
 	exception.PanicIfNeeded(err)
 
-	request.TransactionID = c.Param("id")
-	response, err := controller.TransactionService.UpdateTransaction(c.Request().Context(), request)
+	request := web.PatchRequest{
+		ID:          c.Param("id"),
+		ContentType: c.Request().Header.Get(echo.HeaderContentType),
+		Patch:       patch,
+	}
+	response, err := controller.TransactionService.PatchTransaction(c.Request().Context(), request)
 	exception.PanicIfNeeded(err)
 
 	return c.JSON(http.StatusOK, web.WebResponse{
@@ -109,3 +120,50 @@ func (controller *TransactionControllerImpl) RemoveTransaction(c echo.Context) e
 		Status: web.OK,
 	})
 }
+
+func (controller *TransactionControllerImpl) CreateTransactionBatch(c echo.Context) error {
+	var request web.TransactionBatchCreateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	request.UserID = c.QueryParam("user_id")
+	response, err := controller.TransactionService.CreateTransactionBatch(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return batchJSON(c, http.StatusCreated, web.CREATED, response)
+}
+
+func (controller *TransactionControllerImpl) UpdateTransactionBatch(c echo.Context) error {
+	var request web.TransactionBatchUpdateRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	response, err := controller.TransactionService.UpdateTransactionBatch(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return batchJSON(c, http.StatusOK, web.OK, response)
+}
+
+func (controller *TransactionControllerImpl) RemoveTransactionBatch(c echo.Context) error {
+	var request web.TransactionBatchDeleteRequest
+	err := c.Bind(&request)
+	exception.PanicIfNeeded(err)
+
+	response, err := controller.TransactionService.RemoveTransactionBatch(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return batchJSON(c, http.StatusOK, web.OK, response)
+}
+
+// batchJSON responds with code when at least one item succeeded, and with
+// 400 when nothing was saved because items failed.
+func batchJSON(c echo.Context, code int, status string, response web.TransactionBatchResponse) error {
+	if response.Succeeded == 0 && response.Failed > 0 {
+		code, status = http.StatusBadRequest, web.BAD_REQUEST
+	}
+	return c.JSON(code, web.WebResponse{
+		Code:   code,
+		Status: status,
+		Data:   response,
+	})
+}
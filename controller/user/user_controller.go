@@ -8,5 +8,6 @@ type UserController interface {
 	GetUserById(c echo.Context) error
 	GetAllUser(c echo.Context) error
 	UpdateUserProfile(c echo.Context) error
+	PatchUserProfile(c echo.Context) error
 	RemoveUser(c echo.Context) error
 }
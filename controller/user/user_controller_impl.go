@@ -1,6 +1,7 @@
 package user
 
 import (
+	"io"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -25,6 +26,7 @@ func (controller *UserControllerImpl) Route(e *echo.Echo) {
 	api.GET("/:id", controller.GetUserById)
 	api.GET("", controller.GetAllUser)
 	api.PUT("/:id", controller.UpdateUserProfile, controller.AuthMiddleware.CheckToken)
+	api.PATCH("/:id", controller.PatchUserProfile, controller.AuthMiddleware.CheckToken)
 	api.DELETE("/:id", controller.RemoveUser)
 }
 
@@ -83,6 +85,25 @@ func (controller *UserControllerImpl) UpdateUserProfile(c echo.Context) error {
 	})
 }
 
+func (controller *UserControllerImpl) PatchUserProfile(c echo.Context) error {
+	patch, err := io.ReadAll(c.Request().Body)
+	exception.PanicIfNeeded(err)
+
+	request := web.PatchRequest{
+		ID:          c.Param("id"),
+		ContentType: c.Request().Header.Get(echo.HeaderContentType),
+		Patch:       patch,
+	}
+	response, err := controller.UserService.PatchUserProfile(c.Request().Context(), request)
+	exception.PanicIfNeeded(err)
+
+	return c.JSON(http.StatusOK, web.WebResponse{
+		Code:   http.StatusOK,
+		Status: web.OK,
+		Data:   response,
+	})
+}
+
 func (controller *UserControllerImpl) RemoveUser(c echo.Context) error {
 	userId := c.Param("id")
 
@@ -0,0 +1,56 @@
+// Package apperror defines the typed errors services return to callers.
+// Every error carries a stable code, the HTTP status and the request field it
+// is about, so exception.ErrorHandler can respond to errors it has never
+// seen before without a case of its own.
+package apperror
+
+import "net/http"
+
+// NotFoundMessage is the message of every NOT_FOUND error.
+const NotFoundMessage = "NOT_FOUND"
+
+// Error is a domain error. Errors with the same Code are the same error to
+// errors.Is, so services may declare their own sentinel for a code another
+// service also returns.
+type Error struct {
+	Code    string
+	Status  int
+	Field   string
+	Message string
+	Cause   error
+}
+
+// New returns an error with code that responds with status and reports
+// message for field.
+func New(status int, code string, field string, message string) *Error {
+	return &Error{Code: code, Status: status, Field: field, Message: message}
+}
+
+// NotFound returns a 404 error reporting field as NOT_FOUND.
+func NotFound(code string, field string) *Error {
+	return New(http.StatusNotFound, code, field, NotFoundMessage)
+}
+
+func (err *Error) Error() string {
+	if err.Cause != nil {
+		return err.Code + ": " + err.Cause.Error()
+	}
+	return err.Code
+}
+
+func (err *Error) Unwrap() error {
+	return err.Cause
+}
+
+func (err *Error) Is(target error) bool {
+	other, ok := target.(*Error)
+	return ok && other.Code == err.Code
+}
+
+// Wrap returns a copy of err caused by cause. The copy is still err to
+// errors.Is, and cause stays reachable through errors.Is and errors.As.
+func (err *Error) Wrap(cause error) *Error {
+	wrapped := *err
+	wrapped.Cause = cause
+	return &wrapped
+}
@@ -2,140 +2,265 @@ package exception
 
 import (
 	"encoding/json"
+	"errors"
+	"mime"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+	"github.com/vnnyx/golang-dot-api/i18n"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	"go.uber.org/zap"
 )
 
+const (
+	// FormatDefault responds with web.WebResponse unless the request accepts
+	// application/problem+json.
+	FormatDefault = "default"
+	// FormatProblem responds with application/problem+json to every request.
+	FormatProblem = "problem"
+
+	MIMEApplicationProblemJSON = "application/problem+json"
+)
+
+// statusNames are the web.WebResponse statuses of the HTTP statuses errors
+// respond with.
+var statusNames = map[int]string{
+	http.StatusBadRequest:           web.BAD_REQUEST,
+	http.StatusUnauthorized:         web.UNAUTHORIZATION,
+	http.StatusForbidden:            web.FORBIDDEN,
+	http.StatusNotFound:             web.NOT_FOUND,
+	http.StatusMethodNotAllowed:     web.METHOD_NOT_ALLOWED,
+	http.StatusConflict:             web.CONFLICT,
+	http.StatusUnsupportedMediaType: web.UNSUPPORTED_MEDIA,
+	http.StatusInternalServerError:  web.SERVER_ERROR,
+}
+
+// errorResult is what an error responds with, whatever the response format.
+// errors holds the message of each request field, or of "message" when the
+// error is about the request as a whole.
+type errorResult struct {
+	status int
+	code   string
+	errors map[string]interface{}
+}
+
+// ErrorHandler responds to errors in the default format.
 func ErrorHandler(err error, ctx echo.Context) {
-	if databaseError(err, ctx) {
+	handleError(err, ctx, false)
+}
+
+// NewErrorHandler returns the error handler of format, FormatDefault or
+// FormatProblem.
+func NewErrorHandler(format string) echo.HTTPErrorHandler {
+	problem := format == FormatProblem
+	return func(err error, ctx echo.Context) {
+		handleError(err, ctx, problem)
+	}
+}
+
+func handleError(err error, ctx echo.Context, problem bool) {
+	locale := requestLocale(ctx)
+	result, ok := databaseError(err, ctx, locale)
+	if !ok {
+		result, ok = validationError(err, locale)
+	}
+	if !ok {
+		result, ok = domainError(err, ctx, locale)
+	}
+	if !ok {
+		result, ok = httpError(err, ctx, locale)
+	}
+	if !ok {
+		logError(err, ctx)
+		result = internalError(locale)
+	}
+
+	ctx.Response().Header().Set("Content-Language", locale)
+	if problem || acceptsProblem(ctx.Request()) {
+		problemResponse(ctx, result, locale)
 		return
 	}
-	if validationError(err, ctx) {
+	errorResponse(ctx, result)
+}
+
+// requestLocale returns the locale messages are translated to: the preference
+// of the signed in user, set by the auth middleware, or else the best match of
+// the Accept-Language header.
+func requestLocale(ctx echo.Context) string {
+	if preferred, ok := ctx.Get("currentLocale").(string); ok && i18n.Supported(preferred) {
+		return preferred
+	}
+	return i18n.Negotiate(ctx.Request().Header.Get("Accept-Language"))
+}
+
+func errorResponse(ctx echo.Context, result errorResult) {
+	status, ok := statusNames[result.status]
+	if !ok {
+		status = http.StatusText(result.status)
+	}
+	_ = ctx.JSON(result.status, web.WebResponse{
+		Code:   result.status,
+		Status: status,
+		Data:   nil,
+		Error:  result.errors,
+	})
+}
+
+// problemResponse responds with the RFC 7807 problem details of result. The
+// message of the request is the detail and the field messages are the
+// invalid-params.
+func problemResponse(ctx echo.Context, result errorResult, locale string) {
+	problem := web.ProblemResponse{
+		Type:     "about:blank",
+		Title:    i18n.StatusText(locale, result.status, http.StatusText(result.status)),
+		Status:   result.status,
+		Instance: ctx.Request().URL.RequestURI(),
+		Code:     result.code,
+	}
+	if result.code != "" {
+		problem.Type = "urn:dot-api:problem:" + strings.ToLower(strings.ReplaceAll(result.code, "_", "-"))
+	}
+	for name, reason := range result.errors {
+		if detail, ok := reason.(string); ok && name == "message" {
+			problem.Detail = detail
+			continue
+		}
+		problem.InvalidParams = appendInvalidParams(problem.InvalidParams, name, reason)
+	}
+	sort.Slice(problem.InvalidParams, func(i, j int) bool {
+		return problem.InvalidParams[i].Name < problem.InvalidParams[j].Name
+	})
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		logError(err, ctx)
 		return
 	}
-	generalError(err, ctx)
-}
-
-func generalError(err error, ctx echo.Context) {
-	switch err.Error() {
-	case "USER_NOT_FOUND":
-		_ = ctx.JSON(http.StatusNotFound, web.WebResponse{
-			Code:   http.StatusNotFound,
-			Status: web.NOT_FOUND,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"user_id": "NOT_FOUND",
-			},
-		})
-	case "TRANSACTION_NOT_FOUND":
-		_ = ctx.JSON(http.StatusNotFound, web.WebResponse{
-			Code:   http.StatusNotFound,
-			Status: web.NOT_FOUND,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"trasaction_id": "NOT_FOUND",
-			},
-		})
-	case web.UNAUTHORIZATION:
-		_ = ctx.JSON(http.StatusUnauthorized, web.WebResponse{
-			Code:   http.StatusUnauthorized,
-			Status: web.UNAUTHORIZATION,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"message": "Unauthorized",
-			},
-		})
-	case "PASSWORD_NOT_MATCH":
-		_ = ctx.JSON(http.StatusBadRequest, web.WebResponse{
-			Code:   http.StatusBadRequest,
-			Status: web.BAD_REQUEST,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"password_confirmation": "not match",
-			},
-		})
-	case "code=404, message=Not Found":
-		_ = ctx.JSON(http.StatusNotFound, web.WebResponse{
-			Code:   http.StatusNotFound,
-			Status: web.NOT_FOUND,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"message": "Not Found",
-			},
-		})
-	case "code=405, message=Method Not Allowed":
-		_ = ctx.JSON(http.StatusMethodNotAllowed, web.WebResponse{
-			Code:   http.StatusMethodNotAllowed,
-			Status: web.METHOD_NOT_ALLOWED,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"message": "Method Not Allowed",
-			},
-		})
-	default:
-		_ = ctx.JSON(http.StatusInternalServerError, web.WebResponse{
-			Code:   http.StatusInternalServerError,
-			Status: web.SERVER_ERROR,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"message": "Internal server error",
-			},
-		})
-	}
-}
-
-func validationError(err error, ctx echo.Context) bool {
-	_, ok := err.(ValidationError)
-	if ok {
-		var obj interface{}
-		_ = json.Unmarshal([]byte(err.Error()), &obj)
-		_ = ctx.JSON(http.StatusBadRequest, web.WebResponse{
-			Code:   http.StatusBadRequest,
-			Status: web.BAD_REQUEST,
-			Data:   nil,
-			Error:  obj,
-		})
-		return true
+	_ = ctx.Blob(result.status, MIMEApplicationProblemJSON, body)
+}
+
+// appendInvalidParams appends the reasons of name, naming nested fields such
+// as the items of a batch with their path, for example "items.0.amount".
+func appendInvalidParams(params []web.InvalidParam, name string, reason interface{}) []web.InvalidParam {
+	nested, ok := reason.(map[string]interface{})
+	if !ok {
+		text, ok := reason.(string)
+		if !ok {
+			encoded, _ := json.Marshal(reason)
+			text = string(encoded)
+		}
+		return append(params, web.InvalidParam{Name: name, Reason: text})
+	}
+	for field, fieldReason := range nested {
+		params = appendInvalidParams(params, name+"."+field, fieldReason)
+	}
+	return params
+}
+
+// acceptsProblem reports whether the Accept header of request lists
+// application/problem+json.
+func acceptsProblem(request *http.Request) bool {
+	for _, accepted := range strings.Split(request.Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == MIMEApplicationProblemJSON {
+			return true
+		}
 	}
 	return false
 }
 
-func databaseError(err error, ctx echo.Context) bool {
-	sqlError, ok := err.(*mysql.MySQLError)
-	if !ok {
-		return false
-	}
-	if sqlError.Number == 1062 && strings.Contains(sqlError.Message, "username") {
-		_ = ctx.JSON(http.StatusBadRequest, web.WebResponse{
-			Code:   http.StatusBadRequest,
-			Status: web.BAD_REQUEST,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"username": "must be unique",
-			},
-		})
-	} else if sqlError.Number == 1062 && strings.Contains(sqlError.Message, "email") {
-		_ = ctx.JSON(http.StatusBadRequest, web.WebResponse{
-			Code:   http.StatusBadRequest,
-			Status: web.BAD_REQUEST,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"email": "must be unique",
-			},
-		})
-	} else {
-		_ = ctx.JSON(http.StatusInternalServerError, web.WebResponse{
-			Code:   http.StatusInternalServerError,
-			Status: web.SERVER_ERROR,
-			Data:   nil,
-			Error: map[string]interface{}{
-				"message": "Internal server error",
-			},
-		})
-	}
-	return true
+// logError logs the cause of a 5xx response with the request ID, which the
+// client can quote from the X-Request-ID header.
+func logError(err error, ctx echo.Context) {
+	zap.L().Error("request failed",
+		zap.String("request_id", ctx.Response().Header().Get(echo.HeaderXRequestID)),
+		zap.String("method", ctx.Request().Method),
+		zap.String("path", ctx.Request().URL.Path),
+		zap.Error(err),
+	)
+}
+
+func internalError(locale string) errorResult {
+	return errorResult{
+		status: http.StatusInternalServerError,
+		errors: map[string]interface{}{
+			"message": i18n.Translate(locale, "INTERNAL_SERVER_ERROR", nil, "Internal server error"),
+		},
+	}
+}
+
+// domainError responds to an apperror.Error with its own status, reporting
+// its message for its field.
+func domainError(err error, ctx echo.Context, locale string) (errorResult, bool) {
+	var appError *apperror.Error
+	if !errors.As(err, &appError) {
+		return errorResult{}, false
+	}
+	if appError.Status >= http.StatusInternalServerError {
+		logError(err, ctx)
+	}
+	field := appError.Field
+	if field == "" {
+		field = "message"
+	}
+	return errorResult{
+		status: appError.Status,
+		code:   appError.Code,
+		errors: map[string]interface{}{
+			field: i18n.Translate(locale, appError.Code, nil, appError.Message),
+		},
+	}, true
+}
+
+// httpError responds to errors raised by Echo itself, such as unknown routes
+// and methods.
+func httpError(err error, ctx echo.Context, locale string) (errorResult, bool) {
+	var echoError *echo.HTTPError
+	if !errors.As(err, &echoError) {
+		return errorResult{}, false
+	}
+	if echoError.Code >= http.StatusInternalServerError {
+		logError(err, ctx)
+	}
+	return errorResult{
+		status: echoError.Code,
+		errors: map[string]interface{}{
+			"message": i18n.StatusText(locale, echoError.Code, http.StatusText(echoError.Code)),
+		},
+	}, true
+}
+
+func validationError(err error, locale string) (errorResult, bool) {
+	var validationError ValidationError
+	if !errors.As(err, &validationError) {
+		return errorResult{}, false
+	}
+	return errorResult{
+		status: http.StatusBadRequest,
+		code:   "VALIDATION_ERROR",
+		errors: validationError.TranslatedFields(locale),
+	}, true
+}
+
+func databaseError(err error, ctx echo.Context, locale string) (errorResult, bool) {
+	var sqlError *mysql.MySQLError
+	if !errors.As(err, &sqlError) {
+		return errorResult{}, false
+	}
+	for _, field := range []string{"username", "email"} {
+		if sqlError.Number == 1062 && strings.Contains(sqlError.Message, field) {
+			return errorResult{
+				status: http.StatusBadRequest,
+				errors: map[string]interface{}{
+					field: i18n.Translate(locale, "validation_unique", nil, "must be unique"),
+				},
+			}, true
+		}
+	}
+	logError(err, ctx)
+	return internalError(locale), true
 }
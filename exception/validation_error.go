@@ -1,9 +1,51 @@
 package exception
 
+import (
+	"encoding/json"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/i18n"
+)
+
 type ValidationError struct {
 	Message string
+	// Errors are the validator errors Message was encoded from, if any. Their
+	// codes and params let the messages be translated.
+	Errors validator.Errors
 }
 
 func (validationError ValidationError) Error() string {
 	return validationError.Message
 }
+
+// Fields returns the message of each invalid field, nested for the fields of
+// lists and objects.
+func (validationError ValidationError) Fields() map[string]interface{} {
+	var fields map[string]interface{}
+	_ = json.Unmarshal([]byte(validationError.Message), &fields)
+	return fields
+}
+
+// TranslatedFields returns Fields with the messages in locale. Errors built
+// from a message alone are returned as they are.
+func (validationError ValidationError) TranslatedFields(locale string) map[string]interface{} {
+	if validationError.Errors == nil {
+		return validationError.Fields()
+	}
+	return translateErrors(validationError.Errors, locale)
+}
+
+func translateErrors(errs validator.Errors, locale string) map[string]interface{} {
+	fields := make(map[string]interface{}, len(errs))
+	for field, err := range errs {
+		switch err := err.(type) {
+		case validator.Errors:
+			fields[field] = translateErrors(err, locale)
+		case validator.Error:
+			fields[field] = i18n.Translate(locale, err.Code(), err.Params(), err.Error())
+		default:
+			fields[field] = err.Error()
+		}
+	}
+	return fields
+}
@@ -0,0 +1,115 @@
+// Package i18n translates the messages of validation and domain errors. The
+// catalogs are keyed by error code: the codes of ozzo-validation errors, such
+// as validation_required, and the codes of apperror errors, such as
+// TRANSACTION_NOT_FOUND.
+package i18n
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	EnUS = "en-US"
+	IdID = "id-ID"
+	// DefaultLocale is used when neither the user nor the request asks for a
+	// supported locale.
+	DefaultLocale = EnUS
+)
+
+// Locales are the supported locales.
+var Locales = []string{EnUS, IdID}
+
+var catalogs = map[string]map[string]string{
+	EnUS: enUS,
+	IdID: idID,
+}
+
+// Supported reports whether locale has a catalog.
+func Supported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// Negotiate returns the supported locale that best matches an Accept-Language
+// header such as "id-ID,id;q=0.9,en;q=0.8", or DefaultLocale.
+func Negotiate(acceptLanguage string) string {
+	type weighted struct {
+		tag    string
+		weight float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		weight := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			parsed, err := strconv.ParseFloat(strings.TrimPrefix(params, "q="), 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if tag != "" && weight > 0 {
+			tags = append(tags, weighted{tag: tag, weight: weight})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].weight > tags[j].weight
+	})
+
+	for _, tag := range tags {
+		if locale, ok := match(tag.tag); ok {
+			return locale
+		}
+	}
+	return DefaultLocale
+}
+
+// match returns the supported locale of a language tag, matching on the
+// language alone so "en-GB" gets en-US. "in" is the legacy code of
+// Indonesian some Android versions still send.
+func match(tag string) (string, bool) {
+	language, _, _ := strings.Cut(strings.ToLower(tag), "-")
+	switch language {
+	case "id", "in":
+		return IdID, true
+	case "en":
+		return EnUS, true
+	}
+	return "", false
+}
+
+// Translate returns the message of code in locale with params filled in.
+// Codes missing from the catalog of locale fall back to DefaultLocale, and
+// unknown codes to fallback.
+func Translate(locale string, code string, params map[string]interface{}, fallback string) string {
+	message, ok := catalogs[locale][code]
+	if !ok {
+		message, ok = catalogs[DefaultLocale][code]
+	}
+	if !ok {
+		return fallback
+	}
+	if len(params) == 0 || !strings.Contains(message, "{{") {
+		return message
+	}
+
+	tmpl, err := template.New(code).Parse(message)
+	if err != nil {
+		return fallback
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, params); err != nil {
+		return fallback
+	}
+	return buffer.String()
+}
+
+// StatusText returns the text of an HTTP status in locale.
+func StatusText(locale string, status int, fallback string) string {
+	return Translate(locale, fmt.Sprintf("HTTP_%d", status), nil, fallback)
+}
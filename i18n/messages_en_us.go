@@ -0,0 +1,81 @@
+package i18n
+
+// enUS holds the messages the API has always responded with.
+var enUS = map[string]string{
+	"HTTP_400": "Bad Request",
+	"HTTP_401": "Unauthorized",
+	"HTTP_403": "Forbidden",
+	"HTTP_404": "Not Found",
+	"HTTP_405": "Method Not Allowed",
+	"HTTP_409": "Conflict",
+	"HTTP_413": "Request Entity Too Large",
+	"HTTP_415": "Unsupported Media Type",
+	"HTTP_429": "Too Many Requests",
+	"HTTP_500": "Internal Server Error",
+	"HTTP_503": "Service Unavailable",
+
+	"INTERNAL_SERVER_ERROR":   "Internal server error",
+	"UNAUTHORIZED":            "Unauthorized",
+	"ADMIN_REQUIRED":          "must be an admin",
+	"ATTACHMENT_NOT_FOUND":    "NOT_FOUND",
+	"BUDGET_NOT_FOUND":        "NOT_FOUND",
+	"CATEGORY_NOT_FOUND":      "NOT_FOUND",
+	"CATEGORY_RULE_NOT_FOUND": "NOT_FOUND",
+	"COMMENT_NOT_FOUND":       "NOT_FOUND",
+	"DISPUTE_NOT_FOUND":       "NOT_FOUND",
+	"PARTICIPANT_NOT_FOUND":   "NOT_FOUND",
+	"RECURRING_NOT_FOUND":     "NOT_FOUND",
+	"TAG_NOT_FOUND":           "NOT_FOUND",
+	"TRANSACTION_NOT_FOUND":   "NOT_FOUND",
+	"USER_NOT_FOUND":          "NOT_FOUND",
+	"BUDGET_ALREADY_EXISTS":   "already has a budget",
+	"COMMENT_FORBIDDEN":       "can only be changed by its author",
+	"DISPUTE_ALREADY_OPEN":    "already has an open dispute",
+	"DISPUTE_CHANGED":         "was changed by another request, try again",
+	"DISPUTE_CLOSED":          "is already resolved",
+	"EXCHANGE_RATE_NOT_FOUND": "no exchange rate loaded for some of the amounts",
+	"INVALID_CATEGORY_PARENT": "must not be the category itself or one of its descendants",
+	"INVALID_PATCH":           "invalid patch document",
+	"INVALID_PERIOD":          "must be in YYYY-MM format",
+	"PASSWORD_NOT_MATCH":      "not match",
+	"TRANSACTION_ON_HOLD":     "is on hold by an open dispute",
+	"UNSUPPORTED_MEDIA_TYPE":  "must be application/merge-patch+json or application/json-patch+json",
+
+	"validation_required":                        "cannot be blank",
+	"validation_nil_or_not_empty_required":       "cannot be blank",
+	"validation_not_nil_required":                "is required",
+	"validation_length_out_of_range":             "the length must be between {{.min}} and {{.max}}",
+	"validation_length_too_long":                 "the length must be no more than {{.max}}",
+	"validation_length_too_short":                "the length must be no less than {{.min}}",
+	"validation_length_invalid":                  "the length must be exactly {{.min}}",
+	"validation_min_greater_equal_than_required": "must be no less than {{.threshold}}",
+	"validation_max_less_equal_than_required":    "must be no greater than {{.threshold}}",
+	"validation_in_invalid":                      "must be a valid value",
+	"validation_match_invalid":                   "must be in a valid format",
+	"validation_date_invalid":                    "must be a valid date",
+	"validation_date_out_of_range":               "the date is out of range",
+	"validation_is_email":                        "must be a valid email address",
+	"validation_unique":                          "must be unique",
+	"validation_phone_invalid":                   "must be an E.164 phone number",
+	"validation_currency_invalid":                "must be an ISO 4217 code",
+	"validation_timezone_invalid":                "must be a valid IANA timezone",
+	"validation_regex_invalid":                   "must be a valid regular expression",
+	"validation_rrule_invalid":                   "must be a valid RRULE",
+	"validation_rrule_no_occurrence":             "must produce at least one occurrence",
+	"validation_date_before_from":                "must not be before from",
+	"validation_date_range_too_long":             "must be less than a year after from",
+	"validation_group_repeated":                  "must not repeat a key",
+	"validation_group_periods":                   "must contain at most one of day, week or month",
+	"validation_description_required":            "is required when the reason is other",
+	"validation_split_amount_required":           "requires a positive amount",
+	"validation_split_participant_invalid":       "must have a unique, non blank user_id",
+	"validation_split_amount_invalid":            "amount must be greater than zero",
+	"validation_split_amount_sum":                "amounts must add up to the transaction amount",
+	"validation_split_percent_invalid":           "percent must be greater than zero with at most two decimals",
+	"validation_split_percent_sum":               "percentages must add up to 100",
+	"validation_search_terms_required":           "must contain a letter or digit",
+	"validation_file_too_large":                  "must be at most {{.max}}MB",
+	"validation_json_object":                     "must be a JSON object",
+	"validation_import_empty":                    "contains no transactions",
+	"validation_import_too_large":                "must contain at most {{.max}} transactions",
+}
@@ -0,0 +1,81 @@
+package i18n
+
+// idID translates every message of enUS into Indonesian.
+var idID = map[string]string{
+	"HTTP_400": "Permintaan Tidak Valid",
+	"HTTP_401": "Tidak Terotorisasi",
+	"HTTP_403": "Dilarang",
+	"HTTP_404": "Tidak Ditemukan",
+	"HTTP_405": "Metode Tidak Diizinkan",
+	"HTTP_409": "Konflik",
+	"HTTP_413": "Permintaan Terlalu Besar",
+	"HTTP_415": "Jenis Media Tidak Didukung",
+	"HTTP_429": "Terlalu Banyak Permintaan",
+	"HTTP_500": "Kesalahan Server Internal",
+	"HTTP_503": "Layanan Tidak Tersedia",
+
+	"INTERNAL_SERVER_ERROR":   "Terjadi kesalahan pada server",
+	"UNAUTHORIZED":            "Tidak terotorisasi",
+	"ADMIN_REQUIRED":          "harus seorang admin",
+	"ATTACHMENT_NOT_FOUND":    "tidak ditemukan",
+	"BUDGET_NOT_FOUND":        "tidak ditemukan",
+	"CATEGORY_NOT_FOUND":      "tidak ditemukan",
+	"CATEGORY_RULE_NOT_FOUND": "tidak ditemukan",
+	"COMMENT_NOT_FOUND":       "tidak ditemukan",
+	"DISPUTE_NOT_FOUND":       "tidak ditemukan",
+	"PARTICIPANT_NOT_FOUND":   "tidak ditemukan",
+	"RECURRING_NOT_FOUND":     "tidak ditemukan",
+	"TAG_NOT_FOUND":           "tidak ditemukan",
+	"TRANSACTION_NOT_FOUND":   "tidak ditemukan",
+	"USER_NOT_FOUND":          "tidak ditemukan",
+	"BUDGET_ALREADY_EXISTS":   "sudah memiliki anggaran",
+	"COMMENT_FORBIDDEN":       "hanya dapat diubah oleh penulisnya",
+	"DISPUTE_ALREADY_OPEN":    "sudah memiliki sengketa yang masih terbuka",
+	"DISPUTE_CHANGED":         "diubah oleh permintaan lain, silakan coba lagi",
+	"DISPUTE_CLOSED":          "sudah diselesaikan",
+	"EXCHANGE_RATE_NOT_FOUND": "kurs belum tersedia untuk sebagian jumlah",
+	"INVALID_CATEGORY_PARENT": "tidak boleh kategori itu sendiri atau salah satu turunannya",
+	"INVALID_PATCH":           "dokumen patch tidak valid",
+	"INVALID_PERIOD":          "harus dalam format YYYY-MM",
+	"PASSWORD_NOT_MATCH":      "tidak cocok",
+	"TRANSACTION_ON_HOLD":     "sedang ditahan oleh sengketa yang masih terbuka",
+	"UNSUPPORTED_MEDIA_TYPE":  "harus application/merge-patch+json atau application/json-patch+json",
+
+	"validation_required":                        "tidak boleh kosong",
+	"validation_nil_or_not_empty_required":       "tidak boleh kosong",
+	"validation_not_nil_required":                "wajib diisi",
+	"validation_length_out_of_range":             "panjangnya harus antara {{.min}} dan {{.max}}",
+	"validation_length_too_long":                 "panjangnya tidak boleh lebih dari {{.max}}",
+	"validation_length_too_short":                "panjangnya tidak boleh kurang dari {{.min}}",
+	"validation_length_invalid":                  "panjangnya harus tepat {{.min}}",
+	"validation_min_greater_equal_than_required": "tidak boleh kurang dari {{.threshold}}",
+	"validation_max_less_equal_than_required":    "tidak boleh lebih dari {{.threshold}}",
+	"validation_in_invalid":                      "harus berupa nilai yang valid",
+	"validation_match_invalid":                   "formatnya tidak valid",
+	"validation_date_invalid":                    "harus berupa tanggal yang valid",
+	"validation_date_out_of_range":               "tanggalnya di luar rentang yang diizinkan",
+	"validation_is_email":                        "harus berupa alamat email yang valid",
+	"validation_unique":                          "sudah digunakan",
+	"validation_phone_invalid":                   "harus berupa nomor telepon dalam format E.164",
+	"validation_currency_invalid":                "harus berupa kode mata uang ISO 4217",
+	"validation_timezone_invalid":                "harus berupa zona waktu IANA yang valid",
+	"validation_regex_invalid":                   "harus berupa regular expression yang valid",
+	"validation_rrule_invalid":                   "harus berupa RRULE yang valid",
+	"validation_rrule_no_occurrence":             "harus menghasilkan setidaknya satu jadwal",
+	"validation_date_before_from":                "tidak boleh sebelum from",
+	"validation_date_range_too_long":             "harus kurang dari satu tahun setelah from",
+	"validation_group_repeated":                  "tidak boleh mengulang kunci yang sama",
+	"validation_group_periods":                   "hanya boleh berisi salah satu dari day, week atau month",
+	"validation_description_required":            "wajib diisi jika alasannya other",
+	"validation_split_amount_required":           "memerlukan jumlah yang lebih dari nol",
+	"validation_split_participant_invalid":       "harus memiliki user_id yang unik dan tidak kosong",
+	"validation_split_amount_invalid":            "jumlah harus lebih dari nol",
+	"validation_split_amount_sum":                "total jumlah harus sama dengan jumlah transaksi",
+	"validation_split_percent_invalid":           "persentase harus lebih dari nol dengan paling banyak dua desimal",
+	"validation_split_percent_sum":               "total persentase harus 100",
+	"validation_search_terms_required":           "harus mengandung huruf atau angka",
+	"validation_file_too_large":                  "ukurannya paling besar {{.max}}MB",
+	"validation_json_object":                     "harus berupa objek JSON",
+	"validation_import_empty":                    "tidak berisi transaksi",
+	"validation_import_too_large":                "paling banyak berisi {{.max}} transaksi",
+}
@@ -6,18 +6,37 @@ import (
 )
 
 type Config struct {
-	AppPort                string `mapstructure:"APP_PORT"`
-	MysqlHostSlave         string `mapstructure:"MYSQL_HOST_SLAVE"`
-	MysqlPoolMin           int    `mapstructure:"MYSQL_POOL_MIN"`
-	MysqlPoolMax           int    `mapstructure:"MYSQL_POOL_MAX"`
-	MysqlIdleMax           int    `mapstructure:"MYSQL_IDLE_MAX"`
-	MysqlMaxIdleTimeMinute int    `mapstructure:"MYSQL_MAX_IDLE_TIME_MINUTE"`
-	MysqlMaxLifeTimeMinute int    `mapstructure:"MYSQL_MAX_LIFE_TIME_MINUTE"`
-	JWTPublicKey           string `mapstructure:"JWT_PUBLIC_KEY"`
-	JWTSecretKey           string `mapstructure:"JWT_SECRET_KEY"`
-	JWTMinute              int    `mapstructure:"JWT_MINUTE"`
-	RedisHost              string `mapstructure:"REDIS_HOST"`
-	RedisPassword          string `mapstructure:"REDIS_PASSWORD"`
+	AppPort                       string `mapstructure:"APP_PORT"`
+	MysqlHostSlave                string `mapstructure:"MYSQL_HOST_SLAVE"`
+	MysqlPoolMin                  int    `mapstructure:"MYSQL_POOL_MIN"`
+	MysqlPoolMax                  int    `mapstructure:"MYSQL_POOL_MAX"`
+	MysqlIdleMax                  int    `mapstructure:"MYSQL_IDLE_MAX"`
+	MysqlMaxIdleTimeMinute        int    `mapstructure:"MYSQL_MAX_IDLE_TIME_MINUTE"`
+	MysqlMaxLifeTimeMinute        int    `mapstructure:"MYSQL_MAX_LIFE_TIME_MINUTE"`
+	JWTPublicKey                  string `mapstructure:"JWT_PUBLIC_KEY"`
+	JWTSecretKey                  string `mapstructure:"JWT_SECRET_KEY"`
+	JWTMinute                     int    `mapstructure:"JWT_MINUTE"`
+	RedisHost                     string `mapstructure:"REDIS_HOST"`
+	RedisPassword                 string `mapstructure:"REDIS_PASSWORD"`
+	SchedulerIntervalSecond       int    `mapstructure:"SCHEDULER_INTERVAL_SECOND"`
+	StorageDriver                 string `mapstructure:"STORAGE_DRIVER"`
+	StorageLocalPath              string `mapstructure:"STORAGE_LOCAL_PATH"`
+	S3Endpoint                    string `mapstructure:"S3_ENDPOINT"`
+	S3Region                      string `mapstructure:"S3_REGION"`
+	S3Bucket                      string `mapstructure:"S3_BUCKET"`
+	S3AccessKey                   string `mapstructure:"S3_ACCESS_KEY"`
+	S3SecretKey                   string `mapstructure:"S3_SECRET_KEY"`
+	S3UseSSL                      bool   `mapstructure:"S3_USE_SSL"`
+	AttachmentMaxSizeMB           int    `mapstructure:"ATTACHMENT_MAX_SIZE_MB"`
+	ReportCacheTTLSecond          int    `mapstructure:"REPORT_CACHE_TTL_SECOND"`
+	ErrorFormat                   string `mapstructure:"ERROR_FORMAT"`
+	LogLevel                      string `mapstructure:"LOG_LEVEL"`
+	TracingExporter               string `mapstructure:"TRACING_EXPORTER"`
+	TracingOTLPEndpoint           string `mapstructure:"TRACING_OTLP_ENDPOINT"`
+	TracingOTLPInsecure           bool   `mapstructure:"TRACING_OTLP_INSECURE"`
+	HealthCheckTimeoutMillisecond int    `mapstructure:"HEALTH_CHECK_TIMEOUT_MILLISECOND"`
+	ShutdownDelaySecond           int    `mapstructure:"SHUTDOWN_DELAY_SECOND"`
+	ShutdownTimeoutSecond         int    `mapstructure:"SHUTDOWN_TIMEOUT_SECOND"`
 }
 
 func NewConfig(configName string) *Config {
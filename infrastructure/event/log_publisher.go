@@ -0,0 +1,21 @@
+package event
+
+import (
+	"context"
+	"log"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// LogPublisher writes events to the application log. It is the default
+// until a message broker is configured.
+type LogPublisher struct{}
+
+func NewLogPublisher() Publisher {
+	return &LogPublisher{}
+}
+
+func (publisher *LogPublisher) Publish(ctx context.Context, event model.Event) error {
+	log.Printf("event %s [%s] on %s by user %s", event.EventID, event.Type, event.AggregateID, event.UserID)
+	return nil
+}
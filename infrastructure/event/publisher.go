@@ -0,0 +1,13 @@
+package event
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// Publisher hands domain events to whoever reacts to them. Implementations
+// must be safe for concurrent use.
+type Publisher interface {
+	Publish(ctx context.Context, event model.Event) error
+}
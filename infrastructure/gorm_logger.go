@@ -0,0 +1,91 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// SlowQueryThreshold is the duration above which queries are logged as warnings.
+const SlowQueryThreshold = 200 * time.Millisecond
+
+// sqlStringPattern matches the quoted strings GORM interpolates into the SQL it
+// logs, escaped with backslashes.
+var sqlStringPattern = regexp.MustCompile(`'(?:[^'\\]|\\.)*'`)
+
+// GormLogger writes the queries of GORM to a zap logger: every query at debug
+// level, slow queries as warnings and failed queries as errors. String values
+// are masked, since they include passwords, tokens and phone numbers.
+type GormLogger struct {
+	Logger *zap.Logger
+	Level  logger.LogLevel
+}
+
+func NewGormLogger(zapLogger *zap.Logger) *GormLogger {
+	return &GormLogger{Logger: zapLogger.Named("gorm").WithOptions(zap.WithCaller(false)), Level: logger.Info}
+}
+
+func (gormLogger *GormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	return &GormLogger{Logger: gormLogger.Logger, Level: level}
+}
+
+func (gormLogger *GormLogger) Info(ctx context.Context, message string, data ...interface{}) {
+	if gormLogger.Level >= logger.Info {
+		gormLogger.Logger.Info(fmt.Sprintf(message, data...), requestIDField(ctx))
+	}
+}
+
+func (gormLogger *GormLogger) Warn(ctx context.Context, message string, data ...interface{}) {
+	if gormLogger.Level >= logger.Warn {
+		gormLogger.Logger.Warn(fmt.Sprintf(message, data...), requestIDField(ctx))
+	}
+}
+
+func (gormLogger *GormLogger) Error(ctx context.Context, message string, data ...interface{}) {
+	if gormLogger.Level >= logger.Error {
+		gormLogger.Logger.Error(fmt.Sprintf(message, data...), requestIDField(ctx))
+	}
+}
+
+func (gormLogger *GormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if gormLogger.Level <= logger.Silent {
+		return
+	}
+	elapsed := time.Since(begin)
+	fields := func() []zap.Field {
+		sql, rows := fc()
+		return []zap.Field{
+			zap.String("sql", MaskSQL(sql)),
+			zap.Int64("rows", rows),
+			zap.Float64("elapsed_ms", float64(elapsed.Microseconds())/1000),
+			requestIDField(ctx),
+		}
+	}
+
+	switch {
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && gormLogger.Level >= logger.Error:
+		gormLogger.Logger.Error("sql failed", append(fields(), zap.Error(err))...)
+	case elapsed > SlowQueryThreshold && gormLogger.Level >= logger.Warn:
+		gormLogger.Logger.Warn("slow sql", fields()...)
+	case gormLogger.Level >= logger.Info && gormLogger.Logger.Core().Enabled(zap.DebugLevel):
+		gormLogger.Logger.Debug("sql", fields()...)
+	}
+}
+
+// MaskSQL replaces the string values of sql with '?'.
+func MaskSQL(sql string) string {
+	return sqlStringPattern.ReplaceAllString(sql, "'?'")
+}
+
+func requestIDField(ctx context.Context) zap.Field {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		return zap.String("request_id", requestID)
+	}
+	return zap.Skip()
+}
@@ -0,0 +1,38 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"gorm.io/gorm"
+)
+
+// NewDependencyChecker returns a checker of MySQL and Redis, both critical:
+// no request can be served without the database, and no authenticated
+// request without the tokens in Redis.
+func NewDependencyChecker(configuration *infrastructure.Config, db *gorm.DB, client *redis.Client) *Checker {
+	checker := NewChecker(time.Duration(configuration.HealthCheckTimeoutMillisecond) * time.Millisecond)
+	checker.Register("mysql", true, MySQLCheck(db))
+	checker.Register("redis", true, RedisCheck(client))
+	return checker
+}
+
+// MySQLCheck pings the connection pool of db.
+func MySQLCheck(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+// RedisCheck sends PING to client.
+func RedisCheck(client *redis.Client) CheckFunc {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
@@ -0,0 +1,124 @@
+// Package health checks the dependencies the service needs to take traffic.
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+// DefaultTimeout bounds each check when no timeout is configured.
+const DefaultTimeout = 2 * time.Second
+
+// CheckFunc reports whether a dependency can be used. It must return once
+// ctx is done.
+type CheckFunc func(ctx context.Context) error
+
+type dependency struct {
+	name     string
+	critical bool
+	check    CheckFunc
+}
+
+// Checker runs the checks of the registered dependencies. The service is
+// unavailable when a critical dependency is down, and degraded when only
+// non-critical ones are.
+type Checker struct {
+	Timeout      time.Duration
+	mutex        sync.RWMutex
+	dependencies []dependency
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{Timeout: timeout}
+}
+
+// Register adds a dependency. Checks run in the order they are registered.
+func (checker *Checker) Register(name string, critical bool, check CheckFunc) {
+	checker.mutex.Lock()
+	defer checker.mutex.Unlock()
+	checker.dependencies = append(checker.dependencies, dependency{name: name, critical: critical, check: check})
+}
+
+// ShutDown makes the service unavailable from now on, so the load balancer
+// stops sending requests before the server stops accepting them.
+func (checker *Checker) ShutDown() {
+	checker.shuttingDown.Store(true)
+}
+
+// Check runs every check at the same time, each within the timeout of the
+// checker, and reports the status and latency of each dependency. Once the
+// checker is shut down, it reports the service unavailable without running
+// the checks.
+func (checker *Checker) Check(ctx context.Context) web.HealthResponse {
+	if checker.shuttingDown.Load() {
+		return web.HealthResponse{Status: web.HealthStatusUnavailable}
+	}
+
+	checker.mutex.RLock()
+	dependencies := append([]dependency(nil), checker.dependencies...)
+	checker.mutex.RUnlock()
+
+	response := web.HealthResponse{
+		Status:       web.HealthStatusOK,
+		Dependencies: make([]web.DependencyResponse, len(dependencies)),
+	}
+	var wg sync.WaitGroup
+	for i := range dependencies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			response.Dependencies[i] = checker.run(ctx, dependencies[i])
+		}(i)
+	}
+	wg.Wait()
+
+	for _, dependency := range response.Dependencies {
+		if dependency.Status == web.DependencyStatusUp {
+			continue
+		}
+		if dependency.Critical {
+			response.Status = web.HealthStatusUnavailable
+			break
+		}
+		response.Status = web.HealthStatusDegraded
+	}
+	return response
+}
+
+func (checker *Checker) run(ctx context.Context, dependency dependency) web.DependencyResponse {
+	ctx, cancel := context.WithTimeout(ctx, checker.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	result := make(chan error, 1)
+	go func() {
+		result <- dependency.check(ctx)
+	}()
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		// Do not wait for a check that ignores its context.
+		err = ctx.Err()
+	}
+
+	response := web.DependencyResponse{
+		Name:      dependency.name,
+		Status:    web.DependencyStatusUp,
+		Critical:  dependency.critical,
+		LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		response.Status = web.DependencyStatusDown
+		response.Error = err.Error()
+	}
+	return response
+}
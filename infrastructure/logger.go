@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted replaces the value of sensitive fields in logs.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are the parts of field names whose values never reach the
+// logs: passwords, JWTs and refresh tokens, API secrets and phone numbers.
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "handphone", "phone"}
+
+type requestIDKey struct{}
+
+// NewLogger returns a JSON logger writing to stderr at the LOG_LEVEL of the
+// configuration, info by default. Fields with sensitive names are redacted.
+func NewLogger(configuration *Config) *zap.Logger {
+	level := zapcore.InfoLevel
+	if configuration.LogLevel != "" {
+		if err := level.Set(configuration.LogLevel); err != nil {
+			level = zapcore.InfoLevel
+		}
+	}
+
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "time"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), zapcore.Lock(os.Stderr), level)
+	return zap.New(NewRedactingCore(core), zap.AddCaller())
+}
+
+// IsSensitiveKey reports whether the value of a field, header or query
+// parameter named key must be redacted.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContextWithRequestID returns a copy of ctx carrying the X-Request-ID of the
+// request it serves, so logs written further down, such as SQL, can be
+// correlated with the request.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID of ctx, or "" outside requests.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// redactingCore replaces the values of sensitive fields before they are
+// encoded.
+type redactingCore struct {
+	zapcore.Core
+}
+
+// NewRedactingCore wraps core so fields with sensitive names are written as
+// Redacted, including the keys of map fields.
+func NewRedactingCore(core zapcore.Core) zapcore.Core {
+	return &redactingCore{Core: core}
+}
+
+func (core *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: core.Core.With(redactFields(fields))}
+}
+
+func (core *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if core.Enabled(entry.Level) {
+		return checked.AddCore(entry, core)
+	}
+	return checked
+}
+
+func (core *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return core.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, field := range fields {
+		switch {
+		case IsSensitiveKey(field.Key):
+			redacted[i] = zap.String(field.Key, Redacted)
+		case field.Type == zapcore.ReflectType:
+			if values, ok := field.Interface.(map[string]interface{}); ok {
+				redacted[i] = zap.Any(field.Key, redactMap(values))
+				continue
+			}
+			redacted[i] = field
+		default:
+			redacted[i] = field
+		}
+	}
+	return redacted
+}
+
+func redactMap(values map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		if IsSensitiveKey(key) {
+			redacted[key] = Redacted
+			continue
+		}
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = redactMap(nested)
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"database/sql"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var poolCollector = newDBStatsCollector()
+
+func init() {
+	prometheus.MustRegister(poolCollector)
+}
+
+// RegisterDB adds the connection pool of db to the pool metrics. The metrics
+// add up the stats of every registered pool.
+func RegisterDB(db *sql.DB) {
+	poolCollector.add(db)
+}
+
+// dbStatsCollector reports sql.DBStats when scraped, so the values are
+// current without polling.
+type dbStatsCollector struct {
+	mutex sync.Mutex
+	dbs   []*sql.DB
+
+	maxOpen      *prometheus.Desc
+	open         *prometheus.Desc
+	inUse        *prometheus.Desc
+	idle         *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+}
+
+func newDBStatsCollector() *dbStatsCollector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &dbStatsCollector{
+		maxOpen:      desc("max_open_connections", "Maximum number of open connections to the database."),
+		open:         desc("open_connections", "Established connections, in use and idle."),
+		inUse:        desc("in_use_connections", "Connections currently in use."),
+		idle:         desc("idle_connections", "Idle connections."),
+		waitCount:    desc("wait_count_total", "Connections waited for."),
+		waitDuration: desc("wait_duration_seconds_total", "Time blocked waiting for a new connection."),
+	}
+}
+
+func (collector *dbStatsCollector) add(db *sql.DB) {
+	collector.mutex.Lock()
+	defer collector.mutex.Unlock()
+	collector.dbs = append(collector.dbs, db)
+}
+
+func (collector *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- collector.maxOpen
+	ch <- collector.open
+	ch <- collector.inUse
+	ch <- collector.idle
+	ch <- collector.waitCount
+	ch <- collector.waitDuration
+}
+
+func (collector *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	collector.mutex.Lock()
+	var total sql.DBStats
+	for _, db := range collector.dbs {
+		stats := db.Stats()
+		total.MaxOpenConnections += stats.MaxOpenConnections
+		total.OpenConnections += stats.OpenConnections
+		total.InUse += stats.InUse
+		total.Idle += stats.Idle
+		total.WaitCount += stats.WaitCount
+		total.WaitDuration += stats.WaitDuration
+	}
+	collector.mutex.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(collector.maxOpen, prometheus.GaugeValue, float64(total.MaxOpenConnections))
+	ch <- prometheus.MustNewConstMetric(collector.open, prometheus.GaugeValue, float64(total.OpenConnections))
+	ch <- prometheus.MustNewConstMetric(collector.inUse, prometheus.GaugeValue, float64(total.InUse))
+	ch <- prometheus.MustNewConstMetric(collector.idle, prometheus.GaugeValue, float64(total.Idle))
+	ch <- prometheus.MustNewConstMetric(collector.waitCount, prometheus.CounterValue, float64(total.WaitCount))
+	ch <- prometheus.MustNewConstMetric(collector.waitDuration, prometheus.CounterValue, total.WaitDuration.Seconds())
+}
@@ -0,0 +1,82 @@
+// Package metrics declares the Prometheus metrics of the service. They are
+// registered with the default registry, which /metrics exposes along with the
+// Go runtime and process metrics.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "dot_api"
+
+var (
+	// HTTPRequestDuration observes every request by method, route template,
+	// such as /dot-api/transaction/:id, and status.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests by method, route template and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// DBQueryDuration observes the queries run through GORM by operation,
+	// such as query or create, and table.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duration of GORM queries by operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	// DBQueryErrors counts the GORM queries that failed. Record not found is
+	// not a failure.
+	DBQueryErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "GORM queries that failed, by operation and table.",
+	}, []string{"operation", "table"})
+
+	// RedisCommandDuration observes Redis commands by name, such as get or set.
+	RedisCommandDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "redis_command_duration_seconds",
+		Help:      "Duration of Redis commands by command.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5},
+	}, []string{"command"})
+
+	// RedisCommandErrors counts the Redis commands that failed. A missing key
+	// is not a failure.
+	RedisCommandErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "redis_command_errors_total",
+		Help:      "Redis commands that failed, by command.",
+	}, []string{"command"})
+
+	// Logins counts login attempts by result, success or failure.
+	Logins = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Login attempts by result.",
+	}, []string{"result"})
+
+	// TransactionsCreated counts the transactions saved, one at a time, in
+	// batches, by imports or by recurring transactions.
+	TransactionsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transactions_created_total",
+		Help:      "Transactions created.",
+	})
+
+	// UsersRegistered counts the users created.
+	UsersRegistered = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_registered_total",
+		Help:      "Users registered.",
+	})
+)
+
+const (
+	LoginSuccess = "success"
+	LoginFailure = "failure"
+)
@@ -7,12 +7,14 @@ import (
 
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+	"github.com/vnnyx/golang-dot-api/infrastructure/tracing"
+	"go.uber.org/zap"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
-func NewMySQLDatabase(configuration *Config) *gorm.DB {
+func NewMySQLDatabase(configuration *Config, logger *zap.Logger) *gorm.DB {
 	ctx, cancel := NewMySQLContext()
 	defer cancel()
 
@@ -39,8 +41,14 @@ func NewMySQLDatabase(configuration *Config) *gorm.DB {
 
 	gormDB, err := gorm.Open(mysql.New(mysql.Config{
 		Conn: sqlDB,
-	}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	}), &gorm.Config{Logger: NewGormLogger(logger)})
 	exception.PanicIfNeeded(err)
+
+	err = gormDB.Use(metrics.GormPlugin{})
+	exception.PanicIfNeeded(err)
+	err = gormDB.Use(tracing.GormPlugin{})
+	exception.PanicIfNeeded(err)
+	metrics.RegisterDB(sqlDB)
 	return gormDB
 }
 
@@ -0,0 +1,21 @@
+package notifier
+
+import (
+	"context"
+	"log"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// LogNotifier writes notifications to the application log. It is the default
+// until a push or email channel is configured.
+type LogNotifier struct{}
+
+func NewLogNotifier() Notifier {
+	return &LogNotifier{}
+}
+
+func (notifier *LogNotifier) Notify(ctx context.Context, notification model.Notification) error {
+	log.Printf("notification to user %s [%s]: %s", notification.UserID, notification.Type, notification.Message)
+	return nil
+}
@@ -0,0 +1,13 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// Notifier delivers notifications to users. Implementations must be safe for
+// concurrent use.
+type Notifier interface {
+	Notify(ctx context.Context, notification model.Notification) error
+}
@@ -2,13 +2,16 @@ package infrastructure
 
 import (
 	"github.com/go-redis/redis/v8"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+	"github.com/vnnyx/golang-dot-api/infrastructure/tracing"
 )
 
-func NewRedisClient(configName string) *redis.Client {
-	config := NewConfig(configName)
+func NewRedisClient(config *Config) *redis.Client {
 	client := redis.NewClient(&redis.Options{
 		Addr:     config.RedisHost,
 		Password: config.RedisPassword,
 	})
+	client.AddHook(metrics.RedisHook{})
+	client.AddHook(tracing.RedisHook{})
 	return client
 }
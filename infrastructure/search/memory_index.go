@@ -0,0 +1,115 @@
+package search
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+// MemoryIndex is an in-process Index for tests. Documents are added with
+// AddTransaction and AddUser; a word matching a term exactly scores higher
+// than a word it is only a prefix of.
+type MemoryIndex struct {
+	mutex        sync.RWMutex
+	transactions map[string]memoryDocument
+	users        map[string]memoryDocument
+}
+
+type memoryDocument struct {
+	owners map[string]bool
+	fields map[string]string
+}
+
+func NewMemoryIndex() *MemoryIndex {
+	return &MemoryIndex{transactions: make(map[string]memoryDocument), users: make(map[string]memoryDocument)}
+}
+
+func (index *MemoryIndex) AddTransaction(transaction entity.Transaction) {
+	owners := map[string]bool{transaction.UserID: true}
+	for _, split := range transaction.Splits {
+		owners[split.UserID] = true
+	}
+
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.transactions[transaction.TransactionID] = memoryDocument{owners: owners, fields: map[string]string{"name": transaction.Name}}
+}
+
+func (index *MemoryIndex) AddUser(user entity.User) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.users[user.UserID] = memoryDocument{fields: map[string]string{"username": user.Username, "email": user.Email}}
+}
+
+func (index *MemoryIndex) SearchTransactions(ctx context.Context, userId string, query model.SearchQuery) ([]model.SearchHit, error) {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+	return search(index.transactions, query, func(document memoryDocument) bool {
+		return document.owners[userId]
+	}), nil
+}
+
+func (index *MemoryIndex) SearchUsers(ctx context.Context, query model.SearchQuery) ([]model.SearchHit, error) {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+	return search(index.users, query, func(document memoryDocument) bool {
+		return true
+	}), nil
+}
+
+func search(documents map[string]memoryDocument, query model.SearchQuery, visible func(document memoryDocument) bool) (hits []model.SearchHit) {
+	for id, document := range documents {
+		if !visible(document) {
+			continue
+		}
+		score, ok := scoreDocument(document, query.Terms)
+		if !ok {
+			continue
+		}
+		fields := make(map[string]string, len(document.fields))
+		for name, value := range document.fields {
+			fields[name] = value
+		}
+		hits = append(hits, model.SearchHit{ID: id, Score: score, Fields: fields})
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+	if query.Limit > 0 && len(hits) > query.Limit {
+		hits = hits[:query.Limit]
+	}
+	return hits
+}
+
+// scoreDocument adds up, for every term, the score of each word it matches.
+// A document matches only when every term matches a word.
+func scoreDocument(document memoryDocument, terms []string) (score float64, ok bool) {
+	for _, term := range terms {
+		matched := false
+		for _, value := range document.fields {
+			for _, word := range util.SearchWords(value) {
+				switch {
+				case word == term:
+					score += 2
+					matched = true
+				case strings.HasPrefix(word, term):
+					score++
+					matched = true
+				}
+			}
+		}
+		if !matched {
+			return 0, false
+		}
+	}
+	return score, true
+}
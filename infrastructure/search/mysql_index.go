@@ -0,0 +1,70 @@
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"gorm.io/gorm"
+)
+
+// MySQLIndex queries the FULLTEXT indexes on transactions.name and on
+// users.username and users.email, which MySQL keeps up to date itself.
+type MySQLIndex struct {
+	DB *gorm.DB
+}
+
+func NewMySQLIndex(db *gorm.DB) Index {
+	return &MySQLIndex{DB: db}
+}
+
+func (index *MySQLIndex) SearchTransactions(ctx context.Context, userId string, query model.SearchQuery) (hits []model.SearchHit, err error) {
+	var rows []struct {
+		TransactionID string
+		Name          string
+		Score         float64
+	}
+	against := booleanQuery(query.Terms)
+	shared := index.DB.Table("transaction_splits").Select("transaction_id").Where("user_id", userId)
+	err = index.DB.WithContext(ctx).Table("transactions").
+		Select("transaction_id, name, MATCH(name) AGAINST(? IN BOOLEAN MODE) AS score", against).
+		Where("MATCH(name) AGAINST(? IN BOOLEAN MODE)", against).
+		Where("user_id = ? OR transaction_id IN (?)", userId, shared).
+		Order("score DESC, created_at DESC, transaction_id").
+		Limit(query.Limit).
+		Scan(&rows).Error
+	for _, row := range rows {
+		hits = append(hits, model.SearchHit{ID: row.TransactionID, Score: row.Score, Fields: map[string]string{"name": row.Name}})
+	}
+	return hits, err
+}
+
+func (index *MySQLIndex) SearchUsers(ctx context.Context, query model.SearchQuery) (hits []model.SearchHit, err error) {
+	var rows []struct {
+		UserID   string
+		Username string
+		Email    string
+		Score    float64
+	}
+	against := booleanQuery(query.Terms)
+	err = index.DB.WithContext(ctx).Table("users").
+		Select("user_id, username, email, MATCH(username, email) AGAINST(? IN BOOLEAN MODE) AS score", against).
+		Where("MATCH(username, email) AGAINST(? IN BOOLEAN MODE)", against).
+		Order("score DESC, username").
+		Limit(query.Limit).
+		Scan(&rows).Error
+	for _, row := range rows {
+		hits = append(hits, model.SearchHit{ID: row.UserID, Score: row.Score, Fields: map[string]string{"username": row.Username, "email": row.Email}})
+	}
+	return hits, err
+}
+
+// booleanQuery requires every term as a word prefix, e.g. "+coff* +shop*".
+// Terms only hold word characters, so they cannot inject operators.
+func booleanQuery(terms []string) string {
+	words := make([]string, 0, len(terms))
+	for _, term := range terms {
+		words = append(words, "+"+term+"*")
+	}
+	return strings.Join(words, " ")
+}
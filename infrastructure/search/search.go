@@ -0,0 +1,17 @@
+package search
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// Index finds transactions and users by the words in their text fields,
+// best match first.
+type Index interface {
+	// SearchTransactions only returns transactions userId created or is a
+	// participant of. Hits have a "name" field.
+	SearchTransactions(ctx context.Context, userId string, query model.SearchQuery) ([]model.SearchHit, error)
+	// SearchUsers returns hits with "username" and "email" fields.
+	SearchUsers(ctx context.Context, query model.SearchQuery) ([]model.SearchHit, error)
+}
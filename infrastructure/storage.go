@@ -0,0 +1,20 @@
+package infrastructure
+
+import (
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/infrastructure/storage"
+)
+
+func NewStorage(config *Config) storage.Storage {
+	if config.StorageDriver == "s3" {
+		s3Storage, err := storage.NewS3Storage(config.S3Endpoint, config.S3Region, config.S3Bucket, config.S3AccessKey, config.S3SecretKey, config.S3UseSSL)
+		exception.PanicIfNeeded(err)
+		return s3Storage
+	}
+
+	path := config.StorageLocalPath
+	if path == "" {
+		path = "storage"
+	}
+	return storage.NewLocalStorage(path)
+}
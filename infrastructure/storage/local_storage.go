@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+type LocalStorage struct {
+	BaseDir string
+}
+
+func NewLocalStorage(baseDir string) Storage {
+	return &LocalStorage{BaseDir: baseDir}
+}
+
+// path resolves key below BaseDir; cleaning it as an absolute path first
+// keeps ".." segments from escaping the storage directory.
+func (storage *LocalStorage) path(key string) string {
+	return filepath.Join(storage.BaseDir, filepath.FromSlash(filepath.Clean("/"+key)))
+}
+
+func (storage *LocalStorage) Put(ctx context.Context, key string, contentType string, body io.Reader, size int64) error {
+	path := storage.path(key)
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so readers never see a partial object.
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = io.Copy(file, body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (storage *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(storage.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	return file, err
+}
+
+func (storage *LocalStorage) Delete(ctx context.Context, key string) error {
+	err := os.Remove(storage.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage stores objects in any S3 compatible service such as AWS S3 or
+// MinIO. Non AWS endpoints are addressed path style.
+type S3Storage struct {
+	Client *minio.Client
+	Bucket string
+}
+
+func NewS3Storage(endpoint string, region string, bucket string, accessKey string, secretKey string, useSSL bool) (Storage, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+		Region: region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &S3Storage{Client: client, Bucket: bucket}, nil
+}
+
+func (storage *S3Storage) Put(ctx context.Context, key string, contentType string, body io.Reader, size int64) error {
+	_, err := storage.Client.PutObject(ctx, storage.Bucket, key, body, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (storage *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, err := storage.Client.GetObject(ctx, storage.Bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, notFoundError(err)
+	}
+	// GetObject is lazy; Stat forces the request so a missing key is reported here.
+	_, err = object.Stat()
+	if err != nil {
+		_ = object.Close()
+		return nil, notFoundError(err)
+	}
+	return object, nil
+}
+
+func (storage *S3Storage) Delete(ctx context.Context, key string) error {
+	return storage.Client.RemoveObject(ctx, storage.Bucket, key, minio.RemoveObjectOptions{})
+}
+
+func notFoundError(err error) error {
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return ErrObjectNotFound
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrObjectNotFound = errors.New("OBJECT_NOT_FOUND")
+
+// Storage keeps binary objects such as receipt files outside the database.
+// Keys are slash separated paths generated by the caller.
+type Storage interface {
+	Put(ctx context.Context, key string, contentType string, body io.Reader, size int64) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,58 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/exception"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const (
+	// TracingExporterNone records no spans. It is the default.
+	TracingExporterNone = "none"
+	// TracingExporterStdout writes spans to stdout as JSON.
+	TracingExporterStdout = "stdout"
+	// TracingExporterOTLP sends spans to TRACING_OTLP_ENDPOINT over OTLP/HTTP.
+	TracingExporterOTLP = "otlp"
+)
+
+// ServiceName identifies the service in traces.
+const ServiceName = "dot-api"
+
+// NewTracerProvider returns a tracer provider exporting spans in batches to
+// the TRACING_EXPORTER of the configuration. Spans of requests with a sampled
+// traceparent header are always recorded.
+func NewTracerProvider(configuration *Config) *sdktrace.TracerProvider {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName))),
+	}
+
+	switch configuration.TracingExporter {
+	case TracingExporterStdout:
+		exporter, err := stdouttrace.New()
+		exception.PanicIfNeeded(err)
+		options = append(options, sdktrace.WithBatcher(exporter))
+	case TracingExporterOTLP:
+		otlpOptions := []otlptracehttp.Option{otlptracehttp.WithEndpoint(configuration.TracingOTLPEndpoint)}
+		if configuration.TracingOTLPInsecure {
+			otlpOptions = append(otlpOptions, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), otlpOptions...)
+		exception.PanicIfNeeded(err)
+		options = append(options, sdktrace.WithBatcher(exporter))
+	default:
+		options = append(options, sdktrace.WithSampler(sdktrace.NeverSample()))
+	}
+	return sdktrace.NewTracerProvider(options...)
+}
+
+// NewPropagator returns the W3C Trace Context and Baggage propagator, which
+// reads and writes the traceparent, tracestate and baggage headers.
+func NewPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
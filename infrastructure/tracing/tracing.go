@@ -0,0 +1,7 @@
+// Package tracing instruments GORM and go-redis with OpenTelemetry spans.
+// The spans go to the global tracer provider, which is a no-op until main
+// installs the one built by infrastructure.NewTracerProvider.
+package tracing
+
+// TracerName is the instrumentation name of the spans of the service.
+const TracerName = "github.com/vnnyx/golang-dot-api"
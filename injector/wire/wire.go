@@ -5,59 +5,133 @@ package wire
 
 import (
 	"github.com/google/wire"
+	"github.com/vnnyx/golang-dot-api/application"
+	attachmentController "github.com/vnnyx/golang-dot-api/controller/attachment"
 	authController "github.com/vnnyx/golang-dot-api/controller/auth"
+	budgetController "github.com/vnnyx/golang-dot-api/controller/budget"
+	categoryController "github.com/vnnyx/golang-dot-api/controller/category"
+	commentController "github.com/vnnyx/golang-dot-api/controller/comment"
+	disputeController "github.com/vnnyx/golang-dot-api/controller/dispute"
+	exportController "github.com/vnnyx/golang-dot-api/controller/export"
+	healthController "github.com/vnnyx/golang-dot-api/controller/health"
+	importController "github.com/vnnyx/golang-dot-api/controller/importer"
+	recurringController "github.com/vnnyx/golang-dot-api/controller/recurring"
+	reportController "github.com/vnnyx/golang-dot-api/controller/report"
+	searchController "github.com/vnnyx/golang-dot-api/controller/search"
+	statementController "github.com/vnnyx/golang-dot-api/controller/statement"
+	tagController "github.com/vnnyx/golang-dot-api/controller/tag"
 	transactionController "github.com/vnnyx/golang-dot-api/controller/transaction"
 	userController "github.com/vnnyx/golang-dot-api/controller/user"
 	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/event"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	"github.com/vnnyx/golang-dot-api/infrastructure/notifier"
+	"github.com/vnnyx/golang-dot-api/infrastructure/search"
 	authMiddleware "github.com/vnnyx/golang-dot-api/middleware"
+	attachmentRepository "github.com/vnnyx/golang-dot-api/repository/attachment"
 	authRepository "github.com/vnnyx/golang-dot-api/repository/auth"
+	budgetRepository "github.com/vnnyx/golang-dot-api/repository/budget"
+	categoryRepository "github.com/vnnyx/golang-dot-api/repository/category"
+	commentRepository "github.com/vnnyx/golang-dot-api/repository/comment"
+	disputeRepository "github.com/vnnyx/golang-dot-api/repository/dispute"
+	exchangeRateRepository "github.com/vnnyx/golang-dot-api/repository/exchange"
+	lockRepository "github.com/vnnyx/golang-dot-api/repository/lock"
+	recurringRepository "github.com/vnnyx/golang-dot-api/repository/recurring"
+	reportRepository "github.com/vnnyx/golang-dot-api/repository/report"
+	tagRepository "github.com/vnnyx/golang-dot-api/repository/tag"
 	transactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction"
 	userRepository "github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/scheduler"
+	attachmentService "github.com/vnnyx/golang-dot-api/service/attachment"
 	authService "github.com/vnnyx/golang-dot-api/service/auth"
+	budgetService "github.com/vnnyx/golang-dot-api/service/budget"
+	categoryService "github.com/vnnyx/golang-dot-api/service/category"
+	commentService "github.com/vnnyx/golang-dot-api/service/comment"
+	disputeService "github.com/vnnyx/golang-dot-api/service/dispute"
+	exchangeRateService "github.com/vnnyx/golang-dot-api/service/exchange"
+	exportService "github.com/vnnyx/golang-dot-api/service/export"
+	importService "github.com/vnnyx/golang-dot-api/service/importer"
+	recurringService "github.com/vnnyx/golang-dot-api/service/recurring"
+	reportService "github.com/vnnyx/golang-dot-api/service/report"
+	searchService "github.com/vnnyx/golang-dot-api/service/search"
+	statementService "github.com/vnnyx/golang-dot-api/service/statement"
+	tagService "github.com/vnnyx/golang-dot-api/service/tag"
 	transactionService "github.com/vnnyx/golang-dot-api/service/transaction"
 	userService "github.com/vnnyx/golang-dot-api/service/user"
+	"gorm.io/gorm"
 )
 
-func InitializeUserController(configName string) userController.UserController {
+// InitializeApplication builds the whole application graph, so the config,
+// the MySQL pool, the Redis client and every repository and service are
+// created once and shared.
+func InitializeApplication(configName string) *application.Application {
 	wire.Build(
 		infrastructure.NewConfig,
+		infrastructure.NewLogger,
+		infrastructure.NewTracerProvider,
 		infrastructure.NewMySQLDatabase,
 		infrastructure.NewRedisClient,
-		transactionRepository.NewTransactionRepository,
-		userRepository.NewUserRepository,
+		infrastructure.NewStorage,
+		notifier.NewLogNotifier,
+		event.NewLogPublisher,
+		search.NewMySQLIndex,
+		health.NewDependencyChecker,
+		attachmentRepository.NewAttachmentRepository,
 		authRepository.NewAuthRepository,
-		authMiddleware.NewAuthMiddleware,
-		userService.NewUserService,
-		userController.NewUserController,
-	)
-	return nil
-}
-
-func InitializeTransactionController(configName string) transactionController.TransactionController {
-	wire.Build(
-		infrastructure.NewConfig,
-		infrastructure.NewMySQLDatabase,
-		infrastructure.NewRedisClient,
+		budgetRepository.NewBudgetRepository,
+		categoryRepository.NewCategoryRepository,
+		commentRepository.NewCommentRepository,
+		disputeRepository.NewDisputeRepository,
+		exchangeRateRepository.NewExchangeRateRepository,
+		lockRepository.NewLockRepository,
+		recurringRepository.NewRecurringRepository,
+		reportRepository.NewReportRepository,
+		tagRepository.NewTagRepository,
 		transactionRepository.NewTransactionRepository,
 		userRepository.NewUserRepository,
-		authRepository.NewAuthRepository,
 		authMiddleware.NewAuthMiddleware,
+		attachmentService.NewAttachmentService,
+		authService.NewAuthService,
+		budgetService.NewBudgetService,
+		categoryService.NewCategoryService,
+		commentService.NewCommentService,
+		disputeService.NewDisputeService,
+		exportService.NewExportService,
+		importService.NewImportService,
+		recurringService.NewRecurringService,
+		reportService.NewReportService,
+		searchService.NewSearchService,
+		statementService.NewStatementService,
+		tagService.NewTagService,
 		transactionService.NewTransactionService,
+		userService.NewUserService,
+		attachmentController.NewAttachmentController,
+		authController.NewAuthController,
+		budgetController.NewBudgetController,
+		categoryController.NewCategoryController,
+		commentController.NewCommentController,
+		disputeController.NewDisputeController,
+		exportController.NewExportController,
+		healthController.NewHealthController,
+		importController.NewImportController,
+		recurringController.NewRecurringController,
+		reportController.NewReportController,
+		searchController.NewSearchController,
+		statementController.NewStatementController,
+		tagController.NewTagController,
 		transactionController.NewTransactionController,
+		userController.NewUserController,
+		scheduler.NewRecurringScheduler,
+		wire.Struct(new(application.Controllers), "*"),
+		application.NewApplication,
 	)
 	return nil
 }
 
-func InitializeAuthController(configName string) authController.AuthController {
+func InitializeExchangeRateService(db *gorm.DB) exchangeRateService.ExchangeRateService {
 	wire.Build(
-		infrastructure.NewConfig,
-		infrastructure.NewMySQLDatabase,
-		infrastructure.NewRedisClient,
-		userRepository.NewUserRepository,
-		authRepository.NewAuthRepository,
-		authMiddleware.NewAuthMiddleware,
-		authService.NewAuthService,
-		authController.NewAuthController,
+		exchangeRateRepository.NewExchangeRateRepository,
+		exchangeRateService.NewExchangeRateService,
 	)
 	return nil
 }
@@ -7,55 +7,148 @@
 package wire
 
 import (
+	"github.com/vnnyx/golang-dot-api/application"
+	"github.com/vnnyx/golang-dot-api/controller/attachment"
 	auth2 "github.com/vnnyx/golang-dot-api/controller/auth"
+	budget2 "github.com/vnnyx/golang-dot-api/controller/budget"
+	category2 "github.com/vnnyx/golang-dot-api/controller/category"
+	comment2 "github.com/vnnyx/golang-dot-api/controller/comment"
+	dispute2 "github.com/vnnyx/golang-dot-api/controller/dispute"
+	export2 "github.com/vnnyx/golang-dot-api/controller/export"
+	health2 "github.com/vnnyx/golang-dot-api/controller/health"
+	"github.com/vnnyx/golang-dot-api/controller/importer"
+	recurring2 "github.com/vnnyx/golang-dot-api/controller/recurring"
+	report2 "github.com/vnnyx/golang-dot-api/controller/report"
+	search2 "github.com/vnnyx/golang-dot-api/controller/search"
+	statement2 "github.com/vnnyx/golang-dot-api/controller/statement"
+	tag2 "github.com/vnnyx/golang-dot-api/controller/tag"
 	transaction2 "github.com/vnnyx/golang-dot-api/controller/transaction"
 	"github.com/vnnyx/golang-dot-api/controller/user"
 	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/event"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	"github.com/vnnyx/golang-dot-api/infrastructure/notifier"
+	"github.com/vnnyx/golang-dot-api/infrastructure/search"
 	"github.com/vnnyx/golang-dot-api/middleware"
+	attachment2 "github.com/vnnyx/golang-dot-api/repository/attachment"
 	"github.com/vnnyx/golang-dot-api/repository/auth"
+	"github.com/vnnyx/golang-dot-api/repository/budget"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/comment"
+	"github.com/vnnyx/golang-dot-api/repository/dispute"
+	"github.com/vnnyx/golang-dot-api/repository/exchange"
+	"github.com/vnnyx/golang-dot-api/repository/lock"
+	"github.com/vnnyx/golang-dot-api/repository/recurring"
+	"github.com/vnnyx/golang-dot-api/repository/report"
+	"github.com/vnnyx/golang-dot-api/repository/tag"
 	"github.com/vnnyx/golang-dot-api/repository/transaction"
 	user2 "github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/scheduler"
+	attachment3 "github.com/vnnyx/golang-dot-api/service/attachment"
 	auth3 "github.com/vnnyx/golang-dot-api/service/auth"
+	budget3 "github.com/vnnyx/golang-dot-api/service/budget"
+	category3 "github.com/vnnyx/golang-dot-api/service/category"
+	comment3 "github.com/vnnyx/golang-dot-api/service/comment"
+	dispute3 "github.com/vnnyx/golang-dot-api/service/dispute"
+	exchange3 "github.com/vnnyx/golang-dot-api/service/exchange"
+	export3 "github.com/vnnyx/golang-dot-api/service/export"
+	importer2 "github.com/vnnyx/golang-dot-api/service/importer"
+	recurring3 "github.com/vnnyx/golang-dot-api/service/recurring"
+	report3 "github.com/vnnyx/golang-dot-api/service/report"
+	search3 "github.com/vnnyx/golang-dot-api/service/search"
+	statement3 "github.com/vnnyx/golang-dot-api/service/statement"
+	tag3 "github.com/vnnyx/golang-dot-api/service/tag"
 	transaction3 "github.com/vnnyx/golang-dot-api/service/transaction"
 	user3 "github.com/vnnyx/golang-dot-api/service/user"
+	"gorm.io/gorm"
 )
 
 // Injectors from wire.go:
 
-func InitializeUserController(configName string) user.UserController {
+// InitializeApplication builds the whole application graph, so the config,
+// the MySQL pool, the Redis client and every repository and service are
+// created once and shared.
+func InitializeApplication(configName string) *application.Application {
 	config := infrastructure.NewConfig(configName)
-	db := infrastructure.NewMySQLDatabase(config)
-	userRepository := user2.NewUserRepository(db)
+	logger := infrastructure.NewLogger(config)
+	tracerProvider := infrastructure.NewTracerProvider(config)
+	db := infrastructure.NewMySQLDatabase(config, logger)
+	client := infrastructure.NewRedisClient(config)
+	checker := health.NewDependencyChecker(config, db, client)
+	recurringRepository := recurring.NewRecurringRepository(db)
+	categoryRepository := category.NewCategoryRepository(db)
 	transactionRepository := transaction.NewTransactionRepository(db)
+	userRepository := user2.NewUserRepository(db)
+	tagRepository := tag.NewTagRepository(db)
+	budgetRepository := budget.NewBudgetRepository(db)
+	notifierNotifier := notifier.NewLogNotifier()
+	transactionService := transaction3.NewTransactionService(transactionRepository, userRepository, categoryRepository, tagRepository, budgetRepository, notifierNotifier)
+	recurringService := recurring3.NewRecurringService(recurringRepository, categoryRepository, transactionService)
+	lockRepository := lock.NewLockRepository(client)
+	recurringScheduler := scheduler.NewRecurringScheduler(recurringService, lockRepository, config)
 	userService := user3.NewUserService(userRepository, transactionRepository, db)
-	client := infrastructure.NewRedisClient(configName)
 	authRepository := auth.NewAuthRepository(client)
-	authMiddleware := middleware.NewAuthMiddleware(authRepository, userRepository, configName)
+	authMiddleware := middleware.NewAuthMiddleware(authRepository, userRepository, config)
 	userController := user.NewUserController(userService, authMiddleware)
-	return userController
-}
-
-func InitializeTransactionController(configName string) transaction2.TransactionController {
-	config := infrastructure.NewConfig(configName)
-	db := infrastructure.NewMySQLDatabase(config)
-	transactionRepository := transaction.NewTransactionRepository(db)
-	userRepository := user2.NewUserRepository(db)
-	transactionService := transaction3.NewTransactionService(transactionRepository, userRepository)
-	client := infrastructure.NewRedisClient(configName)
-	authRepository := auth.NewAuthRepository(client)
-	authMiddleware := middleware.NewAuthMiddleware(authRepository, userRepository, configName)
 	transactionController := transaction2.NewTransactionController(transactionService, authMiddleware)
-	return transactionController
-}
-
-func InitializeAuthController(configName string) auth2.AuthController {
-	config := infrastructure.NewConfig(configName)
-	db := infrastructure.NewMySQLDatabase(config)
-	userRepository := user2.NewUserRepository(db)
-	client := infrastructure.NewRedisClient(configName)
-	authRepository := auth.NewAuthRepository(client)
 	authService := auth3.NewAuthService(config, db, userRepository, authRepository)
-	authMiddleware := middleware.NewAuthMiddleware(authRepository, userRepository, configName)
 	authController := auth2.NewAuthController(authService, authMiddleware)
-	return authController
+	categoryService := category3.NewCategoryService(categoryRepository)
+	categoryController := category2.NewCategoryController(categoryService, authMiddleware)
+	tagService := tag3.NewTagService(tagRepository)
+	tagController := tag2.NewTagController(tagService, authMiddleware)
+	recurringController := recurring2.NewRecurringController(recurringService, authMiddleware)
+	attachmentRepository := attachment2.NewAttachmentRepository(db)
+	storage := infrastructure.NewStorage(config)
+	attachmentService := attachment3.NewAttachmentService(attachmentRepository, transactionRepository, storage, config)
+	attachmentController := attachment.NewAttachmentController(attachmentService, authMiddleware)
+	commentRepository := comment.NewCommentRepository(db)
+	commentService := comment3.NewCommentService(commentRepository, transactionRepository, userRepository)
+	commentController := comment2.NewCommentController(commentService, authMiddleware)
+	disputeRepository := dispute.NewDisputeRepository(db)
+	publisher := event.NewLogPublisher()
+	disputeService := dispute3.NewDisputeService(disputeRepository, transactionRepository, attachmentRepository, userRepository, publisher)
+	disputeController := dispute2.NewDisputeController(disputeService, authMiddleware)
+	budgetService := budget3.NewBudgetService(budgetRepository, categoryRepository, transactionRepository)
+	budgetController := budget2.NewBudgetController(budgetService, authMiddleware)
+	reportRepository := report.NewReportRepository(client)
+	exchangeRateRepository := exchange.NewExchangeRateRepository(db)
+	reportService := report3.NewReportService(transactionRepository, reportRepository, exchangeRateRepository, config)
+	reportController := report2.NewReportController(reportService, authMiddleware)
+	exportService := export3.NewExportService(transactionRepository, userRepository, categoryRepository)
+	exportController := export2.NewExportController(exportService, authMiddleware)
+	importService := importer2.NewImportService(transactionRepository, categoryRepository)
+	importController := importer.NewImportController(importService, authMiddleware)
+	statementService := statement3.NewStatementService(userRepository, transactionRepository, exchangeRateRepository)
+	statementController := statement2.NewStatementController(statementService, authMiddleware)
+	index := search.NewMySQLIndex(db)
+	searchService := search3.NewSearchService(index)
+	searchController := search2.NewSearchController(searchService, authMiddleware)
+	healthController := health2.NewHealthController(checker)
+	controllers := application.Controllers{
+		User:        userController,
+		Transaction: transactionController,
+		Auth:        authController,
+		Category:    categoryController,
+		Tag:         tagController,
+		Recurring:   recurringController,
+		Attachment:  attachmentController,
+		Comment:     commentController,
+		Dispute:     disputeController,
+		Budget:      budgetController,
+		Report:      reportController,
+		Export:      exportController,
+		Import:      importController,
+		Statement:   statementController,
+		Search:      searchController,
+		Health:      healthController,
+	}
+	applicationApplication := application.NewApplication(config, logger, tracerProvider, db, client, checker, recurringScheduler, transactionService, controllers)
+	return applicationApplication
+}
+
+func InitializeExchangeRateService(db *gorm.DB) exchange3.ExchangeRateService {
+	exchangeRateRepository := exchange.NewExchangeRateRepository(db)
+	exchangeRateService := exchange3.NewExchangeRateService(exchangeRateRepository)
+	return exchangeRateService
 }
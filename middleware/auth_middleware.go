@@ -2,18 +2,23 @@ package middleware
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
+	"net/http"
 	"strings"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
 	"github.com/vnnyx/golang-dot-api/infrastructure"
-	"github.com/vnnyx/golang-dot-api/model/web"
 	"github.com/vnnyx/golang-dot-api/repository/auth"
 	"github.com/vnnyx/golang-dot-api/repository/user"
 )
 
+var ErrUnauthorized = apperror.New(http.StatusUnauthorized, "UNAUTHORIZED", "message", "Unauthorized")
+
 type DecodedStructure struct {
 	UserID     string `json:"id"`
 	Username   string `json:"username"`
@@ -23,25 +28,22 @@ type DecodedStructure struct {
 type AuthMiddleware struct {
 	auth.AuthRepository
 	user.UserRepository
-	ConfigName string
+	JWTPublicKey *rsa.PublicKey
 }
 
-func NewAuthMiddleware(authRepository auth.AuthRepository, userRepository user.UserRepository, configName string) *AuthMiddleware {
-	return &AuthMiddleware{AuthRepository: authRepository, UserRepository: userRepository, ConfigName: configName}
+// NewAuthMiddleware parses the JWT public key of the configuration once, and
+// panics if it is invalid.
+func NewAuthMiddleware(authRepository auth.AuthRepository, userRepository user.UserRepository, configuration *infrastructure.Config) *AuthMiddleware {
+	jwtPublicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(configuration.JWTPublicKey))
+	exception.PanicIfNeeded(err)
+	return &AuthMiddleware{AuthRepository: authRepository, UserRepository: userRepository, JWTPublicKey: jwtPublicKey}
 }
 
 func (middleware *AuthMiddleware) ValidateToken(encodedToken string) (token *jwt.Token, errData error) {
-	configuration := infrastructure.NewConfig(middleware.ConfigName)
-	jwtPublicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(configuration.JWTPublicKey))
-
-	if err != nil {
-		return token, err
-	}
-
 	tokenString := encodedToken
 	claims := jwt.MapClaims{}
-	token, err = jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return jwtPublicKey, nil
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return middleware.JWTPublicKey, nil
 	})
 	if err != nil {
 		return token, err
@@ -53,12 +55,10 @@ func (middleware *AuthMiddleware) ValidateToken(encodedToken string) (token *jwt
 }
 
 func (middleware *AuthMiddleware) DecodeToken(encodedToken string) (decodedResult DecodedStructure, errData error) {
-	configuration := infrastructure.NewConfig(middleware.ConfigName)
-	jwtPublicKey, _ := jwt.ParseRSAPublicKeyFromPEM([]byte(configuration.JWTPublicKey))
 	tokenString := encodedToken
 	claims := jwt.MapClaims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		return jwtPublicKey, nil
+		return middleware.JWTPublicKey, nil
 	})
 	if err != nil {
 		return decodedResult, err
@@ -93,28 +93,29 @@ func (middleware *AuthMiddleware) CheckToken(next echo.HandlerFunc) echo.Handler
 		//validate token
 		_, err := middleware.ValidateToken(tokenString)
 		if err != nil {
-			return errors.New(web.UNAUTHORIZATION)
+			return ErrUnauthorized
 		}
 
 		//extract data from token
 		decodeRes, err := middleware.DecodeToken(tokenString)
 		if err != nil {
-			return errors.New(web.UNAUTHORIZATION)
+			return ErrUnauthorized
 		}
-		_, err = middleware.UserRepository.FindUserByID(context.Background(), decodeRes.UserID)
+		currentUser, err := middleware.UserRepository.FindUserByID(context.Background(), decodeRes.UserID)
 		if err != nil {
-			return errors.New(web.UNAUTHORIZATION)
+			return ErrUnauthorized
 		}
 
 		_, err = middleware.AuthRepository.GetToken(context.TODO(), decodeRes.AccessUUID)
 		if err != nil {
-			return errors.New(web.UNAUTHORIZATION)
+			return ErrUnauthorized
 		}
 
 		//set global variable
 		ctx.Set("currentId", decodeRes.UserID)
 		ctx.Set("currentUsername", decodeRes.Username)
 		ctx.Set("currentAccessUUID", decodeRes.AccessUUID)
+		ctx.Set("currentLocale", currentUser.Locale)
 
 		return next(ctx)
 	}
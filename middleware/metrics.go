@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+)
+
+// MetricsPath is where Prometheus scrapes the metrics. Its own requests are
+// not observed.
+const MetricsPath = "/metrics"
+
+// Metrics observes the duration of every request by method, route template
+// and status. Requests that match no route share the "unmatched" route, so
+// unknown paths cannot grow the number of series.
+func Metrics() echo.MiddlewareFunc {
+	var once sync.Once
+	routes := map[string]bool{}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if ctx.Request().URL.Path == MetricsPath {
+				return next(ctx)
+			}
+			start := time.Now()
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+
+			// Echo reports the raw path of requests that match no route, so
+			// only the paths of the routes registered by the time of the first
+			// request are used as labels.
+			once.Do(func() {
+				for _, registered := range ctx.Echo().Routes() {
+					routes[registered.Path] = true
+				}
+			})
+			route := ctx.Path()
+			if !routes[route] {
+				route = "unmatched"
+			}
+			metrics.HTTPRequestDuration.
+				WithLabelValues(ctx.Request().Method, route, strconv.Itoa(ctx.Response().Status)).
+				Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
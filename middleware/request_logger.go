@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// RequestID reuses the X-Request-ID header of the request, or generates one,
+// echoes it in the response and stores it in the request context so logs
+// written while serving the request carry it.
+func RequestID() echo.MiddlewareFunc {
+	return middleware.RequestIDWithConfig(middleware.RequestIDConfig{
+		RequestIDHandler: func(ctx echo.Context, requestID string) {
+			ctx.Set("requestId", requestID)
+			request := ctx.Request()
+			ctx.SetRequest(request.WithContext(infrastructure.ContextWithRequestID(request.Context(), requestID)))
+		},
+	})
+}
+
+// RequestLogger logs one line per request with its request ID, status,
+// latency and, for authenticated requests, the user. It must run after
+// RequestID, and after Tracing to log the trace ID of sampled requests. The
+// values of sensitive query parameters are redacted.
+func RequestLogger(logger *zap.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			start := time.Now()
+			err := next(ctx)
+			if err != nil {
+				// Let the error handler write the response, so the status is known.
+				ctx.Error(err)
+			}
+			latency := time.Since(start)
+
+			request := ctx.Request()
+			response := ctx.Response()
+			level := zapcore.InfoLevel
+			switch {
+			case response.Status >= 500:
+				level = zapcore.ErrorLevel
+			case response.Status >= 400:
+				level = zapcore.WarnLevel
+			}
+			if checked := logger.Check(level, "request"); checked != nil {
+				userId, _ := ctx.Get("currentId").(string)
+				requestId, _ := ctx.Get("requestId").(string)
+				traceId := zap.Skip()
+				if spanContext := trace.SpanContextFromContext(request.Context()); spanContext.IsSampled() {
+					traceId = zap.String("trace_id", spanContext.TraceID().String())
+				}
+				checked.Write(
+					zap.String("request_id", requestId),
+					traceId,
+					zap.String("method", request.Method),
+					zap.String("route", ctx.Path()),
+					zap.String("uri", redactURI(request.URL)),
+					zap.Int("status", response.Status),
+					zap.Float64("latency_ms", float64(latency.Microseconds())/1000),
+					zap.Int64("bytes_out", response.Size),
+					zap.String("remote_ip", ctx.RealIP()),
+					zap.String("user_agent", request.UserAgent()),
+					zap.String("user_id", userId),
+				)
+			}
+			return nil
+		}
+	}
+}
+
+// redactURI returns the path and query of uri with the values of sensitive
+// query parameters, such as ?token=, redacted.
+func redactURI(uri *url.URL) string {
+	query := uri.Query()
+	redacted := false
+	for key := range query {
+		if infrastructure.IsSensitiveKey(key) {
+			query[key] = []string{infrastructure.Redacted}
+			redacted = true
+		}
+	}
+	if !redacted {
+		return uri.RequestURI()
+	}
+	copied := *uri
+	copied.RawQuery = query.Encode()
+	return copied.RequestURI()
+}
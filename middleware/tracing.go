@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/infrastructure/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts a server span for every request, continuing the trace of
+// its traceparent header, and stores it in the request context so the spans
+// of GORM and Redis become its children.
+func Tracing() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			request := ctx.Request()
+			if request.URL.Path == MetricsPath {
+				return next(ctx)
+			}
+			parent := otel.GetTextMapPropagator().Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+			spanContext, span := otel.Tracer(tracing.TracerName).Start(parent, fmt.Sprintf("%s %s", request.Method, ctx.Path()),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPMethodKey.String(request.Method),
+					semconv.HTTPRouteKey.String(ctx.Path()),
+					semconv.HTTPTargetKey.String(request.URL.Path),
+				),
+			)
+			defer span.End()
+			ctx.SetRequest(request.WithContext(spanContext))
+
+			err := next(ctx)
+			if err != nil {
+				ctx.Error(err)
+			}
+
+			status := ctx.Response().Status
+			span.SetAttributes(semconv.HTTPStatusCodeKey.Int(status))
+			if status >= 500 {
+				span.SetStatus(codes.Error, fmt.Sprintf("status %d", status))
+			}
+			if err != nil {
+				span.RecordError(err)
+			}
+			return nil
+		}
+	}
+}
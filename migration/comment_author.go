@@ -0,0 +1,26 @@
+package migration
+
+import (
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+// MigrateCommentAuthor recreates the foreign key from comments to their
+// author where it still deletes the comments with the author. AutoMigrate
+// keeps an existing constraint as it is.
+func MigrateCommentAuthor(db *gorm.DB) {
+	var deleteRule string
+	err := db.Raw(
+		"SELECT delete_rule FROM information_schema.referential_constraints WHERE constraint_schema = DATABASE() AND table_name = ? AND constraint_name = ?",
+		entity.Comment{}.TableName(), "fk_comments_author",
+	).Scan(&deleteRule).Error
+	exception.PanicIfNeeded(err)
+	if deleteRule != "CASCADE" {
+		return
+	}
+
+	migrator := db.Migrator()
+	exception.PanicIfNeeded(migrator.DropConstraint(&entity.Comment{}, "Author"))
+	exception.PanicIfNeeded(migrator.CreateConstraint(&entity.Comment{}, "Author"))
+}
@@ -5,11 +5,11 @@ import (
 	"gorm.io/gorm"
 )
 
+// Migrate runs AutoMigrate for every table so columns and constraints added
+// to existing entities are applied to databases created by older releases.
 func Migrate(db *gorm.DB, tables ...interface{}) {
 	for _, table := range tables {
-		if !db.Migrator().HasTable(table) {
-			err := db.Debug().AutoMigrate(table)
-			exception.PanicIfNeeded(err)
-		}
+		err := db.Debug().AutoMigrate(table)
+		exception.PanicIfNeeded(err)
 	}
 }
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+type Attachment struct {
+	AttachmentID  string       `gorm:"column:attachment_id;primaryKey;type:varchar(255)"`
+	TransactionID string       `gorm:"column:transaction_id;type:varchar(255);index"`
+	FileName      string       `gorm:"column:file_name;type:varchar(255)"`
+	ContentType   string       `gorm:"column:content_type;type:varchar(100)"`
+	Size          int64        `gorm:"column:size"`
+	StorageKey    string       `gorm:"column:storage_key;type:varchar(512)"`
+	CreatedAt     time.Time    `gorm:"column:created_at"`
+	Transaction   *Transaction `gorm:"foreignKey:TransactionID;references:TransactionID;constraint:OnDelete:CASCADE"`
+}
+
+func (Attachment) TableName() string {
+	return "attachments"
+}
@@ -0,0 +1,36 @@
+package entity
+
+import "time"
+
+// Budget caps the monthly spending of a user in a category, including the
+// categories nested below it. Months start at midnight in Timezone, and only
+// transactions in Currency count towards it.
+type Budget struct {
+	BudgetID   string    `gorm:"column:budget_id;primaryKey;type:varchar(255)"`
+	UserID     string    `gorm:"column:user_id;type:varchar(255);uniqueIndex:idx_budgets_user_category"`
+	CategoryID string    `gorm:"column:category_id;type:varchar(255);uniqueIndex:idx_budgets_user_category"`
+	Amount     int64     `gorm:"column:amount"`
+	Currency   string    `gorm:"column:currency;type:char(3);default:IDR"`
+	Timezone   string    `gorm:"column:timezone;type:varchar(64)"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+	User       *User     `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+	Category   *Category `gorm:"foreignKey:CategoryID;references:CategoryID;constraint:OnDelete:CASCADE"`
+}
+
+func (Budget) TableName() string {
+	return "budgets"
+}
+
+// BudgetAlert records that a threshold alert was sent for a budget period so
+// it is only sent once per month.
+type BudgetAlert struct {
+	BudgetID  string    `gorm:"column:budget_id;primaryKey;type:varchar(255)"`
+	Period    string    `gorm:"column:period;primaryKey;type:varchar(7)"`
+	Threshold int       `gorm:"column:threshold;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	Budget    *Budget   `gorm:"foreignKey:BudgetID;references:BudgetID;constraint:OnDelete:CASCADE"`
+}
+
+func (BudgetAlert) TableName() string {
+	return "budget_alerts"
+}
@@ -0,0 +1,14 @@
+package entity
+
+type Category struct {
+	CategoryID string    `gorm:"column:category_id;primaryKey;type:varchar(255)"`
+	UserID     string    `gorm:"column:user_id;type:varchar(255);index"`
+	ParentID   *string   `gorm:"column:parent_id;type:varchar(255)"`
+	Name       string    `gorm:"column:name;type:varchar(50)"`
+	User       *User     `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+	Parent     *Category `gorm:"foreignKey:ParentID;references:CategoryID;constraint:OnDelete:SET NULL"`
+}
+
+func (Category) TableName() string {
+	return "categories"
+}
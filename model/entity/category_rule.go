@@ -0,0 +1,24 @@
+package entity
+
+const (
+	RuleMatchContains = "contains"
+	RuleMatchPrefix   = "prefix"
+	RuleMatchSuffix   = "suffix"
+	RuleMatchExact    = "exact"
+	RuleMatchRegex    = "regex"
+)
+
+type CategoryRule struct {
+	RuleID     string    `gorm:"column:rule_id;primaryKey;type:varchar(255)"`
+	UserID     string    `gorm:"column:user_id;type:varchar(255);index"`
+	CategoryID string    `gorm:"column:category_id;type:varchar(255)"`
+	MatchType  string    `gorm:"column:match_type;type:varchar(20)"`
+	Pattern    string    `gorm:"column:pattern;type:varchar(255)"`
+	Priority   int       `gorm:"column:priority"`
+	User       *User     `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+	Category   *Category `gorm:"foreignKey:CategoryID;references:CategoryID;constraint:OnDelete:CASCADE"`
+}
+
+func (CategoryRule) TableName() string {
+	return "category_rules"
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Comment is a note in the discussion thread of a transaction. Deleting a
+// comment only sets DeletedAt, and every edit keeps the replaced body as a
+// CommentRevision, so the thread can be reviewed in full later. Comments
+// outlive their author: AuthorID becomes nil when the author is deleted.
+type Comment struct {
+	CommentID     string            `gorm:"column:comment_id;primaryKey;type:varchar(255)"`
+	TransactionID string            `gorm:"column:transaction_id;type:varchar(255);index"`
+	AuthorID      *string           `gorm:"column:author_id;type:varchar(255);index"`
+	Body          string            `gorm:"column:body;type:text"`
+	CreatedAt     time.Time         `gorm:"column:created_at"`
+	UpdatedAt     time.Time         `gorm:"column:updated_at"`
+	DeletedAt     gorm.DeletedAt    `gorm:"column:deleted_at;index"`
+	Author        *User             `gorm:"foreignKey:AuthorID;references:UserID;constraint:OnDelete:SET NULL"`
+	Transaction   *Transaction      `gorm:"foreignKey:TransactionID;references:TransactionID;constraint:OnDelete:CASCADE"`
+	Revisions     []CommentRevision `gorm:"foreignKey:CommentID;references:CommentID;constraint:OnDelete:CASCADE"`
+}
+
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// AuthoredBy reports whether userId wrote the comment.
+func (comment Comment) AuthoredBy(userId string) bool {
+	return comment.AuthorID != nil && *comment.AuthorID == userId
+}
+
+// CommentRevision is a body a comment had before it was edited at
+// CreatedAt.
+type CommentRevision struct {
+	RevisionID string    `gorm:"column:revision_id;primaryKey;type:varchar(255)"`
+	CommentID  string    `gorm:"column:comment_id;type:varchar(255);index"`
+	Body       string    `gorm:"column:body;type:text"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (CommentRevision) TableName() string {
+	return "comment_revisions"
+}
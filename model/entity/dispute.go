@@ -0,0 +1,58 @@
+package entity
+
+import "time"
+
+const (
+	DisputeStatusOpen        = "open"
+	DisputeStatusUnderReview = "under_review"
+	DisputeStatusWon         = "won"
+	DisputeStatusLost        = "lost"
+)
+
+const (
+	DisputeReasonDuplicate       = "duplicate"
+	DisputeReasonFraudulent      = "fraudulent"
+	DisputeReasonNotReceived     = "not_received"
+	DisputeReasonIncorrectAmount = "incorrect_amount"
+	DisputeReasonCancelled       = "cancelled"
+	DisputeReasonOther           = "other"
+)
+
+// Dispute contests a transaction. While a dispute is open or under review
+// its transaction is on hold; resolving it as won or lost releases the hold.
+type Dispute struct {
+	DisputeID      string            `gorm:"column:dispute_id;primaryKey;type:varchar(255)"`
+	TransactionID  string            `gorm:"column:transaction_id;type:varchar(255);index"`
+	UserID         string            `gorm:"column:user_id;type:varchar(255)"`
+	ReasonCode     string            `gorm:"column:reason_code;type:varchar(30)"`
+	Description    string            `gorm:"column:description;type:text"`
+	Status         string            `gorm:"column:status;type:varchar(20);index"`
+	ResolutionNote string            `gorm:"column:resolution_note;type:text"`
+	ResolvedBy     *string           `gorm:"column:resolved_by;type:varchar(255)"`
+	ResolvedAt     *time.Time        `gorm:"column:resolved_at"`
+	CreatedAt      time.Time         `gorm:"column:created_at"`
+	UpdatedAt      time.Time         `gorm:"column:updated_at"`
+	Transaction    *Transaction      `gorm:"foreignKey:TransactionID;references:TransactionID;constraint:OnDelete:CASCADE"`
+	Evidence       []DisputeEvidence `gorm:"foreignKey:DisputeID;references:DisputeID;constraint:OnDelete:CASCADE"`
+}
+
+func (Dispute) TableName() string {
+	return "disputes"
+}
+
+// Active reports whether the dispute still holds its transaction.
+func (dispute Dispute) Active() bool {
+	return dispute.Status == DisputeStatusOpen || dispute.Status == DisputeStatusUnderReview
+}
+
+// DisputeEvidence links a dispute to an attachment of its transaction.
+type DisputeEvidence struct {
+	DisputeID    string      `gorm:"column:dispute_id;primaryKey;type:varchar(255)"`
+	AttachmentID string      `gorm:"column:attachment_id;primaryKey;type:varchar(255)"`
+	CreatedAt    time.Time   `gorm:"column:created_at"`
+	Attachment   *Attachment `gorm:"foreignKey:AttachmentID;references:AttachmentID;constraint:OnDelete:CASCADE"`
+}
+
+func (DisputeEvidence) TableName() string {
+	return "dispute_evidence"
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// ExchangeRate is how many units of QuoteCurrency one unit of BaseCurrency
+// bought on Date. Rate is kept as a decimal string so it is never rounded
+// through a float.
+type ExchangeRate struct {
+	Date          time.Time `gorm:"column:date;primaryKey;type:date"`
+	BaseCurrency  string    `gorm:"column:base_currency;primaryKey;type:char(3)"`
+	QuoteCurrency string    `gorm:"column:quote_currency;primaryKey;type:char(3)"`
+	Rate          string    `gorm:"column:rate;type:decimal(24,12)"`
+}
+
+func (ExchangeRate) TableName() string {
+	return "exchange_rates"
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// RecurringTransaction is a schedule of transactions. NextRunAt is the next
+// occurrence to create, or nil once the schedule is finished or disabled.
+// Failures counts the consecutive failed attempts at that occurrence; it is
+// not retried before RetryAt.
+type RecurringTransaction struct {
+	RecurringID string     `gorm:"column:recurring_id;primaryKey;type:varchar(255)"`
+	UserID      string     `gorm:"column:user_id;type:varchar(255)"`
+	Name        string     `gorm:"column:name;type:varchar(50)"`
+	Amount      int64      `gorm:"column:amount"`
+	Currency    string     `gorm:"column:currency;type:char(3);default:IDR"`
+	CategoryID  *string    `gorm:"column:category_id;type:varchar(255)"`
+	Tags        []string   `gorm:"column:tags;type:text;serializer:json"`
+	RRule       string     `gorm:"column:rrule;type:varchar(255)"`
+	Timezone    string     `gorm:"column:timezone;type:varchar(64)"`
+	StartAt     time.Time  `gorm:"column:start_at"`
+	NextRunAt   *time.Time `gorm:"column:next_run_at;index"`
+	Failures    int        `gorm:"column:failures;default:0"`
+	LastError   string     `gorm:"column:last_error;type:varchar(255)"`
+	RetryAt     *time.Time `gorm:"column:retry_at"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	User        *User      `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+	Category    *Category  `gorm:"foreignKey:CategoryID;references:CategoryID;constraint:OnDelete:SET NULL"`
+}
+
+func (RecurringTransaction) TableName() string {
+	return "recurring_transactions"
+}
@@ -0,0 +1,12 @@
+package entity
+
+type Tag struct {
+	TagID  string `gorm:"column:tag_id;primaryKey;type:varchar(255)"`
+	UserID string `gorm:"column:user_id;type:varchar(255);uniqueIndex:idx_tags_user_name"`
+	Name   string `gorm:"column:name;type:varchar(50);uniqueIndex:idx_tags_user_name"`
+	User   *User  `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (Tag) TableName() string {
+	return "tags"
+}
@@ -1,12 +1,26 @@
 package entity
 
+import "time"
+
 type Transaction struct {
-	TransactionID string `gorm:"column:transaction_id;primaryKey;type:varchar(255)"`
-	Name          string `gorm:"column:name;type:varchar(50)"`
-	UserID        string `gorm:"column:user_id;type:varchar(255)"`
-	User          *User  `gorm:"association_foreignkey:UserID;references:UserID"`
+	TransactionID string             `gorm:"column:transaction_id;primaryKey;type:varchar(255)"`
+	Name          string             `gorm:"column:name;type:varchar(50);index:idx_transactions_name,class:FULLTEXT"`
+	Amount        int64              `gorm:"column:amount"`
+	Currency      string             `gorm:"column:currency;type:char(3);default:IDR"`
+	UserID        string             `gorm:"column:user_id;type:varchar(255);index:idx_transactions_user_created,priority:1"`
+	CategoryID    *string            `gorm:"column:category_id;type:varchar(255)"`
+	SplitMethod   string             `gorm:"column:split_method;type:varchar(10)"`
+	OnHold        bool               `gorm:"column:on_hold;default:false"`
+	CreatedAt     time.Time          `gorm:"column:created_at;index;index:idx_transactions_user_created,priority:2"`
+	User          *User              `gorm:"association_foreignkey:UserID;references:UserID"`
+	Category      *Category          `gorm:"foreignKey:CategoryID;references:CategoryID;constraint:OnDelete:SET NULL"`
+	Tags          []Tag              `gorm:"many2many:transaction_tags;foreignKey:TransactionID;joinForeignKey:TransactionID;references:TagID;joinReferences:TagID;constraint:OnDelete:CASCADE"`
+	Splits        []TransactionSplit `gorm:"foreignKey:TransactionID;references:TransactionID;constraint:OnDelete:CASCADE"`
 }
 
+// DefaultCurrency is used for transactions created without a currency.
+const DefaultCurrency = "IDR"
+
 func (Transaction) TableName() string {
 	return "transactions"
 }
@@ -0,0 +1,22 @@
+package entity
+
+const (
+	SplitEqual   = "equal"
+	SplitExact   = "exact"
+	SplitPercent = "percent"
+)
+
+// TransactionSplit is the share of a transaction owed by one participant.
+// PercentBasisPoints is only set for percentage splits, in hundredths of a
+// percent, so the original request can be reconstructed without rounding.
+type TransactionSplit struct {
+	TransactionID      string `gorm:"column:transaction_id;primaryKey;type:varchar(255)"`
+	UserID             string `gorm:"column:user_id;primaryKey;type:varchar(255);index"`
+	Amount             int64  `gorm:"column:amount"`
+	PercentBasisPoints int64  `gorm:"column:percent_basis_points"`
+	User               *User  `gorm:"foreignKey:UserID;references:UserID;constraint:OnDelete:CASCADE"`
+}
+
+func (TransactionSplit) TableName() string {
+	return "transaction_splits"
+}
@@ -1,11 +1,22 @@
 package entity
 
+const (
+	UserRoleMember = "member"
+	// UserRoleAdmin can read every transaction's comments and remove any
+	// comment. Admins are promoted directly in the database.
+	UserRoleAdmin = "admin"
+)
+
 type User struct {
 	UserID    string `gorm:"column:user_id;primaryKey;type:varchar(255)"`
-	Username  string `gorm:"column:username;unique;type:varchar(50)"`
-	Email     string `gorm:"column:email;type:varchar(100);unique"`
+	Username  string `gorm:"column:username;unique;type:varchar(50);index:idx_users_search,class:FULLTEXT"`
+	Email     string `gorm:"column:email;type:varchar(100);unique;index:idx_users_search,class:FULLTEXT"`
 	Handphone string `gorm:"column:handphone;type:varchar(20)"`
 	Password  string `gorm:"column:password;type:varchar(255)"`
+	Role      string `gorm:"column:role;type:varchar(20);default:member"`
+	// Locale is the language error messages are sent in, overriding the
+	// Accept-Language header. Empty follows the header.
+	Locale string `gorm:"column:locale;type:varchar(10)"`
 }
 
 func (User) TableName() string {
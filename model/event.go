@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+const (
+	EventDisputeOpened      = "DISPUTE_OPENED"
+	EventDisputeUnderReview = "DISPUTE_UNDER_REVIEW"
+	EventDisputeWon         = "DISPUTE_WON"
+	EventDisputeLost        = "DISPUTE_LOST"
+)
+
+// Event records a change of state in the domain, such as a dispute moving to
+// a new status. AggregateID is the id of the record that changed.
+type Event struct {
+	EventID     string
+	Type        string
+	AggregateID string
+	UserID      string
+	OccurredAt  time.Time
+	Data        map[string]interface{}
+}
@@ -0,0 +1,9 @@
+package model
+
+// Formats of the files exchange rates can be loaded from.
+const (
+	RateFormatCSV = "csv"
+	// RateFormatECB is the European Central Bank euro reference rate XML,
+	// e.g. eurofxref-daily.xml or eurofxref-hist.xml.
+	RateFormatECB = "ecb"
+)
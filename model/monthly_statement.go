@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// MonthlyStatement is everything rendered on a user's monthly statement.
+// Balances are running totals of the user's own spending per currency.
+type MonthlyStatement struct {
+	UserID      string
+	Username    string
+	Email       string
+	Handphone   string
+	Period      time.Time
+	Timezone    string
+	GeneratedAt time.Time
+	Balances    []StatementBalance
+	// Total, when set, is the sum of all Balances converted into its
+	// currency at the exchange rates of RatesDate.
+	Total        *StatementBalance
+	RatesDate    time.Time
+	Transactions []StatementLine
+}
+
+type StatementBalance struct {
+	Currency string
+	Opening  int64
+	Spent    int64
+	Closing  int64
+}
+
+type StatementLine struct {
+	Date     time.Time
+	Name     string
+	Category string
+	Currency string
+	Amount   int64
+}
+
+// CurrencyTotal is the summed amount of transactions in one currency.
+type CurrencyTotal struct {
+	Currency string
+	Amount   int64
+}
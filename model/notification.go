@@ -0,0 +1,10 @@
+package model
+
+const NotificationBudgetThreshold = "BUDGET_THRESHOLD"
+
+type Notification struct {
+	UserID  string
+	Type    string
+	Message string
+	Data    map[string]interface{}
+}
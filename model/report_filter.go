@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Report grouping keys accepted by the reporting API.
+const (
+	ReportGroupDay      = "day"
+	ReportGroupWeek     = "week"
+	ReportGroupMonth    = "month"
+	ReportGroupCategory = "category"
+	ReportGroupCurrency = "currency"
+	ReportGroupUser     = "user"
+)
+
+// ReportFilter selects the transactions created within [From, To) and how
+// they are grouped. Offset is the UTC offset ("+07:00") used to bucket
+// created_at into days, weeks and months.
+type ReportFilter struct {
+	From    time.Time
+	To      time.Time
+	Offset  string
+	GroupBy []string
+}
+
+// ReportRow is one aggregated group. Only the fields named in GroupBy are set.
+type ReportRow struct {
+	Period     string
+	CategoryID string
+	Currency   string
+	UserID     string
+	Sum        int64
+	Count      int64
+	Min        int64
+	Max        int64
+}
@@ -0,0 +1,22 @@
+package model
+
+// Search types accepted by the search API.
+const (
+	SearchTypeTransaction = "transaction"
+	SearchTypeUser        = "user"
+)
+
+// SearchQuery matches documents containing a word that starts with each of
+// Terms. Terms are lower case letters, digits and underscores.
+type SearchQuery struct {
+	Terms []string
+	Limit int
+}
+
+// SearchHit is a matching document with the text of its searchable fields,
+// keyed by field name.
+type SearchHit struct {
+	ID     string
+	Score  float64
+	Fields map[string]string
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// Statement formats accepted by the import API.
+const (
+	StatementCSV = "csv"
+	StatementOFX = "ofx"
+	StatementQIF = "qif"
+)
+
+// StatementEntry is one transaction read from a bank statement. Amount is
+// the raw decimal text; Error is set when the row could not be parsed.
+type StatementEntry struct {
+	Row        int
+	ExternalID string
+	Date       time.Time
+	Name       string
+	Amount     string
+	Currency   string
+	CategoryID string
+	Error      string
+}
+
+// StatementMapping names the CSV header of each imported field.
+type StatementMapping struct {
+	Name       string `json:"name"`
+	Amount     string `json:"amount"`
+	Date       string `json:"date"`
+	Currency   string `json:"currency"`
+	CategoryID string `json:"category_id"`
+}
@@ -0,0 +1,6 @@
+package model
+
+type TransactionFilter struct {
+	CategoryIDs []string
+	Tag         string
+}
@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// TransactionRow is a flattened transaction streamed for exports. Tags holds
+// the tag names joined by commas.
+type TransactionRow struct {
+	TransactionID string
+	Name          string
+	Amount        int64
+	Currency      string
+	UserID        string
+	CategoryID    string
+	SplitMethod   string
+	Tags          string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,34 @@
+package web
+
+import (
+	"io"
+	"time"
+)
+
+type AttachmentUploadRequest struct {
+	TransactionID string
+	UserID        string
+	FileName      string
+	Size          int64
+	Content       io.Reader
+}
+
+type AttachmentRequest struct {
+	TransactionID string
+	AttachmentID  string
+	UserID        string
+}
+
+type AttachmentResponse struct {
+	AttachmentID  string    `json:"attachment_id"`
+	TransactionID string    `json:"transaction_id"`
+	FileName      string    `json:"file_name"`
+	ContentType   string    `json:"content_type"`
+	Size          int64     `json:"size"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type AttachmentContent struct {
+	AttachmentResponse
+	Content io.ReadCloser
+}
@@ -0,0 +1,37 @@
+package web
+
+type BudgetCreateRequest struct {
+	UserID     string `json:"-"`
+	CategoryID string `json:"category_id"`
+	Amount     int64  `json:"amount"`
+	Currency   string `json:"currency"`
+	Timezone   string `json:"timezone"`
+}
+
+type BudgetUpdateRequest struct {
+	BudgetID string `json:"-"`
+	UserID   string `json:"-"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Timezone string `json:"timezone"`
+}
+
+type BudgetRequest struct {
+	BudgetID string
+	UserID   string
+	// Period selects the month to report as YYYY-MM; empty means the current month.
+	Period string
+}
+
+type BudgetResponse struct {
+	BudgetID    string  `json:"budget_id"`
+	UserID      string  `json:"user_id"`
+	CategoryID  string  `json:"category_id"`
+	Amount      int64   `json:"amount"`
+	Currency    string  `json:"currency"`
+	Timezone    string  `json:"timezone"`
+	Period      string  `json:"period"`
+	Spent       int64   `json:"spent"`
+	Remaining   int64   `json:"remaining"`
+	PercentUsed float64 `json:"percent_used"`
+}
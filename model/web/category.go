@@ -0,0 +1,38 @@
+package web
+
+type CategoryCreateRequest struct {
+	UserID   string `json:"-"`
+	ParentID string `json:"parent_id"`
+	Name     string `json:"name"`
+}
+
+type CategoryUpdateRequest struct {
+	CategoryID string `json:"-"`
+	UserID     string `json:"-"`
+	ParentID   string `json:"parent_id"`
+	Name       string `json:"name"`
+}
+
+type CategoryResponse struct {
+	CategoryID string             `json:"category_id"`
+	UserID     string             `json:"user_id"`
+	ParentID   string             `json:"parent_id,omitempty"`
+	Name       string             `json:"name"`
+	Children   []CategoryResponse `json:"children,omitempty"`
+}
+
+type CategoryRuleCreateRequest struct {
+	UserID     string `json:"-"`
+	CategoryID string `json:"category_id"`
+	MatchType  string `json:"match_type"`
+	Pattern    string `json:"pattern"`
+	Priority   int    `json:"priority"`
+}
+
+type CategoryRuleResponse struct {
+	RuleID     string `json:"rule_id"`
+	CategoryID string `json:"category_id"`
+	MatchType  string `json:"match_type"`
+	Pattern    string `json:"pattern"`
+	Priority   int    `json:"priority"`
+}
@@ -0,0 +1,37 @@
+package web
+
+import "time"
+
+type CommentRequest struct {
+	TransactionID string `json:"-"`
+	CommentID     string `json:"-"`
+	UserID        string `json:"-"`
+	Body          string `json:"body"`
+}
+
+type CommentResponse struct {
+	CommentID     string                    `json:"comment_id"`
+	TransactionID string                    `json:"transaction_id"`
+	Author        CommentAuthorResponse     `json:"author"`
+	Body          string                    `json:"body"`
+	Edited        bool                      `json:"edited"`
+	Deleted       bool                      `json:"deleted"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	UpdatedAt     time.Time                 `json:"updated_at"`
+	History       []CommentRevisionResponse `json:"history,omitempty"`
+}
+
+// CommentAuthorResponse is the author of a comment. Only Deleted is set once
+// the author's account is deleted.
+type CommentAuthorResponse struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Deleted  bool   `json:"deleted,omitempty"`
+}
+
+// CommentRevisionResponse is an earlier body of a comment, replaced at
+// ReplacedAt.
+type CommentRevisionResponse struct {
+	Body       string    `json:"body"`
+	ReplacedAt time.Time `json:"replaced_at"`
+}
@@ -0,0 +1,51 @@
+package web
+
+import "time"
+
+type DisputeCreateRequest struct {
+	TransactionID string   `json:"-"`
+	UserID        string   `json:"-"`
+	ReasonCode    string   `json:"reason_code"`
+	Description   string   `json:"description"`
+	EvidenceIDs   []string `json:"evidence_ids"`
+}
+
+type DisputeRequest struct {
+	TransactionID string `json:"-"`
+	DisputeID     string `json:"-"`
+	UserID        string `json:"-"`
+}
+
+type DisputeEvidenceRequest struct {
+	TransactionID string   `json:"-"`
+	DisputeID     string   `json:"-"`
+	UserID        string   `json:"-"`
+	EvidenceIDs   []string `json:"evidence_ids"`
+}
+
+type DisputeListRequest struct {
+	UserID string
+	Status []string
+}
+
+type DisputeResolveRequest struct {
+	DisputeID      string `json:"-"`
+	UserID         string `json:"-"`
+	Outcome        string `json:"outcome"`
+	ResolutionNote string `json:"resolution_note"`
+}
+
+type DisputeResponse struct {
+	DisputeID      string               `json:"dispute_id"`
+	TransactionID  string               `json:"transaction_id"`
+	UserID         string               `json:"user_id"`
+	ReasonCode     string               `json:"reason_code"`
+	Description    string               `json:"description,omitempty"`
+	Status         string               `json:"status"`
+	Evidence       []AttachmentResponse `json:"evidence"`
+	ResolutionNote string               `json:"resolution_note,omitempty"`
+	ResolvedBy     *string              `json:"resolved_by,omitempty"`
+	ResolvedAt     *time.Time           `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time            `json:"created_at"`
+	UpdatedAt      time.Time            `json:"updated_at"`
+}
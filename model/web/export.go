@@ -0,0 +1,22 @@
+package web
+
+import "io"
+
+type TransactionExportRequest struct {
+	TransactionListRequest
+	Format  string
+	Columns []string
+}
+
+type UserExportRequest struct {
+	Format  string
+	Columns []string
+}
+
+// ExportFile describes an export whose content is produced by Write, so rows
+// can be streamed straight to the response.
+type ExportFile struct {
+	FileName    string
+	ContentType string
+	Write       func(w io.Writer) error
+}
@@ -0,0 +1,28 @@
+package web
+
+const (
+	// HealthStatusOK means every dependency is up.
+	HealthStatusOK = "ok"
+	// HealthStatusDegraded means a non-critical dependency is down. The
+	// service still takes traffic.
+	HealthStatusDegraded = "degraded"
+	// HealthStatusUnavailable means a critical dependency is down or the
+	// service is shutting down.
+	HealthStatusUnavailable = "unavailable"
+
+	DependencyStatusUp   = "up"
+	DependencyStatusDown = "down"
+)
+
+type HealthResponse struct {
+	Status       string               `json:"status"`
+	Dependencies []DependencyResponse `json:"dependencies,omitempty"`
+}
+
+type DependencyResponse struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Critical  bool    `json:"critical"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
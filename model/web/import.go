@@ -0,0 +1,48 @@
+package web
+
+import (
+	"io"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// Import row statuses.
+const (
+	ImportStatusReady     = "ready"
+	ImportStatusImported  = "imported"
+	ImportStatusDuplicate = "duplicate"
+	ImportStatusInvalid   = "invalid"
+	ImportStatusFailed    = "failed"
+)
+
+type ImportRequest struct {
+	UserID     string
+	Format     string    `json:"format"`
+	Content    io.Reader `json:"file"`
+	Mapping    model.StatementMapping
+	DateFormat string
+	// DryRun parses and checks the statement without saving anything.
+	DryRun bool
+}
+
+type ImportResponse struct {
+	DryRun     bool              `json:"dry_run"`
+	Total      int               `json:"total"`
+	Imported   int               `json:"imported"`
+	Duplicates int               `json:"duplicates"`
+	Failed     int               `json:"failed"`
+	Rows       []ImportRowResult `json:"rows"`
+}
+
+type ImportRowResult struct {
+	Row           int                    `json:"row"`
+	Status        string                 `json:"status"`
+	TransactionID string                 `json:"transaction_id,omitempty"`
+	Name          string                 `json:"name,omitempty"`
+	Amount        int64                  `json:"amount,omitempty"`
+	Currency      string                 `json:"currency,omitempty"`
+	CategoryID    string                 `json:"category_id,omitempty"`
+	Date          *time.Time             `json:"date,omitempty"`
+	Errors        map[string]interface{} `json:"errors,omitempty"`
+}
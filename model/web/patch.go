@@ -0,0 +1,12 @@
+package web
+
+const (
+	MergePatchContentType = "application/merge-patch+json"
+	JSONPatchContentType  = "application/json-patch+json"
+)
+
+type PatchRequest struct {
+	ID          string
+	ContentType string
+	Patch       []byte
+}
@@ -0,0 +1,32 @@
+package web
+
+import "time"
+
+type RecurringCreateRequest struct {
+	UserID     string    `json:"-"`
+	Name       string    `json:"name"`
+	Amount     int64     `json:"amount"`
+	Currency   string    `json:"currency"`
+	CategoryID string    `json:"category_id"`
+	Tags       []string  `json:"tags"`
+	RRule      string    `json:"rrule"`
+	Timezone   string    `json:"timezone"`
+	StartAt    time.Time `json:"start_at"`
+}
+
+type RecurringResponse struct {
+	RecurringID string     `json:"recurring_id"`
+	UserID      string     `json:"user_id"`
+	Name        string     `json:"name"`
+	Amount      int64      `json:"amount"`
+	Currency    string     `json:"currency"`
+	CategoryID  string     `json:"category_id,omitempty"`
+	Tags        []string   `json:"tags,omitempty"`
+	RRule       string     `json:"rrule"`
+	Timezone    string     `json:"timezone"`
+	StartAt     time.Time  `json:"start_at"`
+	NextRunAt   *time.Time `json:"next_run_at"`
+	Failures    int        `json:"failures,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+	RetryAt     *time.Time `json:"retry_at,omitempty"`
+}
@@ -0,0 +1,34 @@
+package web
+
+type ReportRequest struct {
+	UserID string
+	// From and To are inclusive dates formatted as YYYY-MM-DD.
+	From     string
+	To       string
+	Timezone string
+	GroupBy  []string
+	// Currency, when set, converts every amount into this currency at the
+	// exchange rate of the day it was spent.
+	Currency string
+}
+
+type ReportResponse struct {
+	From     string              `json:"from"`
+	To       string              `json:"to"`
+	Timezone string              `json:"timezone"`
+	GroupBy  []string            `json:"group_by"`
+	Currency string              `json:"currency,omitempty"`
+	Rows     []ReportRowResponse `json:"rows"`
+}
+
+type ReportRowResponse struct {
+	Period     string  `json:"period,omitempty"`
+	CategoryID string  `json:"category_id,omitempty"`
+	Currency   string  `json:"currency,omitempty"`
+	UserID     string  `json:"user_id,omitempty"`
+	Sum        int64   `json:"sum"`
+	Count      int64   `json:"count"`
+	Average    float64 `json:"average"`
+	Min        int64   `json:"min"`
+	Max        int64   `json:"max"`
+}
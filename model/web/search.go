@@ -0,0 +1,24 @@
+package web
+
+type SearchRequest struct {
+	UserID string
+	Query  string
+	// Types limits the search to transaction or user results; empty means
+	// both.
+	Types []string
+	Limit int
+}
+
+type SearchResponse struct {
+	Query        string              `json:"query"`
+	Transactions []SearchHitResponse `json:"transactions"`
+	Users        []SearchHitResponse `json:"users"`
+}
+
+// SearchHitResponse is a single result. Highlights holds the fields that
+// matched, HTML-escaped with the matching words wrapped in <mark> tags.
+type SearchHitResponse struct {
+	ID         string            `json:"id"`
+	Score      float64           `json:"score"`
+	Highlights map[string]string `json:"highlights"`
+}
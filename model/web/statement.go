@@ -0,0 +1,10 @@
+package web
+
+type StatementRequest struct {
+	UserID string
+	// Period is the statement month formatted as YYYY-MM.
+	Period   string
+	Timezone string
+	// Currency, when set, adds a total of all balances converted into it.
+	Currency string
+}
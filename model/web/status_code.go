@@ -1,12 +1,15 @@
 package web
 
 const (
-	BAD_REQUEST        = "Bad Request"
-	UNAUTHORIZATION    = "Unauthorized"
-	FORBIDDEN          = "Forbidden"
-	NOT_FOUND          = "Not Found"
-	SERVER_ERROR       = "Server Errors"
-	OK                 = "OK"
-	CREATED            = "Created"
-	METHOD_NOT_ALLOWED = "Method Not Allowed"
+	BAD_REQUEST         = "Bad Request"
+	UNAUTHORIZATION     = "Unauthorized"
+	FORBIDDEN           = "Forbidden"
+	NOT_FOUND           = "Not Found"
+	SERVER_ERROR        = "Server Errors"
+	OK                  = "OK"
+	CREATED             = "Created"
+	METHOD_NOT_ALLOWED  = "Method Not Allowed"
+	UNSUPPORTED_MEDIA   = "Unsupported Media Type"
+	CONFLICT            = "Conflict"
+	SERVICE_UNAVAILABLE = "Service Unavailable"
 )
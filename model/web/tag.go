@@ -0,0 +1,12 @@
+package web
+
+type TagCreateRequest struct {
+	UserID string `json:"-"`
+	Name   string `json:"name"`
+}
+
+type TagResponse struct {
+	TagID  string `json:"tag_id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
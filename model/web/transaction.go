@@ -1,17 +1,121 @@
 package web
 
+import "time"
+
 type TransactionCreateRequest struct {
-	Name   string `json:"name"`
-	UserID string
+	Name       string                   `json:"name"`
+	Amount     int64                    `json:"amount"`
+	Currency   string                   `json:"currency"`
+	CategoryID string                   `json:"category_id"`
+	Tags       []string                 `json:"tags"`
+	Split      *TransactionSplitRequest `json:"split"`
+	UserID     string
+	// IdempotencyKey makes repeated creates with the same key resolve to the
+	// same transaction instead of inserting duplicates.
+	IdempotencyKey string `json:"-"`
+	// CreatedAt dates the transaction at the time it happened, such as a
+	// recurring occurrence materialized late. Zero means now.
+	CreatedAt time.Time `json:"-"`
 }
 
 type TransactionUpdateRequest struct {
-	TransactionID string
-	Name          string `json:"name"`
+	TransactionID string                   `json:"-"`
+	Name          string                   `json:"name"`
+	Amount        int64                    `json:"amount"`
+	Currency      string                   `json:"currency"`
+	CategoryID    string                   `json:"category_id"`
+	Tags          []string                 `json:"tags"`
+	Split         *TransactionSplitRequest `json:"split"`
+}
+
+type TransactionSplitRequest struct {
+	Method       string                        `json:"method"`
+	Participants []TransactionSplitParticipant `json:"participants"`
+}
+
+type TransactionSplitParticipant struct {
+	UserID  string  `json:"user_id"`
+	Amount  int64   `json:"amount,omitempty"`
+	Percent float64 `json:"percent,omitempty"`
+}
+
+type TransactionListRequest struct {
+	UserID     string
+	CategoryID string
+	Tag        string
 }
 
 type TransactionResponse struct {
+	TransactionID string                     `json:"transaction_id"`
+	Name          string                     `json:"name"`
+	Amount        int64                      `json:"amount"`
+	Currency      string                     `json:"currency"`
+	UserID        string                     `json:"user_id"`
+	CategoryID    string                     `json:"category_id,omitempty"`
+	Tags          []string                   `json:"tags,omitempty"`
+	SplitMethod   string                     `json:"split_method,omitempty"`
+	Splits        []TransactionSplitResponse `json:"splits,omitempty"`
+	Share         *int64                     `json:"share,omitempty"`
+	OnHold        bool                       `json:"on_hold,omitempty"`
+	CreatedAt     time.Time                  `json:"created_at"`
+}
+
+type TransactionSplitResponse struct {
+	UserID  string  `json:"user_id"`
+	Amount  int64   `json:"amount"`
+	Percent float64 `json:"percent,omitempty"`
+}
+
+// Batch modes: atomic saves nothing unless every item is valid, best_effort
+// saves the valid items and reports the others.
+const (
+	BatchModeAtomic     = "atomic"
+	BatchModeBestEffort = "best_effort"
+)
+
+const (
+	BatchStatusCreated = "created"
+	BatchStatusUpdated = "updated"
+	BatchStatusDeleted = "deleted"
+	BatchStatusFailed  = "failed"
+	// BatchStatusSkipped marks valid items that were not saved because
+	// another item of an atomic batch failed.
+	BatchStatusSkipped = "skipped"
+)
+
+type TransactionBatchCreateRequest struct {
+	Mode   string                     `json:"mode"`
+	Items  []TransactionCreateRequest `json:"items"`
+	UserID string                     `json:"-"`
+}
+
+type TransactionBatchUpdateRequest struct {
+	Mode  string                       `json:"mode"`
+	Items []TransactionBatchUpdateItem `json:"items"`
+}
+
+type TransactionBatchUpdateItem struct {
 	TransactionID string `json:"transaction_id"`
-	Name          string `json:"name"`
-	UserID        string `json:"user_id"`
+	TransactionUpdateRequest
+}
+
+type TransactionBatchDeleteRequest struct {
+	Mode           string   `json:"mode"`
+	TransactionIDs []string `json:"transaction_ids"`
+}
+
+type TransactionBatchResponse struct {
+	Mode      string                   `json:"mode"`
+	Total     int                      `json:"total"`
+	Succeeded int                      `json:"succeeded"`
+	Failed    int                      `json:"failed"`
+	Results   []TransactionBatchResult `json:"results"`
+}
+
+type TransactionBatchResult struct {
+	Index         int                    `json:"index"`
+	Status        string                 `json:"status"`
+	TransactionID string                 `json:"transaction_id,omitempty"`
+	Transaction   *TransactionResponse   `json:"transaction,omitempty"`
+	Errors        map[string]interface{} `json:"errors,omitempty"`
 }
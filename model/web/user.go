@@ -6,6 +6,7 @@ type UserCreateRequest struct {
 	Handphone            string `json:"handphone"`
 	Password             string `json:"password"`
 	PasswordConfirmation string `json:"password_confirmation"`
+	Locale               string `json:"locale"`
 }
 
 type UserResponse struct {
@@ -13,13 +14,15 @@ type UserResponse struct {
 	Username  string `json:"username"`
 	Email     string `json:"email"`
 	Handphone string `json:"handphone"`
+	Locale    string `json:"locale,omitempty"`
 }
 
 type UserUpdateProfileRequest struct {
-	UserID    string
+	UserID    string `json:"-"`
 	Username  string `json:"username"`
 	Email     string `json:"email"`
 	Handphone string `json:"handphone"`
+	Locale    string `json:"locale"`
 }
 
 type UserUpdatePasswordRequest struct {
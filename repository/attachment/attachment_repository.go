@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type AttachmentRepository interface {
+	InsertAttachment(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error)
+	FindAttachmentByID(ctx context.Context, attachmentId string) (attachment entity.Attachment, err error)
+	FindAttachmentByTransactionId(ctx context.Context, transactionId string) (attachments []entity.Attachment, err error)
+	// IsActiveDisputeEvidence reports whether the attachment is evidence of a
+	// dispute that still holds its transaction.
+	IsActiveDisputeEvidence(ctx context.Context, attachmentId string) (bool, error)
+	DeleteAttachment(ctx context.Context, attachmentId string) error
+	DeleteAllAttachment(ctx context.Context) error
+}
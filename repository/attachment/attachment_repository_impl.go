@@ -0,0 +1,48 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type AttachmentRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewAttachmentRepository(DB *gorm.DB) AttachmentRepository {
+	return &AttachmentRepositoryImpl{DB: DB}
+}
+
+func (repository *AttachmentRepositoryImpl) InsertAttachment(ctx context.Context, attachment entity.Attachment) (entity.Attachment, error) {
+	err := repository.DB.WithContext(ctx).Create(&attachment).Error
+	return attachment, err
+}
+
+func (repository *AttachmentRepositoryImpl) FindAttachmentByID(ctx context.Context, attachmentId string) (attachment entity.Attachment, err error) {
+	err = repository.DB.WithContext(ctx).Where("attachment_id", attachmentId).First(&attachment).Error
+	return attachment, err
+}
+
+func (repository *AttachmentRepositoryImpl) FindAttachmentByTransactionId(ctx context.Context, transactionId string) (attachments []entity.Attachment, err error) {
+	err = repository.DB.WithContext(ctx).Where("transaction_id", transactionId).Order("created_at").Find(&attachments).Error
+	return attachments, err
+}
+
+func (repository *AttachmentRepositoryImpl) IsActiveDisputeEvidence(ctx context.Context, attachmentId string) (bool, error) {
+	var count int64
+	err := repository.DB.WithContext(ctx).Model(&entity.DisputeEvidence{}).
+		Joins("JOIN disputes ON disputes.dispute_id = dispute_evidence.dispute_id").
+		Where("dispute_evidence.attachment_id = ? AND disputes.status IN ?", attachmentId, []string{entity.DisputeStatusOpen, entity.DisputeStatusUnderReview}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (repository *AttachmentRepositoryImpl) DeleteAttachment(ctx context.Context, attachmentId string) error {
+	return repository.DB.WithContext(ctx).Where("attachment_id", attachmentId).Delete(&entity.Attachment{}).Error
+}
+
+func (repository *AttachmentRepositoryImpl) DeleteAllAttachment(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM attachments").Error
+}
@@ -0,0 +1,18 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type BudgetRepository interface {
+	InsertBudget(ctx context.Context, budget entity.Budget) (entity.Budget, error)
+	FindBudgetByID(ctx context.Context, budgetId string) (budget entity.Budget, err error)
+	FindBudgetByUserId(ctx context.Context, userId string) (budgets []entity.Budget, err error)
+	FindBudgetByCategoryIds(ctx context.Context, userId string, categoryIds []string) (budgets []entity.Budget, err error)
+	UpdateBudget(ctx context.Context, budget entity.Budget) (entity.Budget, error)
+	DeleteBudget(ctx context.Context, budgetId string) error
+	InsertBudgetAlert(ctx context.Context, alert entity.BudgetAlert) (inserted bool, err error)
+	DeleteAllBudget(ctx context.Context) error
+}
@@ -0,0 +1,57 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BudgetRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewBudgetRepository(DB *gorm.DB) BudgetRepository {
+	return &BudgetRepositoryImpl{DB: DB}
+}
+
+func (repository *BudgetRepositoryImpl) InsertBudget(ctx context.Context, budget entity.Budget) (entity.Budget, error) {
+	err := repository.DB.WithContext(ctx).Create(&budget).Error
+	return budget, err
+}
+
+func (repository *BudgetRepositoryImpl) FindBudgetByID(ctx context.Context, budgetId string) (budget entity.Budget, err error) {
+	err = repository.DB.WithContext(ctx).Where("budget_id", budgetId).First(&budget).Error
+	return budget, err
+}
+
+func (repository *BudgetRepositoryImpl) FindBudgetByUserId(ctx context.Context, userId string) (budgets []entity.Budget, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id", userId).Order("created_at").Find(&budgets).Error
+	return budgets, err
+}
+
+func (repository *BudgetRepositoryImpl) FindBudgetByCategoryIds(ctx context.Context, userId string, categoryIds []string) (budgets []entity.Budget, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id = ? AND category_id IN ?", userId, categoryIds).Find(&budgets).Error
+	return budgets, err
+}
+
+func (repository *BudgetRepositoryImpl) UpdateBudget(ctx context.Context, budget entity.Budget) (entity.Budget, error) {
+	err := repository.DB.WithContext(ctx).Select("amount", "timezone").Where("budget_id", budget.BudgetID).Updates(&budget).Error
+	return budget, err
+}
+
+func (repository *BudgetRepositoryImpl) DeleteBudget(ctx context.Context, budgetId string) error {
+	return repository.DB.WithContext(ctx).Where("budget_id", budgetId).Delete(&entity.Budget{}).Error
+}
+
+// InsertBudgetAlert reports false when the alert for the same budget, period
+// and threshold was already recorded, so concurrent checks alert only once.
+func (repository *BudgetRepositoryImpl) InsertBudgetAlert(ctx context.Context, alert entity.BudgetAlert) (inserted bool, err error) {
+	result := repository.DB.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&alert)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (repository *BudgetRepositoryImpl) DeleteAllBudget(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM budgets").Error
+}
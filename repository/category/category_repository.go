@@ -0,0 +1,20 @@
+package category
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type CategoryRepository interface {
+	InsertCategory(ctx context.Context, category entity.Category) (entity.Category, error)
+	FindCategoryByID(ctx context.Context, categoryId string) (category entity.Category, err error)
+	FindCategoryByUserId(ctx context.Context, userId string) (categories []entity.Category, err error)
+	UpdateCategory(ctx context.Context, category entity.Category) (entity.Category, error)
+	DeleteCategory(ctx context.Context, categoryId string) error
+	InsertCategoryRule(ctx context.Context, rule entity.CategoryRule) (entity.CategoryRule, error)
+	FindCategoryRuleByID(ctx context.Context, ruleId string) (rule entity.CategoryRule, err error)
+	FindCategoryRuleByUserId(ctx context.Context, userId string) (rules []entity.CategoryRule, err error)
+	DeleteCategoryRule(ctx context.Context, ruleId string) error
+	DeleteAllCategory(ctx context.Context) error
+}
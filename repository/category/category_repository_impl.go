@@ -0,0 +1,63 @@
+package category
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type CategoryRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewCategoryRepository(DB *gorm.DB) CategoryRepository {
+	return &CategoryRepositoryImpl{DB: DB}
+}
+
+func (repository *CategoryRepositoryImpl) InsertCategory(ctx context.Context, category entity.Category) (entity.Category, error) {
+	err := repository.DB.WithContext(ctx).Create(&category).Error
+	return category, err
+}
+
+func (repository *CategoryRepositoryImpl) FindCategoryByID(ctx context.Context, categoryId string) (category entity.Category, err error) {
+	err = repository.DB.WithContext(ctx).Where("category_id", categoryId).First(&category).Error
+	return category, err
+}
+
+func (repository *CategoryRepositoryImpl) FindCategoryByUserId(ctx context.Context, userId string) (categories []entity.Category, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id", userId).Order("name").Find(&categories).Error
+	return categories, err
+}
+
+func (repository *CategoryRepositoryImpl) UpdateCategory(ctx context.Context, category entity.Category) (entity.Category, error) {
+	err := repository.DB.WithContext(ctx).Select("*").Where("category_id", category.CategoryID).Updates(&category).Error
+	return category, err
+}
+
+func (repository *CategoryRepositoryImpl) DeleteCategory(ctx context.Context, categoryId string) error {
+	return repository.DB.WithContext(ctx).Where("category_id", categoryId).Delete(&entity.Category{}).Error
+}
+
+func (repository *CategoryRepositoryImpl) InsertCategoryRule(ctx context.Context, rule entity.CategoryRule) (entity.CategoryRule, error) {
+	err := repository.DB.WithContext(ctx).Create(&rule).Error
+	return rule, err
+}
+
+func (repository *CategoryRepositoryImpl) FindCategoryRuleByID(ctx context.Context, ruleId string) (rule entity.CategoryRule, err error) {
+	err = repository.DB.WithContext(ctx).Where("rule_id", ruleId).First(&rule).Error
+	return rule, err
+}
+
+func (repository *CategoryRepositoryImpl) FindCategoryRuleByUserId(ctx context.Context, userId string) (rules []entity.CategoryRule, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id", userId).Order("priority").Order("rule_id").Find(&rules).Error
+	return rules, err
+}
+
+func (repository *CategoryRepositoryImpl) DeleteCategoryRule(ctx context.Context, ruleId string) error {
+	return repository.DB.WithContext(ctx).Where("rule_id", ruleId).Delete(&entity.CategoryRule{}).Error
+}
+
+func (repository *CategoryRepositoryImpl) DeleteAllCategory(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM categories").Error
+}
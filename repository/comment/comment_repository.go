@@ -0,0 +1,16 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type CommentRepository interface {
+	InsertComment(ctx context.Context, comment entity.Comment) (entity.Comment, error)
+	FindCommentByID(ctx context.Context, commentId string) (comment entity.Comment, err error)
+	FindCommentByTransactionId(ctx context.Context, transactionId string) (comments []entity.Comment, err error)
+	UpdateComment(ctx context.Context, comment entity.Comment, revision entity.CommentRevision) (entity.Comment, error)
+	DeleteComment(ctx context.Context, commentId string) error
+	DeleteAllComment(ctx context.Context) error
+}
@@ -0,0 +1,70 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type CommentRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewCommentRepository(DB *gorm.DB) CommentRepository {
+	return &CommentRepositoryImpl{DB: DB}
+}
+
+func (repository *CommentRepositoryImpl) InsertComment(ctx context.Context, comment entity.Comment) (entity.Comment, error) {
+	err := repository.DB.WithContext(ctx).Omit("Author", "Transaction", "Revisions").Create(&comment).Error
+	return comment, err
+}
+
+// FindCommentByID also finds deleted comments, so callers can tell them
+// apart from comments that never existed.
+func (repository *CommentRepositoryImpl) FindCommentByID(ctx context.Context, commentId string) (comment entity.Comment, err error) {
+	err = repository.DB.WithContext(ctx).Unscoped().
+		Preload("Author").
+		Preload("Revisions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, revision_id")
+		}).
+		Where("comment_id", commentId).First(&comment).Error
+	return comment, err
+}
+
+// FindCommentByTransactionId returns the whole thread, oldest first,
+// including deleted comments so replies keep their context.
+func (repository *CommentRepositoryImpl) FindCommentByTransactionId(ctx context.Context, transactionId string) (comments []entity.Comment, err error) {
+	err = repository.DB.WithContext(ctx).Unscoped().
+		Preload("Author").
+		Preload("Revisions", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at, revision_id")
+		}).
+		Where("transaction_id", transactionId).Order("created_at, comment_id").Find(&comments).Error
+	return comments, err
+}
+
+// UpdateComment saves the new body of comment together with the revision
+// holding its previous body.
+func (repository *CommentRepositoryImpl) UpdateComment(ctx context.Context, comment entity.Comment, revision entity.CommentRevision) (entity.Comment, error) {
+	err := repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&revision).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&comment).Select("body", "updated_at").Updates(&comment).Error
+	})
+	if err != nil {
+		return comment, err
+	}
+	comment.Revisions = append(comment.Revisions, revision)
+	return comment, nil
+}
+
+func (repository *CommentRepositoryImpl) DeleteComment(ctx context.Context, commentId string) error {
+	return repository.DB.WithContext(ctx).Where("comment_id", commentId).Delete(&entity.Comment{}).Error
+}
+
+func (repository *CommentRepositoryImpl) DeleteAllComment(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM comments").Error
+}
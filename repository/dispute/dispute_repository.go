@@ -0,0 +1,17 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type DisputeRepository interface {
+	InsertDispute(ctx context.Context, dispute entity.Dispute) (entity.Dispute, error)
+	InsertDisputeEvidence(ctx context.Context, evidence []entity.DisputeEvidence) error
+	FindDisputeByID(ctx context.Context, disputeId string) (dispute entity.Dispute, err error)
+	FindDisputeByTransactionId(ctx context.Context, transactionId string) (disputes []entity.Dispute, err error)
+	FindDisputeByStatus(ctx context.Context, statuses []string) (disputes []entity.Dispute, err error)
+	UpdateDispute(ctx context.Context, dispute entity.Dispute, fromStatus string) (entity.Dispute, error)
+	DeleteAllDispute(ctx context.Context) error
+}
@@ -0,0 +1,116 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type DisputeRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewDisputeRepository(DB *gorm.DB) DisputeRepository {
+	return &DisputeRepositoryImpl{DB: DB}
+}
+
+// ErrTransactionOnHold is returned by InsertDispute when the transaction is
+// already on hold by another dispute.
+var ErrTransactionOnHold = errors.New("transaction is already on hold")
+
+// ErrDisputeChanged is returned by UpdateDispute when the dispute is no
+// longer in the status it was read in.
+var ErrDisputeChanged = errors.New("dispute was changed by another request")
+
+// InsertDispute saves the dispute with its evidence and puts its transaction
+// on hold in one database transaction. It fails with ErrTransactionOnHold
+// when the transaction is already on hold, so two disputes opened at the
+// same time cannot both succeed.
+func (repository *DisputeRepositoryImpl) InsertDispute(ctx context.Context, dispute entity.Dispute) (entity.Dispute, error) {
+	err := repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		held := tx.Model(&entity.Transaction{}).
+			Where("transaction_id = ? AND on_hold = ?", dispute.TransactionID, false).
+			Update("on_hold", true)
+		if held.Error != nil {
+			return held.Error
+		}
+		if held.RowsAffected == 0 {
+			return ErrTransactionOnHold
+		}
+		err := tx.Omit("Transaction", "Evidence").Create(&dispute).Error
+		if err != nil {
+			return err
+		}
+		return insertEvidence(tx, dispute.Evidence)
+	})
+	return dispute, err
+}
+
+func (repository *DisputeRepositoryImpl) InsertDisputeEvidence(ctx context.Context, evidence []entity.DisputeEvidence) error {
+	return insertEvidence(repository.DB.WithContext(ctx), evidence)
+}
+
+func insertEvidence(tx *gorm.DB, evidence []entity.DisputeEvidence) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+	return tx.Omit("Attachment").Create(&evidence).Error
+}
+
+func (repository *DisputeRepositoryImpl) FindDisputeByID(ctx context.Context, disputeId string) (dispute entity.Dispute, err error) {
+	err = repository.preloadEvidence(ctx).Where("dispute_id", disputeId).First(&dispute).Error
+	return dispute, err
+}
+
+// FindDisputeByTransactionId returns every dispute of the transaction,
+// oldest first.
+func (repository *DisputeRepositoryImpl) FindDisputeByTransactionId(ctx context.Context, transactionId string) (disputes []entity.Dispute, err error) {
+	err = repository.preloadEvidence(ctx).Where("transaction_id", transactionId).Order("created_at, dispute_id").Find(&disputes).Error
+	return disputes, err
+}
+
+// FindDisputeByStatus returns the disputes in any of statuses, oldest first.
+// No statuses returns every dispute.
+func (repository *DisputeRepositoryImpl) FindDisputeByStatus(ctx context.Context, statuses []string) (disputes []entity.Dispute, err error) {
+	query := repository.preloadEvidence(ctx)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+	err = query.Order("created_at, dispute_id").Find(&disputes).Error
+	return disputes, err
+}
+
+func (repository *DisputeRepositoryImpl) preloadEvidence(ctx context.Context) *gorm.DB {
+	return repository.DB.WithContext(ctx).Preload("Evidence", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, attachment_id")
+	}).Preload("Evidence.Attachment")
+}
+
+// UpdateDispute saves the status and resolution of the dispute and, in the
+// same database transaction, keeps its transaction on hold only while the
+// dispute is active.
+// UpdateDispute saves dispute if it is still in fromStatus and puts its
+// transaction on or off hold to match, in one database transaction. It fails
+// with ErrDisputeChanged when the status has moved on, so two admins acting
+// on the same dispute at the same time cannot both succeed.
+func (repository *DisputeRepositoryImpl) UpdateDispute(ctx context.Context, dispute entity.Dispute, fromStatus string) (entity.Dispute, error) {
+	err := repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		updated := tx.Model(&dispute).Where("status = ?", fromStatus).
+			Select("status", "resolution_note", "resolved_by", "resolved_at", "updated_at").
+			Updates(&dispute)
+		if updated.Error != nil {
+			return updated.Error
+		}
+		if updated.RowsAffected == 0 {
+			return ErrDisputeChanged
+		}
+		return tx.Model(&entity.Transaction{}).Where("transaction_id", dispute.TransactionID).Update("on_hold", dispute.Active()).Error
+	})
+	return dispute, err
+}
+
+func (repository *DisputeRepositoryImpl) DeleteAllDispute(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM disputes").Error
+}
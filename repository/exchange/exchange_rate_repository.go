@@ -0,0 +1,13 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type ExchangeRateRepository interface {
+	UpsertExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error
+	FindExchangeRates(ctx context.Context, currencies []string, from time.Time, to time.Time) (rates []entity.ExchangeRate, err error)
+}
@@ -0,0 +1,42 @@
+package exchange
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const upsertBatchSize = 500
+
+type ExchangeRateRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewExchangeRateRepository(DB *gorm.DB) ExchangeRateRepository {
+	return &ExchangeRateRepositoryImpl{DB: DB}
+}
+
+// UpsertExchangeRates saves all rates in one database transaction, replacing
+// the rate of a day and currency pair that was loaded before.
+func (repository *ExchangeRateRepositoryImpl) UpsertExchangeRates(ctx context.Context, rates []entity.ExchangeRate) error {
+	return repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "date"}, {Name: "base_currency"}, {Name: "quote_currency"}},
+			DoUpdates: clause.AssignmentColumns([]string{"rate"}),
+		}).CreateInBatches(&rates, upsertBatchSize).Error
+	})
+}
+
+// FindExchangeRates returns the rates between the from and to dates, both
+// inclusive, that quote any of currencies against another currency.
+func (repository *ExchangeRateRepositoryImpl) FindExchangeRates(ctx context.Context, currencies []string, from time.Time, to time.Time) (rates []entity.ExchangeRate, err error) {
+	err = repository.DB.WithContext(ctx).
+		Where("base_currency IN ? OR quote_currency IN ?", currencies, currencies).
+		Where("date BETWEEN ? AND ?", from.Format("2006-01-02"), to.Format("2006-01-02")).
+		Order("date").
+		Find(&rates).Error
+	return rates, err
+}
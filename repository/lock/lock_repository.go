@@ -0,0 +1,11 @@
+package lock
+
+import (
+	"context"
+	"time"
+)
+
+type LockRepository interface {
+	AcquireLock(ctx context.Context, key string, token string, ttl time.Duration) (bool, error)
+	ReleaseLock(ctx context.Context, key string, token string) error
+}
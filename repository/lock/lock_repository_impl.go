@@ -0,0 +1,48 @@
+package lock
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// acquireScript takes the lock when it is free and refreshes its TTL when it
+// is already held by the same token, so a leader keeps its lease across ticks.
+var acquireScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return 1
+end
+return 0
+`)
+
+// releaseScript only deletes the lock when it is still held by the token.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type LockRepositoryImpl struct {
+	Redis *redis.Client
+}
+
+func NewLockRepository(redis *redis.Client) LockRepository {
+	return &LockRepositoryImpl{Redis: redis}
+}
+
+func (repository *LockRepositoryImpl) AcquireLock(ctx context.Context, key string, token string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireScript.Run(ctx, repository.Redis, []string{key}, token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return acquired == 1, nil
+}
+
+func (repository *LockRepositoryImpl) ReleaseLock(ctx context.Context, key string, token string) error {
+	return releaseScript.Run(ctx, repository.Redis, []string{key}, token).Err()
+}
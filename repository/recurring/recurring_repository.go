@@ -0,0 +1,23 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type RecurringRepository interface {
+	InsertRecurring(ctx context.Context, recurring entity.RecurringTransaction) (entity.RecurringTransaction, error)
+	FindRecurringByID(ctx context.Context, recurringId string) (recurring entity.RecurringTransaction, err error)
+	FindRecurringByUserId(ctx context.Context, userId string) (recurrings []entity.RecurringTransaction, err error)
+	FindDueRecurring(ctx context.Context, now time.Time, limit int) (recurrings []entity.RecurringTransaction, err error)
+	// UpdateRecurringNextRun moves the schedule to its next occurrence and
+	// clears the failures of the previous one.
+	UpdateRecurringNextRun(ctx context.Context, recurringId string, nextRunAt *time.Time) error
+	// UpdateRecurringFailure writes the failure fields and the next run time
+	// of recurring.
+	UpdateRecurringFailure(ctx context.Context, recurring entity.RecurringTransaction) error
+	DeleteRecurring(ctx context.Context, recurringId string) error
+	DeleteAllRecurring(ctx context.Context) error
+}
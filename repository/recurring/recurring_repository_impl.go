@@ -0,0 +1,70 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type RecurringRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewRecurringRepository(DB *gorm.DB) RecurringRepository {
+	return &RecurringRepositoryImpl{DB: DB}
+}
+
+func (repository *RecurringRepositoryImpl) InsertRecurring(ctx context.Context, recurring entity.RecurringTransaction) (entity.RecurringTransaction, error) {
+	err := repository.DB.WithContext(ctx).Create(&recurring).Error
+	return recurring, err
+}
+
+func (repository *RecurringRepositoryImpl) FindRecurringByID(ctx context.Context, recurringId string) (recurring entity.RecurringTransaction, err error) {
+	err = repository.DB.WithContext(ctx).Where("recurring_id", recurringId).First(&recurring).Error
+	return recurring, err
+}
+
+func (repository *RecurringRepositoryImpl) FindRecurringByUserId(ctx context.Context, userId string) (recurrings []entity.RecurringTransaction, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id", userId).Order("created_at").Find(&recurrings).Error
+	return recurrings, err
+}
+
+// FindDueRecurring skips the schedules waiting to retry a failed occurrence,
+// so they cannot hold back the others.
+func (repository *RecurringRepositoryImpl) FindDueRecurring(ctx context.Context, now time.Time, limit int) (recurrings []entity.RecurringTransaction, err error) {
+	err = repository.DB.WithContext(ctx).
+		Where("next_run_at IS NOT NULL AND next_run_at <= ?", now).
+		Where("retry_at IS NULL OR retry_at <= ?", now).
+		Order("next_run_at").
+		Limit(limit).
+		Find(&recurrings).Error
+	return recurrings, err
+}
+
+func (repository *RecurringRepositoryImpl) UpdateRecurringNextRun(ctx context.Context, recurringId string, nextRunAt *time.Time) error {
+	return repository.DB.WithContext(ctx).Model(&entity.RecurringTransaction{}).Where("recurring_id", recurringId).Updates(map[string]interface{}{
+		"next_run_at": nextRunAt,
+		"failures":    0,
+		"last_error":  "",
+		"retry_at":    nil,
+	}).Error
+}
+
+func (repository *RecurringRepositoryImpl) UpdateRecurringFailure(ctx context.Context, recurring entity.RecurringTransaction) error {
+	return repository.DB.WithContext(ctx).Model(&entity.RecurringTransaction{}).Where("recurring_id", recurring.RecurringID).Updates(map[string]interface{}{
+		"next_run_at": recurring.NextRunAt,
+		"failures":    recurring.Failures,
+		"last_error":  recurring.LastError,
+		"retry_at":    recurring.RetryAt,
+	}).Error
+}
+
+func (repository *RecurringRepositoryImpl) DeleteRecurring(ctx context.Context, recurringId string) error {
+	return repository.DB.WithContext(ctx).Where("recurring_id", recurringId).Delete(&entity.RecurringTransaction{}).Error
+}
+
+func (repository *RecurringRepositoryImpl) DeleteAllRecurring(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM recurring_transactions").Error
+}
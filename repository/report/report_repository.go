@@ -0,0 +1,11 @@
+package report
+
+import (
+	"context"
+	"time"
+)
+
+type ReportRepository interface {
+	GetReport(ctx context.Context, key string) (report string, err error)
+	StoreReport(ctx context.Context, key string, report string, ttl time.Duration) error
+}
@@ -0,0 +1,26 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type ReportRepositoryImpl struct {
+	Redis *redis.Client
+}
+
+func NewReportRepository(redis *redis.Client) ReportRepository {
+	return &ReportRepositoryImpl{Redis: redis}
+}
+
+// GetReport returns the cached report stored under key, or redis.Nil when
+// there is none.
+func (repository *ReportRepositoryImpl) GetReport(ctx context.Context, key string) (report string, err error) {
+	return repository.Redis.Get(ctx, key).Result()
+}
+
+func (repository *ReportRepositoryImpl) StoreReport(ctx context.Context, key string, report string, ttl time.Duration) error {
+	return repository.Redis.Set(ctx, key, report, ttl).Err()
+}
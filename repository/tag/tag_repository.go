@@ -0,0 +1,16 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+type TagRepository interface {
+	InsertTag(ctx context.Context, tag entity.Tag) (entity.Tag, error)
+	FindTagByID(ctx context.Context, tagId string) (tag entity.Tag, err error)
+	FindTagByUserId(ctx context.Context, userId string) (tags []entity.Tag, err error)
+	FindOrInsertTag(ctx context.Context, tag entity.Tag) (entity.Tag, error)
+	DeleteTag(ctx context.Context, tagId string) error
+	DeleteAllTag(ctx context.Context) error
+}
@@ -0,0 +1,44 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"gorm.io/gorm"
+)
+
+type TagRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewTagRepository(DB *gorm.DB) TagRepository {
+	return &TagRepositoryImpl{DB: DB}
+}
+
+func (repository *TagRepositoryImpl) InsertTag(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	err := repository.DB.WithContext(ctx).Create(&tag).Error
+	return tag, err
+}
+
+func (repository *TagRepositoryImpl) FindTagByID(ctx context.Context, tagId string) (tag entity.Tag, err error) {
+	err = repository.DB.WithContext(ctx).Where("tag_id", tagId).First(&tag).Error
+	return tag, err
+}
+
+func (repository *TagRepositoryImpl) FindTagByUserId(ctx context.Context, userId string) (tags []entity.Tag, err error) {
+	err = repository.DB.WithContext(ctx).Where("user_id", userId).Order("name").Find(&tags).Error
+	return tags, err
+}
+
+func (repository *TagRepositoryImpl) FindOrInsertTag(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	err := repository.DB.WithContext(ctx).Where(entity.Tag{UserID: tag.UserID, Name: tag.Name}).FirstOrCreate(&tag).Error
+	return tag, err
+}
+
+func (repository *TagRepositoryImpl) DeleteTag(ctx context.Context, tagId string) error {
+	return repository.DB.WithContext(ctx).Where("tag_id", tagId).Delete(&entity.Tag{}).Error
+}
+
+func (repository *TagRepositoryImpl) DeleteAllTag(ctx context.Context) error {
+	return repository.DB.WithContext(ctx).Exec("DELETE FROM tags").Error
+}
@@ -4,7 +4,9 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
+	model "github.com/vnnyx/golang-dot-api/model"
 	entity "github.com/vnnyx/golang-dot-api/model/entity"
 	gorm "gorm.io/gorm"
 
@@ -16,6 +18,29 @@ type TransactionRepository struct {
 	mock.Mock
 }
 
+// AggregateTransaction provides a mock function with given fields: ctx, userId, filter
+func (_m *TransactionRepository) AggregateTransaction(ctx context.Context, userId string, filter model.ReportFilter) ([]model.ReportRow, error) {
+	ret := _m.Called(ctx, userId, filter)
+
+	var r0 []model.ReportRow
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.ReportFilter) []model.ReportRow); ok {
+		r0 = rf(ctx, userId, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ReportRow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.ReportFilter) error); ok {
+		r1 = rf(ctx, userId, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteAllTransaction provides a mock function with given fields: ctx
 func (_m *TransactionRepository) DeleteAllTransaction(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -44,6 +69,20 @@ func (_m *TransactionRepository) DeleteTransaction(ctx context.Context, transact
 	return r0
 }
 
+// DeleteTransactionBatch provides a mock function with given fields: ctx, transactionIds
+func (_m *TransactionRepository) DeleteTransactionBatch(ctx context.Context, transactionIds []string) error {
+	ret := _m.Called(ctx, transactionIds)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) error); ok {
+		r0 = rf(ctx, transactionIds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteTransactionByUserId provides a mock function with given fields: ctx, tx, userId
 func (_m *TransactionRepository) DeleteTransactionByUserId(ctx context.Context, tx *gorm.DB, userId string) error {
 	ret := _m.Called(ctx, tx, userId)
@@ -81,6 +120,75 @@ func (_m *TransactionRepository) FindAllTransaction(ctx context.Context) ([]enti
 	return r0, r1
 }
 
+// FindExistingTransactionIDs provides a mock function with given fields: ctx, transactionIds
+func (_m *TransactionRepository) FindExistingTransactionIDs(ctx context.Context, transactionIds []string) ([]string, error) {
+	ret := _m.Called(ctx, transactionIds)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, transactionIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, transactionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindHeldTransactionIDs provides a mock function with given fields: ctx, transactionIds
+func (_m *TransactionRepository) FindHeldTransactionIDs(ctx context.Context, transactionIds []string) ([]string, error) {
+	ret := _m.Called(ctx, transactionIds)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, transactionIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, transactionIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTransactionBetween provides a mock function with given fields: ctx, userId, from, to
+func (_m *TransactionRepository) FindTransactionBetween(ctx context.Context, userId string, from time.Time, to time.Time) ([]entity.Transaction, error) {
+	ret := _m.Called(ctx, userId, from, to)
+
+	var r0 []entity.Transaction
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []entity.Transaction); ok {
+		r0 = rf(ctx, userId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Transaction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindTransactionByID provides a mock function with given fields: ctx, transactionId
 func (_m *TransactionRepository) FindTransactionByID(ctx context.Context, transactionId string) (entity.Transaction, error) {
 	ret := _m.Called(ctx, transactionId)
@@ -102,13 +210,13 @@ func (_m *TransactionRepository) FindTransactionByID(ctx context.Context, transa
 	return r0, r1
 }
 
-// FindTransactionByUserId provides a mock function with given fields: ctx, userId
-func (_m *TransactionRepository) FindTransactionByUserId(ctx context.Context, userId string) ([]entity.Transaction, error) {
-	ret := _m.Called(ctx, userId)
+// FindTransactionByUserId provides a mock function with given fields: ctx, userId, filter
+func (_m *TransactionRepository) FindTransactionByUserId(ctx context.Context, userId string, filter model.TransactionFilter) ([]entity.Transaction, error) {
+	ret := _m.Called(ctx, userId, filter)
 
 	var r0 []entity.Transaction
-	if rf, ok := ret.Get(0).(func(context.Context, string) []entity.Transaction); ok {
-		r0 = rf(ctx, userId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.TransactionFilter) []entity.Transaction); ok {
+		r0 = rf(ctx, userId, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Transaction)
@@ -116,8 +224,29 @@ func (_m *TransactionRepository) FindTransactionByUserId(ctx context.Context, us
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userId)
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.TransactionFilter) error); ok {
+		r1 = rf(ctx, userId, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasHeldTransaction provides a mock function with given fields: ctx, tx, userId
+func (_m *TransactionRepository) HasHeldTransaction(ctx context.Context, tx *gorm.DB, userId string) (bool, error) {
+	ret := _m.Called(ctx, tx, userId)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, *gorm.DB, string) bool); ok {
+		r0 = rf(ctx, tx, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *gorm.DB, string) error); ok {
+		r1 = rf(ctx, tx, userId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,6 +275,78 @@ func (_m *TransactionRepository) InsertTransaction(ctx context.Context, _a1 enti
 	return r0, r1
 }
 
+// InsertTransactionBatch provides a mock function with given fields: ctx, transactions
+func (_m *TransactionRepository) InsertTransactionBatch(ctx context.Context, transactions []entity.Transaction) error {
+	ret := _m.Called(ctx, transactions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Transaction) error); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamTransaction provides a mock function with given fields: ctx, userId, filter, fn
+func (_m *TransactionRepository) StreamTransaction(ctx context.Context, userId string, filter model.TransactionFilter, fn func(model.TransactionRow) error) error {
+	ret := _m.Called(ctx, userId, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.TransactionFilter, func(model.TransactionRow) error) error); ok {
+		r0 = rf(ctx, userId, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SumTransactionAmount provides a mock function with given fields: ctx, userId, currency, categoryIds, from, to
+func (_m *TransactionRepository) SumTransactionAmount(ctx context.Context, userId string, currency string, categoryIds []string, from time.Time, to time.Time) (int64, error) {
+	ret := _m.Called(ctx, userId, currency, categoryIds, from, to)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string, time.Time, time.Time) int64); ok {
+		r0 = rf(ctx, userId, currency, categoryIds, from, to)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, userId, currency, categoryIds, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SumTransactionAmountByCurrency provides a mock function with given fields: ctx, userId, before
+func (_m *TransactionRepository) SumTransactionAmountByCurrency(ctx context.Context, userId string, before time.Time) ([]model.CurrencyTotal, error) {
+	ret := _m.Called(ctx, userId, before)
+
+	var r0 []model.CurrencyTotal
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []model.CurrencyTotal); ok {
+		r0 = rf(ctx, userId, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CurrencyTotal)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userId, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTransaction provides a mock function with given fields: ctx, _a1
 func (_m *TransactionRepository) UpdateTransaction(ctx context.Context, _a1 entity.Transaction) (entity.Transaction, error) {
 	ret := _m.Called(ctx, _a1)
@@ -167,6 +368,20 @@ func (_m *TransactionRepository) UpdateTransaction(ctx context.Context, _a1 enti
 	return r0, r1
 }
 
+// UpdateTransactionBatch provides a mock function with given fields: ctx, transactions
+func (_m *TransactionRepository) UpdateTransactionBatch(ctx context.Context, transactions []entity.Transaction) error {
+	ret := _m.Called(ctx, transactions)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []entity.Transaction) error); ok {
+		r0 = rf(ctx, transactions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewTransactionRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -2,18 +2,34 @@ package transaction
 
 import (
 	"context"
+	"time"
 
+	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"gorm.io/gorm"
 )
 
 type TransactionRepository interface {
 	InsertTransaction(ctx context.Context, transaction entity.Transaction) (entity.Transaction, error)
+	InsertTransactionBatch(ctx context.Context, transactions []entity.Transaction) error
+	FindExistingTransactionIDs(ctx context.Context, transactionIds []string) (existing []string, err error)
+	FindHeldTransactionIDs(ctx context.Context, transactionIds []string) (held []string, err error)
 	FindTransactionByID(ctx context.Context, transactionId string) (transaction entity.Transaction, err error)
 	FindAllTransaction(ctx context.Context) (transactions []entity.Transaction, err error)
-	FindTransactionByUserId(ctx context.Context, userId string) (transactions []entity.Transaction, err error)
+	FindTransactionByUserId(ctx context.Context, userId string, filter model.TransactionFilter) (transactions []entity.Transaction, err error)
+	StreamTransaction(ctx context.Context, userId string, filter model.TransactionFilter, fn func(row model.TransactionRow) error) error
+	FindTransactionBetween(ctx context.Context, userId string, from time.Time, to time.Time) (transactions []entity.Transaction, err error)
+	SumTransactionAmount(ctx context.Context, userId string, currency string, categoryIds []string, from time.Time, to time.Time) (total int64, err error)
+	SumTransactionAmountByCurrency(ctx context.Context, userId string, before time.Time) (totals []model.CurrencyTotal, err error)
+	AggregateTransaction(ctx context.Context, userId string, filter model.ReportFilter) (rows []model.ReportRow, err error)
 	UpdateTransaction(ctx context.Context, transaction entity.Transaction) (entity.Transaction, error)
+	UpdateTransactionBatch(ctx context.Context, transactions []entity.Transaction) error
 	DeleteTransaction(ctx context.Context, transactionId string) error
+	DeleteTransactionBatch(ctx context.Context, transactionIds []string) error
+	// HasHeldTransaction reports whether any transaction of the user is on
+	// hold. It locks the transactions of the user until tx ends, so no
+	// dispute can put one on hold in between.
+	HasHeldTransaction(ctx context.Context, tx *gorm.DB, userId string) (bool, error)
 	DeleteTransactionByUserId(ctx context.Context, tx *gorm.DB, userId string) error
 	DeleteAllTransaction(ctx context.Context) error
 }
@@ -2,11 +2,17 @@ package transaction
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+const insertBatchSize = 100
+
 type TransactionRepositoryImpl struct {
 	*gorm.DB
 }
@@ -20,30 +26,241 @@ func (repository *TransactionRepositoryImpl) InsertTransaction(ctx context.Conte
 	return transaction, err
 }
 
+// InsertTransactionBatch inserts all transactions in one database
+// transaction, so either every row is saved or none is.
+func (repository *TransactionRepositoryImpl) InsertTransactionBatch(ctx context.Context, transactions []entity.Transaction) error {
+	return repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return tx.CreateInBatches(&transactions, insertBatchSize).Error
+	})
+}
+
+// FindExistingTransactionIDs returns which of transactionIds are already
+// used.
+func (repository *TransactionRepositoryImpl) FindExistingTransactionIDs(ctx context.Context, transactionIds []string) (existing []string, err error) {
+	if len(transactionIds) == 0 {
+		return existing, nil
+	}
+	err = repository.DB.WithContext(ctx).Model(&entity.Transaction{}).Where("transaction_id IN ?", transactionIds).Pluck("transaction_id", &existing).Error
+	return existing, err
+}
+
+// FindHeldTransactionIDs returns which of transactionIds are on hold by an
+// active dispute.
+func (repository *TransactionRepositoryImpl) FindHeldTransactionIDs(ctx context.Context, transactionIds []string) (held []string, err error) {
+	if len(transactionIds) == 0 {
+		return held, nil
+	}
+	err = repository.DB.WithContext(ctx).Model(&entity.Transaction{}).Where("transaction_id IN ? AND on_hold = ?", transactionIds, true).Pluck("transaction_id", &held).Error
+	return held, err
+}
+
 func (repository *TransactionRepositoryImpl) FindTransactionByID(ctx context.Context, transactionId string) (transaction entity.Transaction, err error) {
-	err = repository.DB.WithContext(ctx).Where("transaction_id", transactionId).First(&transaction).Error
+	err = repository.DB.WithContext(ctx).Preload("Tags").Preload("Splits").Where("transaction_id", transactionId).First(&transaction).Error
 	return transaction, err
 }
 
 func (repository *TransactionRepositoryImpl) FindAllTransaction(ctx context.Context) (transactions []entity.Transaction, err error) {
-	err = repository.DB.WithContext(ctx).Find(&transactions).Error
+	err = repository.DB.WithContext(ctx).Preload("Tags").Preload("Splits").Find(&transactions).Error
 	return transactions, err
 }
 
-func (repository *TransactionRepositoryImpl) FindTransactionByUserId(ctx context.Context, userId string) (transactions []entity.Transaction, err error) {
-	err = repository.DB.WithContext(ctx).Where("user_id", userId).Find(&transactions).Error
+func (repository *TransactionRepositoryImpl) FindTransactionByUserId(ctx context.Context, userId string, filter model.TransactionFilter) (transactions []entity.Transaction, err error) {
+	err = repository.filterByUser(repository.DB.WithContext(ctx).Preload("Tags").Preload("Splits"), userId, filter).Find(&transactions).Error
 	return transactions, err
 }
 
+// StreamTransaction calls fn for every transaction matching the filter while
+// reading rows from MySQL one at a time. An empty userId streams every
+// transaction. Streaming stops at the first error returned by fn.
+func (repository *TransactionRepositoryImpl) StreamTransaction(ctx context.Context, userId string, filter model.TransactionFilter, fn func(row model.TransactionRow) error) error {
+	tags := repository.DB.Table("transaction_tags").
+		Select("GROUP_CONCAT(tags.name ORDER BY tags.name SEPARATOR ',')").
+		Joins("JOIN tags ON tags.tag_id = transaction_tags.tag_id").
+		Where("transaction_tags.transaction_id = transactions.transaction_id")
+	query := repository.DB.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("transaction_id, name, amount, currency, user_id, COALESCE(category_id, '') AS category_id, split_method, created_at, COALESCE((?), '') AS tags", tags).
+		Order("created_at, transaction_id")
+	if userId != "" {
+		query = repository.filterByUser(query, userId, filter)
+	}
+
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row model.TransactionRow
+		err = repository.DB.ScanRows(rows, &row)
+		if err != nil {
+			return err
+		}
+		err = fn(row)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// filterByUser narrows query to the transactions userId owns or shares,
+// further filtered by category and tag.
+func (repository *TransactionRepositoryImpl) filterByUser(query *gorm.DB, userId string, filter model.TransactionFilter) *gorm.DB {
+	shared := repository.DB.Table("transaction_splits").Select("transaction_id").Where("user_id", userId)
+	query = query.Where("user_id = ? OR transaction_id IN (?)", userId, shared)
+	if len(filter.CategoryIDs) > 0 {
+		query = query.Where("category_id IN ?", filter.CategoryIDs)
+	}
+	if filter.Tag != "" {
+		tagged := repository.DB.Table("transaction_tags").
+			Select("transaction_tags.transaction_id").
+			Joins("JOIN tags ON tags.tag_id = transaction_tags.tag_id").
+			Where("tags.user_id = ? AND tags.name = ?", userId, filter.Tag)
+		query = query.Where("transaction_id IN (?)", tagged)
+	}
+	return query
+}
+
+// SumTransactionAmount totals the amounts of transactions owned by userId in
+// currency, in the given categories and created within [from, to).
+func (repository *TransactionRepositoryImpl) SumTransactionAmount(ctx context.Context, userId string, currency string, categoryIds []string, from time.Time, to time.Time) (total int64, err error) {
+	err = repository.DB.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("user_id = ? AND currency = ? AND category_id IN ? AND created_at >= ? AND created_at < ?", userId, currency, categoryIds, from, to).
+		Scan(&total).Error
+	return total, err
+}
+
+// AggregateTransaction totals the transactions userId owns or shares in
+// filter's date range, grouped in the database by the requested keys.
+func (repository *TransactionRepositoryImpl) AggregateTransaction(ctx context.Context, userId string, filter model.ReportFilter) (rows []model.ReportRow, err error) {
+	local := "CONVERT_TZ(created_at, '+00:00', ?)"
+	var columns, groups []string
+	var args []interface{}
+	for _, group := range filter.GroupBy {
+		switch group {
+		case model.ReportGroupDay:
+			columns = append(columns, "DATE_FORMAT("+local+", '%Y-%m-%d') AS period")
+			args = append(args, filter.Offset)
+			groups = append(groups, "period")
+		case model.ReportGroupWeek:
+			columns = append(columns, "DATE_FORMAT(DATE_SUB(DATE("+local+"), INTERVAL WEEKDAY("+local+") DAY), '%Y-%m-%d') AS period")
+			args = append(args, filter.Offset, filter.Offset)
+			groups = append(groups, "period")
+		case model.ReportGroupMonth:
+			columns = append(columns, "DATE_FORMAT("+local+", '%Y-%m') AS period")
+			args = append(args, filter.Offset)
+			groups = append(groups, "period")
+		case model.ReportGroupCategory:
+			columns = append(columns, "COALESCE(category_id, '') AS category_id")
+			groups = append(groups, "category_id")
+		case model.ReportGroupCurrency:
+			columns = append(columns, "currency")
+			groups = append(groups, "currency")
+		case model.ReportGroupUser:
+			columns = append(columns, "user_id")
+			groups = append(groups, "user_id")
+		}
+	}
+	columns = append(columns, "SUM(amount) AS `sum`", "COUNT(*) AS `count`", "MIN(amount) AS `min`", "MAX(amount) AS `max`")
+
+	shared := repository.DB.Table("transaction_splits").Select("transaction_id").Where("user_id", userId)
+	query := repository.DB.WithContext(ctx).Model(&entity.Transaction{}).
+		Select(strings.Join(columns, ", "), args...).
+		Where("user_id = ? OR transaction_id IN (?)", userId, shared).
+		Where("created_at >= ? AND created_at < ?", filter.From, filter.To)
+	if len(groups) > 0 {
+		query = query.Group(strings.Join(groups, ", ")).Order(strings.Join(groups, ", "))
+	}
+	err = query.Scan(&rows).Error
+	return rows, err
+}
+
+// FindTransactionBetween returns the transactions userId owns that were
+// created within [from, to), oldest first, with their category.
+func (repository *TransactionRepositoryImpl) FindTransactionBetween(ctx context.Context, userId string, from time.Time, to time.Time) (transactions []entity.Transaction, err error) {
+	err = repository.DB.WithContext(ctx).Preload("Category").
+		Where("user_id = ? AND created_at >= ? AND created_at < ?", userId, from, to).
+		Order("created_at, transaction_id").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// SumTransactionAmountByCurrency totals, per currency, the amounts of the
+// transactions userId owns that were created before before.
+func (repository *TransactionRepositoryImpl) SumTransactionAmountByCurrency(ctx context.Context, userId string, before time.Time) (totals []model.CurrencyTotal, err error) {
+	err = repository.DB.WithContext(ctx).Model(&entity.Transaction{}).
+		Select("currency, SUM(amount) AS amount").
+		Where("user_id = ? AND created_at < ?", userId, before).
+		Group("currency").
+		Order("currency").
+		Scan(&totals).Error
+	return totals, err
+}
+
 func (repository *TransactionRepositoryImpl) UpdateTransaction(ctx context.Context, transaction entity.Transaction) (entity.Transaction, error) {
-	err := repository.DB.WithContext(ctx).Where("transaction_id", transaction.TransactionID).Updates(&transaction).Error
+	err := repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateTransaction(tx, &transaction)
+	})
 	return transaction, err
 }
 
+func (repository *TransactionRepositoryImpl) UpdateTransactionBatch(ctx context.Context, transactions []entity.Transaction) error {
+	return repository.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range transactions {
+			err := updateTransaction(tx, &transactions[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// updateTransaction saves the transaction's columns and replaces its splits
+// and tags inside tx. The hold is left alone, as only disputes change it.
+func updateTransaction(tx *gorm.DB, transaction *entity.Transaction) error {
+	err := tx.Select("*").Omit("Tags", "Splits", "OnHold").Where("transaction_id", transaction.TransactionID).Updates(transaction).Error
+	if err != nil {
+		return err
+	}
+	err = tx.Where("transaction_id", transaction.TransactionID).Delete(&entity.TransactionSplit{}).Error
+	if err != nil {
+		return err
+	}
+	for i := range transaction.Splits {
+		transaction.Splits[i].TransactionID = transaction.TransactionID
+	}
+	if len(transaction.Splits) > 0 {
+		err = tx.Create(&transaction.Splits).Error
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Model(transaction).Association("Tags").Replace(transaction.Tags)
+}
+
 func (repository *TransactionRepositoryImpl) DeleteTransaction(ctx context.Context, transactionId string) error {
 	return repository.DB.WithContext(ctx).Where("transaction_id", transactionId).Delete(&entity.Transaction{}).Error
 }
 
+func (repository *TransactionRepositoryImpl) DeleteTransactionBatch(ctx context.Context, transactionIds []string) error {
+	return repository.DB.WithContext(ctx).Where("transaction_id IN ?", transactionIds).Delete(&entity.Transaction{}).Error
+}
+
+func (repository *TransactionRepositoryImpl) HasHeldTransaction(ctx context.Context, tx *gorm.DB, userId string) (bool, error) {
+	var onHold []bool
+	err := tx.WithContext(ctx).Model(&entity.Transaction{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id", userId).Pluck("on_hold", &onHold).Error
+	for _, held := range onHold {
+		if held {
+			return true, err
+		}
+	}
+	return false, err
+}
+
 func (repository *TransactionRepositoryImpl) DeleteTransactionByUserId(ctx context.Context, tx *gorm.DB, userId string) error {
 	return tx.WithContext(ctx).Where("user_id", userId).Delete(&entity.Transaction{}).Error
 }
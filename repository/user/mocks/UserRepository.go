@@ -67,6 +67,27 @@ func (_m *UserRepository) FindAllUser(ctx context.Context) ([]entity.User, error
 	return r0, r1
 }
 
+// FindUserByEmail provides a mock function with given fields: ctx, email
+func (_m *UserRepository) FindUserByEmail(ctx context.Context, email string) (entity.User, error) {
+	ret := _m.Called(ctx, email)
+
+	var r0 entity.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) entity.User); ok {
+		r0 = rf(ctx, email)
+	} else {
+		r0 = ret.Get(0).(entity.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindUserByID provides a mock function with given fields: ctx, userId
 func (_m *UserRepository) FindUserByID(ctx context.Context, userId string) (entity.User, error) {
 	ret := _m.Called(ctx, userId)
@@ -130,6 +151,20 @@ func (_m *UserRepository) InsertUser(ctx context.Context, _a1 entity.User) (enti
 	return r0, r1
 }
 
+// StreamUser provides a mock function with given fields: ctx, fn
+func (_m *UserRepository) StreamUser(ctx context.Context, fn func(entity.User) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(entity.User) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUser provides a mock function with given fields: ctx, _a1
 func (_m *UserRepository) UpdateUser(ctx context.Context, _a1 entity.User) (entity.User, error) {
 	ret := _m.Called(ctx, _a1)
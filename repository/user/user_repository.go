@@ -11,7 +11,11 @@ type UserRepository interface {
 	InsertUser(ctx context.Context, user entity.User) (entity.User, error)
 	FindUserByID(ctx context.Context, userId string) (user entity.User, err error)
 	FindAllUser(ctx context.Context) (users []entity.User, err error)
+	StreamUser(ctx context.Context, fn func(user entity.User) error) error
 	FindUserByUsername(ctx context.Context, username string) (user entity.User, err error)
+	FindUserByEmail(ctx context.Context, email string) (user entity.User, err error)
+	// UpdateUser writes the profile of user. It never writes the password or
+	// the role.
 	UpdateUser(ctx context.Context, user entity.User) (entity.User, error)
 	DeleteUser(ctx context.Context, tx *gorm.DB, userId string) error
 	DeleteAllUser(ctx context.Context) error
@@ -30,13 +30,44 @@ func (repository *UserRepositoryImpl) FindUserByUsername(ctx context.Context, us
 	return user, err
 }
 
+func (repository *UserRepositoryImpl) FindUserByEmail(ctx context.Context, email string) (user entity.User, err error) {
+	err = repository.DB.WithContext(ctx).Where("email", email).First(&user).Error
+	return user, err
+}
+
 func (repository *UserRepositoryImpl) FindAllUser(ctx context.Context) (users []entity.User, err error) {
 	err = repository.DB.WithContext(ctx).Find(&users).Error
 	return users, err
 }
 
+// StreamUser calls fn for every user while reading rows from MySQL one at a
+// time. Passwords are never selected.
+func (repository *UserRepositoryImpl) StreamUser(ctx context.Context, fn func(user entity.User) error) error {
+	rows, err := repository.DB.WithContext(ctx).Model(&entity.User{}).
+		Select("user_id, username, email, handphone").
+		Order("username").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user entity.User
+		err = repository.DB.ScanRows(rows, &user)
+		if err != nil {
+			return err
+		}
+		err = fn(user)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func (repository *UserRepositoryImpl) UpdateUser(ctx context.Context, user entity.User) (entity.User, error) {
-	err := repository.DB.WithContext(ctx).Where("user_id", user.UserID).Updates(&user).Error
+	err := repository.DB.WithContext(ctx).Select("*").Omit("password", "role").Where("user_id", user.UserID).Updates(&user).Error
 	return user, err
 }
 
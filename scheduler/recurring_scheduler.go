@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/repository/lock"
+	"github.com/vnnyx/golang-dot-api/service/recurring"
+)
+
+const recurringLockKey = "scheduler:recurring:leader"
+
+// RecurringScheduler periodically materializes due recurring transactions.
+// Every replica runs the loop, but only the one holding the Redis leader lock
+// does any work; the lease outlives a few missed ticks so a crashed leader is
+// replaced automatically.
+type RecurringScheduler struct {
+	recurring.RecurringService
+	lock.LockRepository
+	Interval time.Duration
+	Token    string
+}
+
+func NewRecurringScheduler(recurringService recurring.RecurringService, lockRepository lock.LockRepository, config *infrastructure.Config) *RecurringScheduler {
+	interval := time.Duration(config.SchedulerIntervalSecond) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &RecurringScheduler{
+		RecurringService: recurringService,
+		LockRepository:   lockRepository,
+		Interval:         interval,
+		Token:            uuid.NewString(),
+	}
+}
+
+// Start blocks until ctx is cancelled.
+func (scheduler *RecurringScheduler) Start(ctx context.Context) {
+	ticker := time.NewTicker(scheduler.Interval)
+	defer ticker.Stop()
+	defer func() {
+		err := scheduler.LockRepository.ReleaseLock(context.Background(), recurringLockKey, scheduler.Token)
+		if err != nil {
+			log.Printf("recurring scheduler: release lock: %v", err)
+		}
+	}()
+
+	scheduler.Tick(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			scheduler.Tick(ctx)
+		}
+	}
+}
+
+// Tick runs a single scheduling round if this replica is the leader.
+func (scheduler *RecurringScheduler) Tick(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("recurring scheduler: recovered from panic: %v", r)
+		}
+	}()
+
+	leader, err := scheduler.LockRepository.AcquireLock(ctx, recurringLockKey, scheduler.Token, 3*scheduler.Interval)
+	if err != nil {
+		log.Printf("recurring scheduler: acquire lock: %v", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	created, err := scheduler.RecurringService.RunDueRecurring(ctx, time.Now())
+	if err != nil {
+		log.Printf("recurring scheduler: run due recurring: %v", err)
+	}
+	if created > 0 {
+		log.Printf("recurring scheduler: created %d transactions", created)
+	}
+}
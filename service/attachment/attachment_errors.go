@@ -0,0 +1,13 @@
+package attachment
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrAttachmentNotFound  = apperror.NotFound("ATTACHMENT_NOT_FOUND", "attachment_id")
+	ErrTransactionNotFound = apperror.NotFound("TRANSACTION_NOT_FOUND", "transaction_id")
+	ErrTransactionOnHold   = apperror.New(http.StatusConflict, "TRANSACTION_ON_HOLD", "transaction_id", "is on hold by an open dispute")
+)
@@ -0,0 +1,14 @@
+package attachment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, request web.AttachmentUploadRequest) (response web.AttachmentResponse, err error)
+	GetAttachmentByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.AttachmentResponse, err error)
+	DownloadAttachment(ctx context.Context, request web.AttachmentRequest) (response web.AttachmentContent, err error)
+	RemoveAttachment(ctx context.Context, request web.AttachmentRequest) error
+}
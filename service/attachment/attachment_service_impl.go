@@ -0,0 +1,185 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/storage"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/attachment"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const defaultMaxSizeMB = 5
+
+type AttachmentServiceImpl struct {
+	attachment.AttachmentRepository
+	transaction.TransactionRepository
+	storage.Storage
+	MaxSize int64
+}
+
+func NewAttachmentService(attachmentRepository attachment.AttachmentRepository, transactionRepository transaction.TransactionRepository, storage storage.Storage, config *infrastructure.Config) AttachmentService {
+	maxSizeMB := config.AttachmentMaxSizeMB
+	if maxSizeMB <= 0 {
+		maxSizeMB = defaultMaxSizeMB
+	}
+	return &AttachmentServiceImpl{
+		AttachmentRepository:  attachmentRepository,
+		TransactionRepository: transactionRepository,
+		Storage:               storage,
+		MaxSize:               int64(maxSizeMB) << 20,
+	}
+}
+
+func (service *AttachmentServiceImpl) UploadAttachment(ctx context.Context, request web.AttachmentUploadRequest) (response web.AttachmentResponse, err error) {
+	transaction, err := service.findOwnedTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return response, err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(request.Content, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return response, err
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	fileName := filepath.Base(request.FileName)
+
+	err = validation.UploadAttachmentValidation(fileName, contentType, request.Size, service.MaxSize)
+	if err != nil {
+		return response, err
+	}
+
+	attachmentId := uuid.NewString()
+	key := "transactions/" + transaction.TransactionID + "/" + attachmentId
+	err = service.Storage.Put(ctx, key, contentType, io.MultiReader(bytes.NewReader(head), request.Content), request.Size)
+	if err != nil {
+		return response, err
+	}
+
+	attachment, err := service.AttachmentRepository.InsertAttachment(ctx, entity.Attachment{
+		AttachmentID:  attachmentId,
+		TransactionID: transaction.TransactionID,
+		FileName:      fileName,
+		ContentType:   contentType,
+		Size:          request.Size,
+		StorageKey:    key,
+	})
+	if err != nil {
+		_ = service.Storage.Delete(ctx, key)
+		return response, err
+	}
+
+	return toAttachmentResponse(attachment), nil
+}
+
+func (service *AttachmentServiceImpl) GetAttachmentByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.AttachmentResponse, err error) {
+	transaction, err := service.findOwnedTransaction(ctx, userId, transactionId)
+	if err != nil {
+		return response, err
+	}
+
+	attachments, err := service.AttachmentRepository.FindAttachmentByTransactionId(ctx, transaction.TransactionID)
+	if err != nil {
+		return response, err
+	}
+
+	for _, attachment := range attachments {
+		response = append(response, toAttachmentResponse(attachment))
+	}
+
+	return response, nil
+}
+
+func (service *AttachmentServiceImpl) DownloadAttachment(ctx context.Context, request web.AttachmentRequest) (response web.AttachmentContent, err error) {
+	attachment, err := service.findOwnedAttachment(ctx, request)
+	if err != nil {
+		return response, err
+	}
+
+	content, err := service.Storage.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return response, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return response, err
+	}
+
+	return web.AttachmentContent{
+		AttachmentResponse: toAttachmentResponse(attachment),
+		Content:            content,
+	}, nil
+}
+
+// RemoveAttachment refuses to remove the attachments of a transaction on
+// hold, and attachments that are evidence of an open dispute, which would
+// lose them along with the attachment.
+func (service *AttachmentServiceImpl) RemoveAttachment(ctx context.Context, request web.AttachmentRequest) error {
+	transaction, err := service.findOwnedTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return err
+	}
+	if transaction.OnHold {
+		return ErrTransactionOnHold
+	}
+
+	attachment, err := service.AttachmentRepository.FindAttachmentByID(ctx, request.AttachmentID)
+	if err != nil || attachment.TransactionID != transaction.TransactionID {
+		return ErrAttachmentNotFound
+	}
+	evidence, err := service.AttachmentRepository.IsActiveDisputeEvidence(ctx, attachment.AttachmentID)
+	if err != nil {
+		return err
+	}
+	if evidence {
+		return ErrTransactionOnHold
+	}
+
+	err = service.AttachmentRepository.DeleteAttachment(ctx, attachment.AttachmentID)
+	if err != nil {
+		return err
+	}
+	return service.Storage.Delete(ctx, attachment.StorageKey)
+}
+
+func (service *AttachmentServiceImpl) findOwnedTransaction(ctx context.Context, userId string, transactionId string) (entity.Transaction, error) {
+	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, transactionId)
+	if err != nil || transaction.UserID != userId {
+		return transaction, ErrTransactionNotFound
+	}
+	return transaction, nil
+}
+
+func (service *AttachmentServiceImpl) findOwnedAttachment(ctx context.Context, request web.AttachmentRequest) (entity.Attachment, error) {
+	transaction, err := service.findOwnedTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return entity.Attachment{}, err
+	}
+
+	attachment, err := service.AttachmentRepository.FindAttachmentByID(ctx, request.AttachmentID)
+	if err != nil || attachment.TransactionID != transaction.TransactionID {
+		return attachment, ErrAttachmentNotFound
+	}
+	return attachment, nil
+}
+
+func toAttachmentResponse(attachment entity.Attachment) web.AttachmentResponse {
+	return web.AttachmentResponse{
+		AttachmentID:  attachment.AttachmentID,
+		TransactionID: attachment.TransactionID,
+		FileName:      attachment.FileName,
+		ContentType:   attachment.ContentType,
+		Size:          attachment.Size,
+		CreatedAt:     attachment.CreatedAt,
+	}
+}
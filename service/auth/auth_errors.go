@@ -0,0 +1,9 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var ErrUnauthorized = apperror.New(http.StatusUnauthorized, "UNAUTHORIZED", "message", "Unauthorized")
@@ -2,9 +2,9 @@ package auth
 
 import (
 	"context"
-	"errors"
 
 	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
 	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/web"
 	"github.com/vnnyx/golang-dot-api/repository/auth"
@@ -28,11 +28,13 @@ func NewAuthService(config *infrastructure.Config, db *gorm.DB, userRepository u
 func (service *AuthServiceImpl) Login(ctx context.Context, request web.LoginRequest) (response web.LoginResponse, err error) {
 	user, err := service.UserRepository.FindUserByUsername(ctx, request.Username)
 	if err != nil {
-		return response, errors.New(web.UNAUTHORIZATION)
+		metrics.Logins.WithLabelValues(metrics.LoginFailure).Inc()
+		return response, ErrUnauthorized
 	}
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(request.Password))
 	if err != nil {
-		return response, errors.New(web.UNAUTHORIZATION)
+		metrics.Logins.WithLabelValues(metrics.LoginFailure).Inc()
+		return response, ErrUnauthorized
 	}
 
 	td := util.CreateToken(model.JwtPayload{
@@ -58,6 +60,7 @@ func (service *AuthServiceImpl) Login(ctx context.Context, request web.LoginRequ
 		Username:    user.Username,
 		Email:       user.Email,
 	}
+	metrics.Logins.WithLabelValues(metrics.LoginSuccess).Inc()
 
 	return response, nil
 
@@ -66,7 +69,7 @@ func (service *AuthServiceImpl) Login(ctx context.Context, request web.LoginRequ
 func (service *AuthServiceImpl) Logout(ctx context.Context, accessUUID string) error {
 	err := service.AuthRepository.DeleteToken(ctx, accessUUID)
 	if err != nil {
-		return errors.New(web.UNAUTHORIZATION)
+		return ErrUnauthorized
 	}
 	return nil
 }
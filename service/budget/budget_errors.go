@@ -0,0 +1,14 @@
+package budget
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrCategoryNotFound    = apperror.NotFound("CATEGORY_NOT_FOUND", "category_id")
+	ErrBudgetAlreadyExists = apperror.New(http.StatusConflict, "BUDGET_ALREADY_EXISTS", "category_id", "already has a budget")
+	ErrBudgetNotFound      = apperror.NotFound("BUDGET_NOT_FOUND", "budget_id")
+	ErrInvalidPeriod       = apperror.New(http.StatusBadRequest, "INVALID_PERIOD", "period", "must be in YYYY-MM format")
+)
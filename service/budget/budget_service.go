@@ -0,0 +1,15 @@
+package budget
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type BudgetService interface {
+	CreateBudget(ctx context.Context, request web.BudgetCreateRequest) (response web.BudgetResponse, err error)
+	GetBudgetById(ctx context.Context, request web.BudgetRequest) (response web.BudgetResponse, err error)
+	GetBudgetByUserId(ctx context.Context, request web.BudgetRequest) (response []web.BudgetResponse, err error)
+	UpdateBudget(ctx context.Context, request web.BudgetUpdateRequest) (response web.BudgetResponse, err error)
+	RemoveBudget(ctx context.Context, userId string, budgetId string) error
+}
@@ -0,0 +1,192 @@
+package budget
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/budget"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type BudgetServiceImpl struct {
+	budget.BudgetRepository
+	category.CategoryRepository
+	transaction.TransactionRepository
+}
+
+func NewBudgetService(budgetRepository budget.BudgetRepository, categoryRepository category.CategoryRepository, transactionRepository transaction.TransactionRepository) BudgetService {
+	return &BudgetServiceImpl{BudgetRepository: budgetRepository, CategoryRepository: categoryRepository, TransactionRepository: transactionRepository}
+}
+
+func (service *BudgetServiceImpl) CreateBudget(ctx context.Context, request web.BudgetCreateRequest) (response web.BudgetResponse, err error) {
+	err = validation.CreateBudgetValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	category, err := service.CategoryRepository.FindCategoryByID(ctx, request.CategoryID)
+	if err != nil || category.UserID != request.UserID {
+		return response, ErrCategoryNotFound
+	}
+
+	existing, err := service.BudgetRepository.FindBudgetByCategoryIds(ctx, request.UserID, []string{category.CategoryID})
+	if err != nil {
+		return response, err
+	}
+	if len(existing) > 0 {
+		return response, ErrBudgetAlreadyExists
+	}
+
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	currency := request.Currency
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+
+	budget, err := service.BudgetRepository.InsertBudget(ctx, entity.Budget{
+		BudgetID:   uuid.NewString(),
+		UserID:     request.UserID,
+		CategoryID: category.CategoryID,
+		Amount:     request.Amount,
+		Currency:   currency,
+		Timezone:   timezone,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return service.toBudgetResponse(ctx, budget, time.Now())
+}
+
+func (service *BudgetServiceImpl) GetBudgetById(ctx context.Context, request web.BudgetRequest) (response web.BudgetResponse, err error) {
+	budget, err := service.findOwnedBudget(ctx, request.UserID, request.BudgetID)
+	if err != nil {
+		return response, err
+	}
+
+	at, err := periodTime(request.Period)
+	if err != nil {
+		return response, err
+	}
+
+	return service.toBudgetResponse(ctx, budget, at)
+}
+
+func (service *BudgetServiceImpl) GetBudgetByUserId(ctx context.Context, request web.BudgetRequest) (response []web.BudgetResponse, err error) {
+	at, err := periodTime(request.Period)
+	if err != nil {
+		return response, err
+	}
+
+	budgets, err := service.BudgetRepository.FindBudgetByUserId(ctx, request.UserID)
+	if err != nil {
+		return response, err
+	}
+
+	for _, budget := range budgets {
+		budgetResponse, err := service.toBudgetResponse(ctx, budget, at)
+		if err != nil {
+			return response, err
+		}
+		response = append(response, budgetResponse)
+	}
+
+	return response, nil
+}
+
+func (service *BudgetServiceImpl) UpdateBudget(ctx context.Context, request web.BudgetUpdateRequest) (response web.BudgetResponse, err error) {
+	err = validation.UpdateBudgetValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	budget, err := service.findOwnedBudget(ctx, request.UserID, request.BudgetID)
+	if err != nil {
+		return response, err
+	}
+
+	budget.Amount = request.Amount
+	if request.Currency != "" {
+		budget.Currency = request.Currency
+	}
+	if request.Timezone != "" {
+		budget.Timezone = request.Timezone
+	}
+	budget, err = service.BudgetRepository.UpdateBudget(ctx, budget)
+	if err != nil {
+		return response, err
+	}
+
+	return service.toBudgetResponse(ctx, budget, time.Now())
+}
+
+func (service *BudgetServiceImpl) RemoveBudget(ctx context.Context, userId string, budgetId string) error {
+	budget, err := service.findOwnedBudget(ctx, userId, budgetId)
+	if err != nil {
+		return err
+	}
+	return service.BudgetRepository.DeleteBudget(ctx, budget.BudgetID)
+}
+
+func (service *BudgetServiceImpl) findOwnedBudget(ctx context.Context, userId string, budgetId string) (entity.Budget, error) {
+	budget, err := service.BudgetRepository.FindBudgetByID(ctx, budgetId)
+	if err != nil || budget.UserID != userId {
+		return budget, ErrBudgetNotFound
+	}
+	return budget, nil
+}
+
+// toBudgetResponse reports the spending of the month containing at, in the
+// budget's own timezone and currency.
+func (service *BudgetServiceImpl) toBudgetResponse(ctx context.Context, budget entity.Budget, at time.Time) (response web.BudgetResponse, err error) {
+	location, err := time.LoadLocation(budget.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, budget.UserID)
+	if err != nil {
+		return response, err
+	}
+
+	from, to := util.MonthRange(at, location)
+	spent, err := service.TransactionRepository.SumTransactionAmount(ctx, budget.UserID, budget.Currency, util.CategoryDescendantIDs(categories, budget.CategoryID), from, to)
+	if err != nil {
+		return response, err
+	}
+
+	return web.BudgetResponse{
+		BudgetID:    budget.BudgetID,
+		UserID:      budget.UserID,
+		CategoryID:  budget.CategoryID,
+		Amount:      budget.Amount,
+		Currency:    budget.Currency,
+		Timezone:    budget.Timezone,
+		Period:      from.Format(util.PeriodLayout),
+		Spent:       spent,
+		Remaining:   budget.Amount - spent,
+		PercentUsed: float64(spent*10000/budget.Amount) / 100,
+	}, nil
+}
+
+// periodTime returns a time inside the requested YYYY-MM month. Mid-month is
+// used so the month is the same in every timezone.
+func periodTime(period string) (time.Time, error) {
+	if period == "" {
+		return time.Now(), nil
+	}
+	month, err := time.Parse(util.PeriodLayout, period)
+	if err != nil {
+		return month, ErrInvalidPeriod
+	}
+	return month.AddDate(0, 0, 14), nil
+}
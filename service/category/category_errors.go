@@ -0,0 +1,13 @@
+package category
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrInvalidCategoryParent = apperror.New(http.StatusBadRequest, "INVALID_CATEGORY_PARENT", "parent_id", "must not be the category itself or one of its descendants")
+	ErrCategoryRuleNotFound  = apperror.NotFound("CATEGORY_RULE_NOT_FOUND", "rule_id")
+	ErrCategoryNotFound      = apperror.NotFound("CATEGORY_NOT_FOUND", "category_id")
+)
@@ -0,0 +1,18 @@
+package category
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, request web.CategoryCreateRequest) (response web.CategoryResponse, err error)
+	GetCategoryById(ctx context.Context, userId string, categoryId string) (response web.CategoryResponse, err error)
+	GetCategoryByUserId(ctx context.Context, userId string) (response []web.CategoryResponse, err error)
+	UpdateCategory(ctx context.Context, request web.CategoryUpdateRequest) (response web.CategoryResponse, err error)
+	RemoveCategory(ctx context.Context, userId string, categoryId string) error
+	CreateCategoryRule(ctx context.Context, request web.CategoryRuleCreateRequest) (response web.CategoryRuleResponse, err error)
+	GetCategoryRuleByUserId(ctx context.Context, userId string) (response []web.CategoryRuleResponse, err error)
+	RemoveCategoryRule(ctx context.Context, userId string, ruleId string) error
+}
@@ -0,0 +1,202 @@
+package category
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type CategoryServiceImpl struct {
+	category.CategoryRepository
+}
+
+func NewCategoryService(categoryRepository category.CategoryRepository) CategoryService {
+	return &CategoryServiceImpl{CategoryRepository: categoryRepository}
+}
+
+func (service *CategoryServiceImpl) CreateCategory(ctx context.Context, request web.CategoryCreateRequest) (response web.CategoryResponse, err error) {
+	err = validation.CreateCategoryValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	var parentId *string
+	if request.ParentID != "" {
+		parent, err := service.findOwnedCategory(ctx, request.UserID, request.ParentID)
+		if err != nil {
+			return response, err
+		}
+		parentId = &parent.CategoryID
+	}
+
+	category, err := service.CategoryRepository.InsertCategory(ctx, entity.Category{
+		CategoryID: uuid.NewString(),
+		UserID:     request.UserID,
+		ParentID:   parentId,
+		Name:       request.Name,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return toCategoryResponse(category), nil
+}
+
+func (service *CategoryServiceImpl) GetCategoryById(ctx context.Context, userId string, categoryId string) (response web.CategoryResponse, err error) {
+	category, err := service.findOwnedCategory(ctx, userId, categoryId)
+	if err != nil {
+		return response, err
+	}
+
+	return toCategoryResponse(category), nil
+}
+
+func (service *CategoryServiceImpl) GetCategoryByUserId(ctx context.Context, userId string) (response []web.CategoryResponse, err error) {
+	categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, userId)
+	if err != nil {
+		return response, err
+	}
+
+	return buildCategoryTree(categories, nil), nil
+}
+
+func (service *CategoryServiceImpl) UpdateCategory(ctx context.Context, request web.CategoryUpdateRequest) (response web.CategoryResponse, err error) {
+	err = validation.UpdateCategoryValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	category, err := service.findOwnedCategory(ctx, request.UserID, request.CategoryID)
+	if err != nil {
+		return response, err
+	}
+
+	category.ParentID = nil
+	if request.ParentID != "" {
+		categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, request.UserID)
+		if err != nil {
+			return response, err
+		}
+		for _, descendantId := range util.CategoryDescendantIDs(categories, category.CategoryID) {
+			if descendantId == request.ParentID {
+				return response, ErrInvalidCategoryParent
+			}
+		}
+
+		parent, err := service.findOwnedCategory(ctx, request.UserID, request.ParentID)
+		if err != nil {
+			return response, err
+		}
+		category.ParentID = &parent.CategoryID
+	}
+	category.Name = request.Name
+
+	category, err = service.CategoryRepository.UpdateCategory(ctx, category)
+	if err != nil {
+		return response, err
+	}
+
+	return toCategoryResponse(category), nil
+}
+
+func (service *CategoryServiceImpl) RemoveCategory(ctx context.Context, userId string, categoryId string) error {
+	category, err := service.findOwnedCategory(ctx, userId, categoryId)
+	if err != nil {
+		return err
+	}
+	return service.CategoryRepository.DeleteCategory(ctx, category.CategoryID)
+}
+
+func (service *CategoryServiceImpl) CreateCategoryRule(ctx context.Context, request web.CategoryRuleCreateRequest) (response web.CategoryRuleResponse, err error) {
+	err = validation.CreateCategoryRuleValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	category, err := service.findOwnedCategory(ctx, request.UserID, request.CategoryID)
+	if err != nil {
+		return response, err
+	}
+
+	rule, err := service.CategoryRepository.InsertCategoryRule(ctx, entity.CategoryRule{
+		RuleID:     uuid.NewString(),
+		UserID:     request.UserID,
+		CategoryID: category.CategoryID,
+		MatchType:  request.MatchType,
+		Pattern:    request.Pattern,
+		Priority:   request.Priority,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return toCategoryRuleResponse(rule), nil
+}
+
+func (service *CategoryServiceImpl) GetCategoryRuleByUserId(ctx context.Context, userId string) (response []web.CategoryRuleResponse, err error) {
+	rules, err := service.CategoryRepository.FindCategoryRuleByUserId(ctx, userId)
+	if err != nil {
+		return response, err
+	}
+
+	for _, rule := range rules {
+		response = append(response, toCategoryRuleResponse(rule))
+	}
+
+	return response, nil
+}
+
+func (service *CategoryServiceImpl) RemoveCategoryRule(ctx context.Context, userId string, ruleId string) error {
+	rule, err := service.CategoryRepository.FindCategoryRuleByID(ctx, ruleId)
+	if err != nil || rule.UserID != userId {
+		return ErrCategoryRuleNotFound
+	}
+	return service.CategoryRepository.DeleteCategoryRule(ctx, rule.RuleID)
+}
+
+func (service *CategoryServiceImpl) findOwnedCategory(ctx context.Context, userId string, categoryId string) (entity.Category, error) {
+	category, err := service.CategoryRepository.FindCategoryByID(ctx, categoryId)
+	if err != nil || category.UserID != userId {
+		return entity.Category{}, ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+func buildCategoryTree(categories []entity.Category, parentId *string) (response []web.CategoryResponse) {
+	for _, category := range categories {
+		if (parentId == nil && category.ParentID != nil) || (parentId != nil && (category.ParentID == nil || *category.ParentID != *parentId)) {
+			continue
+		}
+		node := toCategoryResponse(category)
+		node.Children = buildCategoryTree(categories, &category.CategoryID)
+		response = append(response, node)
+	}
+	return response
+}
+
+func toCategoryResponse(category entity.Category) web.CategoryResponse {
+	response := web.CategoryResponse{
+		CategoryID: category.CategoryID,
+		UserID:     category.UserID,
+		Name:       category.Name,
+	}
+	if category.ParentID != nil {
+		response.ParentID = *category.ParentID
+	}
+	return response
+}
+
+func toCategoryRuleResponse(rule entity.CategoryRule) web.CategoryRuleResponse {
+	return web.CategoryRuleResponse{
+		RuleID:     rule.RuleID,
+		CategoryID: rule.CategoryID,
+		MatchType:  rule.MatchType,
+		Pattern:    rule.Pattern,
+		Priority:   rule.Priority,
+	}
+}
@@ -0,0 +1,14 @@
+package comment
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrCommentNotFound     = apperror.NotFound("COMMENT_NOT_FOUND", "comment_id")
+	ErrCommentForbidden    = apperror.New(http.StatusForbidden, "COMMENT_FORBIDDEN", "comment_id", "can only be changed by its author")
+	ErrUserNotFound        = apperror.NotFound("USER_NOT_FOUND", "user_id")
+	ErrTransactionNotFound = apperror.NotFound("TRANSACTION_NOT_FOUND", "transaction_id")
+)
@@ -0,0 +1,15 @@
+package comment
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type CommentService interface {
+	CreateComment(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error)
+	GetCommentByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.CommentResponse, err error)
+	GetCommentById(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error)
+	UpdateComment(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error)
+	RemoveComment(ctx context.Context, request web.CommentRequest) error
+}
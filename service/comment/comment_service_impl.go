@@ -0,0 +1,207 @@
+package comment
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/comment"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type CommentServiceImpl struct {
+	comment.CommentRepository
+	transaction.TransactionRepository
+	user.UserRepository
+}
+
+func NewCommentService(commentRepository comment.CommentRepository, transactionRepository transaction.TransactionRepository, userRepository user.UserRepository) CommentService {
+	return &CommentServiceImpl{
+		CommentRepository:     commentRepository,
+		TransactionRepository: transactionRepository,
+		UserRepository:        userRepository,
+	}
+}
+
+// CreateComment adds a comment to the thread of a transaction the user owns,
+// takes part in or, as an admin, reviews.
+func (service *CommentServiceImpl) CreateComment(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error) {
+	request.Body = strings.TrimSpace(request.Body)
+	err = validation.CommentValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, transaction, err := service.findReadableTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return response, err
+	}
+
+	comment, err := service.CommentRepository.InsertComment(ctx, entity.Comment{
+		CommentID:     uuid.NewString(),
+		TransactionID: transaction.TransactionID,
+		AuthorID:      &user.UserID,
+		Body:          request.Body,
+	})
+	if err != nil {
+		return response, err
+	}
+	comment.Author = &user
+
+	return toCommentResponse(comment), nil
+}
+
+func (service *CommentServiceImpl) GetCommentByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.CommentResponse, err error) {
+	_, transaction, err := service.findReadableTransaction(ctx, userId, transactionId)
+	if err != nil {
+		return response, err
+	}
+
+	comments, err := service.CommentRepository.FindCommentByTransactionId(ctx, transaction.TransactionID)
+	if err != nil {
+		return response, err
+	}
+
+	response = []web.CommentResponse{}
+	for _, comment := range comments {
+		response = append(response, toCommentResponse(comment))
+	}
+	return response, nil
+}
+
+func (service *CommentServiceImpl) GetCommentById(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error) {
+	_, comment, err := service.findComment(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	return toCommentResponse(comment), nil
+}
+
+// UpdateComment replaces the body of a comment and keeps the old body in its
+// history. Only the author can edit a comment, and deleted comments cannot
+// be edited.
+func (service *CommentServiceImpl) UpdateComment(ctx context.Context, request web.CommentRequest) (response web.CommentResponse, err error) {
+	request.Body = strings.TrimSpace(request.Body)
+	err = validation.CommentValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, comment, err := service.findComment(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	if comment.DeletedAt.Valid {
+		return response, ErrCommentNotFound
+	}
+	if !comment.AuthoredBy(user.UserID) {
+		return response, ErrCommentForbidden
+	}
+	if comment.Body == request.Body {
+		return toCommentResponse(comment), nil
+	}
+
+	revision := entity.CommentRevision{
+		RevisionID: uuid.NewString(),
+		CommentID:  comment.CommentID,
+		Body:       comment.Body,
+	}
+	comment.Body = request.Body
+	comment, err = service.CommentRepository.UpdateComment(ctx, comment, revision)
+	if err != nil {
+		return response, err
+	}
+
+	return toCommentResponse(comment), nil
+}
+
+// RemoveComment soft deletes a comment. Authors can remove their own
+// comments and admins can remove any comment.
+func (service *CommentServiceImpl) RemoveComment(ctx context.Context, request web.CommentRequest) error {
+	user, comment, err := service.findComment(ctx, request)
+	if err != nil {
+		return err
+	}
+	if comment.DeletedAt.Valid {
+		return ErrCommentNotFound
+	}
+	if !comment.AuthoredBy(user.UserID) && user.Role != entity.UserRoleAdmin {
+		return ErrCommentForbidden
+	}
+
+	return service.CommentRepository.DeleteComment(ctx, comment.CommentID)
+}
+
+// findReadableTransaction returns the transaction when the user owns it, is
+// one of its split participants or is an admin. Other users get
+// TRANSACTION_NOT_FOUND so they cannot tell whether it exists.
+func (service *CommentServiceImpl) findReadableTransaction(ctx context.Context, userId string, transactionId string) (entity.User, entity.Transaction, error) {
+	user, err := service.UserRepository.FindUserByID(ctx, userId)
+	if err != nil {
+		return user, entity.Transaction{}, ErrUserNotFound
+	}
+	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, transactionId)
+	if err != nil {
+		return user, transaction, ErrTransactionNotFound
+	}
+
+	if transaction.UserID == user.UserID || user.Role == entity.UserRoleAdmin {
+		return user, transaction, nil
+	}
+	for _, split := range transaction.Splits {
+		if split.UserID == user.UserID {
+			return user, transaction, nil
+		}
+	}
+	return user, transaction, ErrTransactionNotFound
+}
+
+func (service *CommentServiceImpl) findComment(ctx context.Context, request web.CommentRequest) (entity.User, entity.Comment, error) {
+	user, transaction, err := service.findReadableTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return user, entity.Comment{}, err
+	}
+
+	comment, err := service.CommentRepository.FindCommentByID(ctx, request.CommentID)
+	if err != nil || comment.TransactionID != transaction.TransactionID {
+		return user, comment, ErrCommentNotFound
+	}
+	return user, comment, nil
+}
+
+// toCommentResponse hides the body and history of deleted comments, which
+// stay in the thread as placeholders. Comments of deleted authors keep their
+// body and show the author as deleted.
+func toCommentResponse(comment entity.Comment) web.CommentResponse {
+	response := web.CommentResponse{
+		CommentID:     comment.CommentID,
+		TransactionID: comment.TransactionID,
+		Author:        web.CommentAuthorResponse{Deleted: comment.AuthorID == nil},
+		Body:          comment.Body,
+		Edited:        len(comment.Revisions) > 0,
+		Deleted:       comment.DeletedAt.Valid,
+		CreatedAt:     comment.CreatedAt,
+		UpdatedAt:     comment.UpdatedAt,
+	}
+	if comment.AuthorID != nil {
+		response.Author.UserID = *comment.AuthorID
+	}
+	if comment.Author != nil {
+		response.Author.Username = comment.Author.Username
+	}
+	if response.Deleted {
+		response.Body = ""
+		return response
+	}
+	for _, revision := range comment.Revisions {
+		response.History = append(response.History, web.CommentRevisionResponse{
+			Body:       revision.Body,
+			ReplacedAt: revision.CreatedAt,
+		})
+	}
+	return response
+}
@@ -0,0 +1,18 @@
+package dispute
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrTransactionNotFound = apperror.NotFound("TRANSACTION_NOT_FOUND", "transaction_id")
+	ErrDisputeAlreadyOpen  = apperror.New(http.StatusConflict, "DISPUTE_ALREADY_OPEN", "transaction_id", "already has an open dispute")
+	ErrDisputeNotFound     = apperror.NotFound("DISPUTE_NOT_FOUND", "dispute_id")
+	ErrDisputeClosed       = apperror.New(http.StatusConflict, "DISPUTE_CLOSED", "dispute_id", "is already resolved")
+	ErrDisputeChanged      = apperror.New(http.StatusConflict, "DISPUTE_CHANGED", "dispute_id", "was changed by another request, try again")
+	ErrUserNotFound        = apperror.NotFound("USER_NOT_FOUND", "user_id")
+	ErrAdminRequired       = apperror.New(http.StatusForbidden, "ADMIN_REQUIRED", "user_id", "must be an admin")
+	ErrAttachmentNotFound  = apperror.NotFound("ATTACHMENT_NOT_FOUND", "attachment_id")
+)
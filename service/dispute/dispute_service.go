@@ -0,0 +1,17 @@
+package dispute
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type DisputeService interface {
+	OpenDispute(ctx context.Context, request web.DisputeCreateRequest) (response web.DisputeResponse, err error)
+	GetDisputeByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.DisputeResponse, err error)
+	GetDisputeById(ctx context.Context, request web.DisputeRequest) (response web.DisputeResponse, err error)
+	AddDisputeEvidence(ctx context.Context, request web.DisputeEvidenceRequest) (response web.DisputeResponse, err error)
+	GetAllDispute(ctx context.Context, request web.DisputeListRequest) (response []web.DisputeResponse, err error)
+	ReviewDispute(ctx context.Context, request web.DisputeRequest) (response web.DisputeResponse, err error)
+	ResolveDispute(ctx context.Context, request web.DisputeResolveRequest) (response web.DisputeResponse, err error)
+}
@@ -0,0 +1,378 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/infrastructure/event"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/attachment"
+	"github.com/vnnyx/golang-dot-api/repository/dispute"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+// statusEvents maps every status a dispute can move to onto the event
+// published when it does.
+var statusEvents = map[string]string{
+	entity.DisputeStatusOpen:        model.EventDisputeOpened,
+	entity.DisputeStatusUnderReview: model.EventDisputeUnderReview,
+	entity.DisputeStatusWon:         model.EventDisputeWon,
+	entity.DisputeStatusLost:        model.EventDisputeLost,
+}
+
+type DisputeServiceImpl struct {
+	dispute.DisputeRepository
+	transaction.TransactionRepository
+	attachment.AttachmentRepository
+	user.UserRepository
+	event.Publisher
+}
+
+func NewDisputeService(disputeRepository dispute.DisputeRepository, transactionRepository transaction.TransactionRepository, attachmentRepository attachment.AttachmentRepository, userRepository user.UserRepository, publisher event.Publisher) DisputeService {
+	return &DisputeServiceImpl{
+		DisputeRepository:     disputeRepository,
+		TransactionRepository: transactionRepository,
+		AttachmentRepository:  attachmentRepository,
+		UserRepository:        userRepository,
+		Publisher:             publisher,
+	}
+}
+
+// OpenDispute disputes a transaction of the user and puts it on hold until
+// an admin resolves the dispute. Evidence must be attachments of the same
+// transaction.
+func (service *DisputeServiceImpl) OpenDispute(ctx context.Context, request web.DisputeCreateRequest) (response web.DisputeResponse, err error) {
+	request.Description = strings.TrimSpace(request.Description)
+	err = validation.DisputeCreateValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, transaction, err := service.findReadableTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return response, err
+	}
+	if transaction.UserID != user.UserID {
+		return response, ErrTransactionNotFound
+	}
+	if transaction.OnHold {
+		return response, ErrDisputeAlreadyOpen
+	}
+
+	disputeId := uuid.NewString()
+	evidence, err := service.newEvidence(ctx, disputeId, transaction.TransactionID, nil, request.EvidenceIDs)
+	if err != nil {
+		return response, err
+	}
+
+	opened, err := service.DisputeRepository.InsertDispute(ctx, entity.Dispute{
+		DisputeID:     disputeId,
+		TransactionID: transaction.TransactionID,
+		UserID:        user.UserID,
+		ReasonCode:    request.ReasonCode,
+		Description:   request.Description,
+		Status:        entity.DisputeStatusOpen,
+		Evidence:      evidence,
+	})
+	if errors.Is(err, dispute.ErrTransactionOnHold) {
+		return response, ErrDisputeAlreadyOpen
+	}
+	if err != nil {
+		return response, err
+	}
+	service.publish(ctx, opened, user.UserID)
+
+	return toDisputeResponse(opened), nil
+}
+
+func (service *DisputeServiceImpl) GetDisputeByTransactionId(ctx context.Context, userId string, transactionId string) (response []web.DisputeResponse, err error) {
+	_, transaction, err := service.findReadableTransaction(ctx, userId, transactionId)
+	if err != nil {
+		return response, err
+	}
+
+	disputes, err := service.DisputeRepository.FindDisputeByTransactionId(ctx, transaction.TransactionID)
+	if err != nil {
+		return response, err
+	}
+	return toDisputeResponses(disputes), nil
+}
+
+func (service *DisputeServiceImpl) GetDisputeById(ctx context.Context, request web.DisputeRequest) (response web.DisputeResponse, err error) {
+	_, dispute, err := service.findDispute(ctx, request)
+	if err != nil {
+		return response, err
+	}
+	return toDisputeResponse(dispute), nil
+}
+
+// AddDisputeEvidence attaches more evidence to a dispute that is still
+// active. Attachments that are already evidence are skipped.
+func (service *DisputeServiceImpl) AddDisputeEvidence(ctx context.Context, request web.DisputeEvidenceRequest) (response web.DisputeResponse, err error) {
+	err = validation.DisputeEvidenceValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, dispute, err := service.findDispute(ctx, web.DisputeRequest{
+		TransactionID: request.TransactionID,
+		DisputeID:     request.DisputeID,
+		UserID:        request.UserID,
+	})
+	if err != nil {
+		return response, err
+	}
+	if dispute.UserID != user.UserID {
+		return response, ErrDisputeNotFound
+	}
+	if !dispute.Active() {
+		return response, ErrDisputeClosed
+	}
+
+	evidence, err := service.newEvidence(ctx, dispute.DisputeID, dispute.TransactionID, dispute.Evidence, request.EvidenceIDs)
+	if err != nil {
+		return response, err
+	}
+	err = service.DisputeRepository.InsertDisputeEvidence(ctx, evidence)
+	if err != nil {
+		return response, err
+	}
+	dispute.Evidence = append(dispute.Evidence, evidence...)
+
+	return toDisputeResponse(dispute), nil
+}
+
+// GetAllDispute lists the disputes of every user for admins, optionally
+// only those in the given statuses.
+func (service *DisputeServiceImpl) GetAllDispute(ctx context.Context, request web.DisputeListRequest) (response []web.DisputeResponse, err error) {
+	err = validation.DisputeListValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	_, err = service.findAdmin(ctx, request.UserID)
+	if err != nil {
+		return response, err
+	}
+
+	disputes, err := service.DisputeRepository.FindDisputeByStatus(ctx, request.Status)
+	if err != nil {
+		return response, err
+	}
+	return toDisputeResponses(disputes), nil
+}
+
+// ReviewDispute marks an open dispute as under review. Reviewing a dispute
+// that is already under review changes nothing.
+func (service *DisputeServiceImpl) ReviewDispute(ctx context.Context, request web.DisputeRequest) (response web.DisputeResponse, err error) {
+	admin, dispute, err := service.findDisputeForAdmin(ctx, request.UserID, request.DisputeID)
+	if err != nil {
+		return response, err
+	}
+	if dispute.Status == entity.DisputeStatusUnderReview {
+		return toDisputeResponse(dispute), nil
+	}
+
+	fromStatus := dispute.Status
+	dispute.Status = entity.DisputeStatusUnderReview
+	dispute, err = service.updateDispute(ctx, dispute, fromStatus)
+	if err != nil {
+		return response, err
+	}
+	service.publish(ctx, dispute, admin.UserID)
+
+	return toDisputeResponse(dispute), nil
+}
+
+// ResolveDispute closes an active dispute as won or lost and releases the
+// hold on its transaction.
+func (service *DisputeServiceImpl) ResolveDispute(ctx context.Context, request web.DisputeResolveRequest) (response web.DisputeResponse, err error) {
+	request.ResolutionNote = strings.TrimSpace(request.ResolutionNote)
+	err = validation.DisputeResolveValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	admin, dispute, err := service.findDisputeForAdmin(ctx, request.UserID, request.DisputeID)
+	if err != nil {
+		return response, err
+	}
+
+	resolvedAt := time.Now()
+	fromStatus := dispute.Status
+	dispute.Status = request.Outcome
+	dispute.ResolutionNote = request.ResolutionNote
+	dispute.ResolvedBy = &admin.UserID
+	dispute.ResolvedAt = &resolvedAt
+	dispute, err = service.updateDispute(ctx, dispute, fromStatus)
+	if err != nil {
+		return response, err
+	}
+	service.publish(ctx, dispute, admin.UserID)
+
+	return toDisputeResponse(dispute), nil
+}
+
+// updateDispute saves the new status of changed unless another request
+// changed the dispute since it was read in fromStatus.
+func (service *DisputeServiceImpl) updateDispute(ctx context.Context, changed entity.Dispute, fromStatus string) (entity.Dispute, error) {
+	saved, err := service.DisputeRepository.UpdateDispute(ctx, changed, fromStatus)
+	if errors.Is(err, dispute.ErrDisputeChanged) {
+		return saved, ErrDisputeChanged
+	}
+	return saved, err
+}
+
+// findReadableTransaction returns the transaction when the user owns it or
+// is an admin. Other users get TRANSACTION_NOT_FOUND so they cannot tell
+// whether it exists.
+func (service *DisputeServiceImpl) findReadableTransaction(ctx context.Context, userId string, transactionId string) (entity.User, entity.Transaction, error) {
+	user, err := service.UserRepository.FindUserByID(ctx, userId)
+	if err != nil {
+		return user, entity.Transaction{}, ErrUserNotFound
+	}
+	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, transactionId)
+	if err != nil || transaction.UserID != user.UserID && user.Role != entity.UserRoleAdmin {
+		return user, transaction, ErrTransactionNotFound
+	}
+	return user, transaction, nil
+}
+
+func (service *DisputeServiceImpl) findDispute(ctx context.Context, request web.DisputeRequest) (entity.User, entity.Dispute, error) {
+	user, transaction, err := service.findReadableTransaction(ctx, request.UserID, request.TransactionID)
+	if err != nil {
+		return user, entity.Dispute{}, err
+	}
+
+	dispute, err := service.DisputeRepository.FindDisputeByID(ctx, request.DisputeID)
+	if err != nil || dispute.TransactionID != transaction.TransactionID {
+		return user, dispute, ErrDisputeNotFound
+	}
+	return user, dispute, nil
+}
+
+func (service *DisputeServiceImpl) findAdmin(ctx context.Context, userId string) (entity.User, error) {
+	user, err := service.UserRepository.FindUserByID(ctx, userId)
+	if err != nil {
+		return user, ErrUserNotFound
+	}
+	if user.Role != entity.UserRoleAdmin {
+		return user, ErrAdminRequired
+	}
+	return user, nil
+}
+
+// findDisputeForAdmin returns an active dispute for an admin to act on.
+func (service *DisputeServiceImpl) findDisputeForAdmin(ctx context.Context, userId string, disputeId string) (entity.User, entity.Dispute, error) {
+	admin, err := service.findAdmin(ctx, userId)
+	if err != nil {
+		return admin, entity.Dispute{}, err
+	}
+
+	dispute, err := service.DisputeRepository.FindDisputeByID(ctx, disputeId)
+	if err != nil {
+		return admin, dispute, ErrDisputeNotFound
+	}
+	if !dispute.Active() {
+		return admin, dispute, ErrDisputeClosed
+	}
+	return admin, dispute, nil
+}
+
+// newEvidence links the attachments with attachmentIds to the dispute,
+// skipping repeated ids and attachments already in existing.
+func (service *DisputeServiceImpl) newEvidence(ctx context.Context, disputeId string, transactionId string, existing []entity.DisputeEvidence, attachmentIds []string) (evidence []entity.DisputeEvidence, err error) {
+	seen := make(map[string]bool)
+	for _, item := range existing {
+		seen[item.AttachmentID] = true
+	}
+	for _, attachmentId := range attachmentIds {
+		if seen[attachmentId] {
+			continue
+		}
+		seen[attachmentId] = true
+
+		attachment, err := service.AttachmentRepository.FindAttachmentByID(ctx, attachmentId)
+		if err != nil || attachment.TransactionID != transactionId {
+			return nil, ErrAttachmentNotFound
+		}
+		evidence = append(evidence, entity.DisputeEvidence{
+			DisputeID:    disputeId,
+			AttachmentID: attachment.AttachmentID,
+			Attachment:   &attachment,
+		})
+	}
+	return evidence, nil
+}
+
+// publish emits the event for the dispute's current status. The change is
+// already saved, so a failure is logged rather than returned.
+func (service *DisputeServiceImpl) publish(ctx context.Context, dispute entity.Dispute, actorId string) {
+	data := map[string]interface{}{
+		"transaction_id": dispute.TransactionID,
+		"reason_code":    dispute.ReasonCode,
+		"status":         dispute.Status,
+		"opened_by":      dispute.UserID,
+	}
+	if dispute.ResolutionNote != "" {
+		data["resolution_note"] = dispute.ResolutionNote
+	}
+	err := service.Publisher.Publish(ctx, model.Event{
+		EventID:     uuid.NewString(),
+		Type:        statusEvents[dispute.Status],
+		AggregateID: dispute.DisputeID,
+		UserID:      actorId,
+		OccurredAt:  time.Now(),
+		Data:        data,
+	})
+	if err != nil {
+		log.Printf("publish %s for dispute %s: %v", statusEvents[dispute.Status], dispute.DisputeID, err)
+	}
+}
+
+func toDisputeResponses(disputes []entity.Dispute) []web.DisputeResponse {
+	response := []web.DisputeResponse{}
+	for _, dispute := range disputes {
+		response = append(response, toDisputeResponse(dispute))
+	}
+	return response
+}
+
+func toDisputeResponse(dispute entity.Dispute) web.DisputeResponse {
+	response := web.DisputeResponse{
+		DisputeID:      dispute.DisputeID,
+		TransactionID:  dispute.TransactionID,
+		UserID:         dispute.UserID,
+		ReasonCode:     dispute.ReasonCode,
+		Description:    dispute.Description,
+		Status:         dispute.Status,
+		Evidence:       []web.AttachmentResponse{},
+		ResolutionNote: dispute.ResolutionNote,
+		ResolvedBy:     dispute.ResolvedBy,
+		ResolvedAt:     dispute.ResolvedAt,
+		CreatedAt:      dispute.CreatedAt,
+		UpdatedAt:      dispute.UpdatedAt,
+	}
+	for _, evidence := range dispute.Evidence {
+		if evidence.Attachment == nil {
+			continue
+		}
+		response.Evidence = append(response.Evidence, web.AttachmentResponse{
+			AttachmentID:  evidence.Attachment.AttachmentID,
+			TransactionID: evidence.Attachment.TransactionID,
+			FileName:      evidence.Attachment.FileName,
+			ContentType:   evidence.Attachment.ContentType,
+			Size:          evidence.Attachment.Size,
+			CreatedAt:     evidence.Attachment.CreatedAt,
+		})
+	}
+	return response
+}
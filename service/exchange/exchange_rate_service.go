@@ -0,0 +1,10 @@
+package exchange
+
+import (
+	"context"
+	"io"
+)
+
+type ExchangeRateService interface {
+	ImportExchangeRates(ctx context.Context, format string, reader io.Reader) (imported int, err error)
+}
@@ -0,0 +1,44 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/repository/exchange"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+type ExchangeRateServiceImpl struct {
+	exchange.ExchangeRateRepository
+}
+
+func NewExchangeRateService(exchangeRateRepository exchange.ExchangeRateRepository) ExchangeRateService {
+	return &ExchangeRateServiceImpl{ExchangeRateRepository: exchangeRateRepository}
+}
+
+// ImportExchangeRates parses a rate file in format and stores its rates,
+// replacing those already loaded for the same day and currency pair. Nothing
+// is stored when any rate in the file is invalid.
+func (service *ExchangeRateServiceImpl) ImportExchangeRates(ctx context.Context, format string, reader io.Reader) (imported int, err error) {
+	var rates []entity.ExchangeRate
+	switch format {
+	case model.RateFormatCSV:
+		rates, err = util.ParseCSVExchangeRates(reader)
+	case model.RateFormatECB:
+		rates, err = util.ParseECBExchangeRates(reader)
+	default:
+		return 0, errors.New("format must be csv or ecb")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = service.ExchangeRateRepository.UpsertExchangeRates(ctx, rates)
+	if err != nil {
+		return 0, err
+	}
+	return len(rates), nil
+}
@@ -0,0 +1,8 @@
+package export
+
+import "github.com/vnnyx/golang-dot-api/exception/apperror"
+
+var (
+	ErrUserNotFound     = apperror.NotFound("USER_NOT_FOUND", "user_id")
+	ErrCategoryNotFound = apperror.NotFound("CATEGORY_NOT_FOUND", "category_id")
+)
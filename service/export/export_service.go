@@ -0,0 +1,12 @@
+package export
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type ExportService interface {
+	ExportTransaction(ctx context.Context, request web.TransactionExportRequest) (response web.ExportFile, err error)
+	ExportUser(ctx context.Context, request web.UserExportRequest) (response web.ExportFile, err error)
+}
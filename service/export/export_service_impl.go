@@ -0,0 +1,188 @@
+package export
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+var transactionColumns = []string{"transaction_id", "name", "amount", "currency", "user_id", "category_id", "split_method", "tags", "created_at"}
+
+var userColumns = []string{"user_id", "username", "email", "handphone"}
+
+var contentTypes = map[string]string{
+	util.FormatCSV:  "text/csv",
+	util.FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type ExportServiceImpl struct {
+	transaction.TransactionRepository
+	user.UserRepository
+	category.CategoryRepository
+}
+
+func NewExportService(transactionRepository transaction.TransactionRepository, userRepository user.UserRepository, categoryRepository category.CategoryRepository) ExportService {
+	return &ExportServiceImpl{
+		TransactionRepository: transactionRepository,
+		UserRepository:        userRepository,
+		CategoryRepository:    categoryRepository,
+	}
+}
+
+// ExportTransaction exports every transaction, or with UserID set the same
+// transactions GetTransactionByUserId lists. Filters are checked before the
+// file is returned so errors can still be reported as JSON.
+func (service *ExportServiceImpl) ExportTransaction(ctx context.Context, request web.TransactionExportRequest) (response web.ExportFile, err error) {
+	err = validation.ExportValidation(request.Format, request.Columns, transactionColumns)
+	if err != nil {
+		return response, err
+	}
+
+	var filter model.TransactionFilter
+	if request.UserID != "" {
+		filter, err = service.transactionFilter(ctx, request.TransactionListRequest)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	columns := request.Columns
+	if len(columns) == 0 {
+		columns = transactionColumns
+	}
+
+	return newExportFile("transactions", request.Format, columns, func(writeRow func(values []string) error) error {
+		return service.TransactionRepository.StreamTransaction(ctx, request.UserID, filter, func(row model.TransactionRow) error {
+			values := make([]string, len(columns))
+			for i, column := range columns {
+				values[i] = transactionValue(row, column)
+			}
+			return writeRow(values)
+		})
+	}), nil
+}
+
+func (service *ExportServiceImpl) ExportUser(ctx context.Context, request web.UserExportRequest) (response web.ExportFile, err error) {
+	err = validation.ExportValidation(request.Format, request.Columns, userColumns)
+	if err != nil {
+		return response, err
+	}
+
+	columns := request.Columns
+	if len(columns) == 0 {
+		columns = userColumns
+	}
+
+	return newExportFile("users", request.Format, columns, func(writeRow func(values []string) error) error {
+		return service.UserRepository.StreamUser(ctx, func(user entity.User) error {
+			values := make([]string, len(columns))
+			for i, column := range columns {
+				values[i] = userValue(user, column)
+			}
+			return writeRow(values)
+		})
+	}), nil
+}
+
+// transactionFilter resolves the list filters the same way
+// GetTransactionByUserId does.
+func (service *ExportServiceImpl) transactionFilter(ctx context.Context, request web.TransactionListRequest) (filter model.TransactionFilter, err error) {
+	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
+	if err != nil {
+		return filter, ErrUserNotFound
+	}
+
+	filter.Tag = request.Tag
+	if request.CategoryID == "" {
+		return filter, nil
+	}
+
+	categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, user.UserID)
+	if err != nil {
+		return filter, err
+	}
+	for _, category := range categories {
+		if category.CategoryID == request.CategoryID {
+			filter.CategoryIDs = util.CategoryDescendantIDs(categories, request.CategoryID)
+			return filter, nil
+		}
+	}
+	return filter, ErrCategoryNotFound
+}
+
+// newExportFile wraps stream, which emits the data rows, into a file that
+// starts with a header row of column names.
+func newExportFile(name string, format string, columns []string, stream func(writeRow func(values []string) error) error) web.ExportFile {
+	if format == "" {
+		format = util.FormatCSV
+	}
+	return web.ExportFile{
+		FileName:    name + "-" + time.Now().UTC().Format("20060102") + "." + format,
+		ContentType: contentTypes[format],
+		Write: func(w io.Writer) error {
+			writer, err := util.NewTableWriter(format, w)
+			if err != nil {
+				return err
+			}
+			defer writer.Close()
+
+			err = writer.WriteRow(columns)
+			if err != nil {
+				return err
+			}
+			err = stream(writer.WriteRow)
+			if err != nil {
+				return err
+			}
+			return writer.Flush()
+		},
+	}
+}
+
+func transactionValue(row model.TransactionRow, column string) string {
+	switch column {
+	case "transaction_id":
+		return row.TransactionID
+	case "name":
+		return row.Name
+	case "amount":
+		return strconv.FormatInt(row.Amount, 10)
+	case "currency":
+		return row.Currency
+	case "user_id":
+		return row.UserID
+	case "category_id":
+		return row.CategoryID
+	case "split_method":
+		return row.SplitMethod
+	case "tags":
+		return row.Tags
+	case "created_at":
+		return row.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+func userValue(user entity.User, column string) string {
+	switch column {
+	case "user_id":
+		return user.UserID
+	case "username":
+		return user.Username
+	case "email":
+		return user.Email
+	case "handphone":
+		return user.Handphone
+	}
+	return ""
+}
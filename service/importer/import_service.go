@@ -0,0 +1,11 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type ImportService interface {
+	ImportTransaction(ctx context.Context, request web.ImportRequest) (response web.ImportResponse, err error)
+}
@@ -0,0 +1,253 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const maxImportRows = 1000
+
+// creditRow is the reason a row with a positive amount is not imported.
+const creditRow = "is a credit, only spending can be imported"
+
+type ImportServiceImpl struct {
+	transaction.TransactionRepository
+	category.CategoryRepository
+}
+
+func NewImportService(transactionRepository transaction.TransactionRepository, categoryRepository category.CategoryRepository) ImportService {
+	return &ImportServiceImpl{TransactionRepository: transactionRepository, CategoryRepository: categoryRepository}
+}
+
+// ImportTransaction parses a bank statement and saves its rows as
+// transactions. Rows get deterministic ids so importing the same statement
+// twice reports the second copy as duplicates. Valid rows are saved in one
+// database transaction; if that fails they are retried one by one.
+func (service *ImportServiceImpl) ImportTransaction(ctx context.Context, request web.ImportRequest) (response web.ImportResponse, err error) {
+	err = validation.ImportValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	var entries []model.StatementEntry
+	switch request.Format {
+	case model.StatementCSV:
+		entries, err = util.ParseCSVStatement(request.Content, request.Mapping, request.DateFormat)
+	case model.StatementOFX:
+		entries, err = util.ParseOFXStatement(request.Content)
+	case model.StatementQIF:
+		entries, err = util.ParseQIFStatement(request.Content, request.DateFormat)
+	}
+	if err == nil && len(entries) == 0 {
+		err = validation.ErrImportEmpty
+	}
+	if err == nil && len(entries) > maxImportRows {
+		err = validation.ErrImportTooLarge.SetParams(map[string]interface{}{"max": maxImportRows})
+	}
+	if err != nil {
+		return response, validation.FieldError("file", err)
+	}
+
+	categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, request.UserID)
+	if err != nil {
+		return response, err
+	}
+	owned := make(map[string]bool)
+	for _, category := range categories {
+		owned[category.CategoryID] = true
+	}
+	rules, err := service.CategoryRepository.FindCategoryRuleByUserId(ctx, request.UserID)
+	if err != nil {
+		return response, err
+	}
+
+	response = web.ImportResponse{DryRun: request.DryRun, Total: len(entries)}
+	transactions := make(map[int]entity.Transaction)
+	var ids []string
+	seen := make(map[string]int)
+	for _, entry := range entries {
+		result, transaction := service.toImportRow(request, entry, owned, rules)
+		if result.Status == "" {
+			key := fingerprint(request.UserID, entry, transaction)
+			seen[key]++
+			if entry.ExternalID != "" && seen[key] > 1 {
+				result.Status = web.ImportStatusDuplicate
+			} else {
+				transaction.TransactionID = util.IdempotentID(fmt.Sprintf("%s#%d", key, seen[key]))
+				result.TransactionID = transaction.TransactionID
+				transactions[len(response.Rows)] = transaction
+				ids = append(ids, transaction.TransactionID)
+			}
+		}
+		response.Rows = append(response.Rows, result)
+	}
+
+	existing, err := service.TransactionRepository.FindExistingTransactionIDs(ctx, ids)
+	if err != nil {
+		return response, err
+	}
+	duplicate := make(map[string]bool)
+	for _, id := range existing {
+		duplicate[id] = true
+	}
+
+	var pending []int
+	for i, transaction := range transactions {
+		if duplicate[transaction.TransactionID] {
+			response.Rows[i].Status = web.ImportStatusDuplicate
+			continue
+		}
+		response.Rows[i].Status = web.ImportStatusReady
+		pending = append(pending, i)
+	}
+
+	sort.Ints(pending)
+	if !request.DryRun && len(pending) > 0 {
+		service.insertRows(ctx, &response, transactions, pending)
+	}
+
+	for _, row := range response.Rows {
+		switch row.Status {
+		case web.ImportStatusImported:
+			response.Imported++
+		case web.ImportStatusDuplicate:
+			response.Duplicates++
+		case web.ImportStatusInvalid, web.ImportStatusFailed:
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+// insertRows saves the pending rows in one database transaction and falls
+// back to saving them one at a time so a single bad row does not block the
+// rest.
+func (service *ImportServiceImpl) insertRows(ctx context.Context, response *web.ImportResponse, transactions map[int]entity.Transaction, pending []int) {
+	batch := make([]entity.Transaction, 0, len(pending))
+	for _, i := range pending {
+		batch = append(batch, transactions[i])
+	}
+	err := service.TransactionRepository.InsertTransactionBatch(ctx, batch)
+	if err == nil {
+		for _, i := range pending {
+			response.Rows[i].Status = web.ImportStatusImported
+		}
+		metrics.TransactionsCreated.Add(float64(len(pending)))
+		return
+	}
+	log.Printf("import batch of %d rows failed, retrying row by row: %v", len(batch), err)
+
+	for _, i := range pending {
+		_, err := service.TransactionRepository.InsertTransaction(ctx, transactions[i])
+		if err != nil {
+			response.Rows[i].Status = web.ImportStatusFailed
+			response.Rows[i].Errors = map[string]interface{}{"row": "could not be saved"}
+			continue
+		}
+		response.Rows[i].Status = web.ImportStatusImported
+		metrics.TransactionsCreated.Inc()
+	}
+}
+
+// toImportRow converts a statement entry into a transaction. The returned
+// result has an empty status when the row is valid.
+func (service *ImportServiceImpl) toImportRow(request web.ImportRequest, entry model.StatementEntry, owned map[string]bool, rules []entity.CategoryRule) (result web.ImportRowResult, transaction entity.Transaction) {
+	result = web.ImportRowResult{Row: entry.Row, Name: entry.Name, CategoryID: entry.CategoryID}
+	if !entry.Date.IsZero() {
+		date := entry.Date
+		result.Date = &date
+	}
+	if entry.Error != "" {
+		result.Status = web.ImportStatusInvalid
+		result.Errors = map[string]interface{}{"row": entry.Error}
+		return result, transaction
+	}
+
+	currency := entry.Currency
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+	result.Currency = currency
+
+	// Statements show spending as negative amounts; transactions store the
+	// amount spent. Credits such as refunds and deposits are not spending, so
+	// they are reported instead of imported.
+	amount, err := util.ParseMinorUnits(entry.Amount, currency)
+	if err != nil {
+		result.Status = web.ImportStatusInvalid
+		result.Errors = map[string]interface{}{"amount": err.Error()}
+		return result, transaction
+	}
+	if amount > 0 {
+		result.Amount = amount
+		result.Status = web.ImportStatusInvalid
+		result.Errors = map[string]interface{}{"amount": creditRow}
+		return result, transaction
+	}
+	amount = -amount
+	result.Amount = amount
+
+	errs := validation.TransactionRowErrors(web.TransactionCreateRequest{
+		Name:       entry.Name,
+		Amount:     amount,
+		Currency:   currency,
+		CategoryID: entry.CategoryID,
+		UserID:     request.UserID,
+	})
+	if errs == nil && entry.CategoryID != "" && !owned[entry.CategoryID] {
+		errs = map[string]interface{}{"category_id": "NOT_FOUND"}
+	}
+	if errs != nil {
+		result.Status = web.ImportStatusInvalid
+		result.Errors = errs
+		return result, transaction
+	}
+
+	transaction = entity.Transaction{
+		Name:      truncate(entry.Name, 50),
+		Amount:    amount,
+		Currency:  currency,
+		UserID:    request.UserID,
+		CreatedAt: entry.Date,
+	}
+	if entry.CategoryID != "" {
+		categoryId := entry.CategoryID
+		transaction.CategoryID = &categoryId
+	} else if rule, ok := util.MatchCategoryRule(rules, entry.Name); ok {
+		categoryId := rule.CategoryID
+		transaction.CategoryID = &categoryId
+	}
+	if transaction.CategoryID != nil {
+		result.CategoryID = *transaction.CategoryID
+	}
+	return result, transaction
+}
+
+// fingerprint identifies a statement row across imports: by the bank's own
+// id when the statement has one, otherwise by its date, amount and name.
+func fingerprint(userId string, entry model.StatementEntry, transaction entity.Transaction) string {
+	if entry.ExternalID != "" {
+		return "import:" + userId + ":id:" + entry.ExternalID
+	}
+	return fmt.Sprintf("import:%s:%s:%d:%s:%s", userId, transaction.CreatedAt.Format("2006-01-02"), transaction.Amount, transaction.Currency, strings.ToLower(transaction.Name))
+}
+
+func truncate(value string, length int) string {
+	runes := []rune(value)
+	if len(runes) > length {
+		return string(runes[:length])
+	}
+	return value
+}
@@ -0,0 +1,8 @@
+package recurring
+
+import "github.com/vnnyx/golang-dot-api/exception/apperror"
+
+var (
+	ErrCategoryNotFound  = apperror.NotFound("CATEGORY_NOT_FOUND", "category_id")
+	ErrRecurringNotFound = apperror.NotFound("RECURRING_NOT_FOUND", "recurring_id")
+)
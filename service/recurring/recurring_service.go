@@ -0,0 +1,16 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type RecurringService interface {
+	CreateRecurring(ctx context.Context, request web.RecurringCreateRequest) (response web.RecurringResponse, err error)
+	GetRecurringById(ctx context.Context, userId string, recurringId string) (response web.RecurringResponse, err error)
+	GetRecurringByUserId(ctx context.Context, userId string) (response []web.RecurringResponse, err error)
+	RemoveRecurring(ctx context.Context, userId string, recurringId string) error
+	RunDueRecurring(ctx context.Context, now time.Time) (created int, err error)
+}
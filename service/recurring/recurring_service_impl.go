@@ -0,0 +1,249 @@
+package recurring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/recurring"
+	"github.com/vnnyx/golang-dot-api/service/transaction"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const (
+	// dueBatchSize bounds how many schedules a single run picks up.
+	dueBatchSize = 100
+	// maxCatchUp bounds how many missed occurrences of one schedule are
+	// materialized per run, so a long outage cannot stall the scheduler.
+	maxCatchUp = 10
+	// maxFailures is how many times in a row an occurrence may fail before
+	// its schedule is disabled. The wait between attempts starts at
+	// retryBackoff and doubles after every failure.
+	maxFailures  = 5
+	retryBackoff = 5 * time.Minute
+	// lastErrorLength is the size of the last_error column.
+	lastErrorLength = 255
+)
+
+type RecurringServiceImpl struct {
+	recurring.RecurringRepository
+	category.CategoryRepository
+	transaction.TransactionService
+}
+
+func NewRecurringService(recurringRepository recurring.RecurringRepository, categoryRepository category.CategoryRepository, transactionService transaction.TransactionService) RecurringService {
+	return &RecurringServiceImpl{RecurringRepository: recurringRepository, CategoryRepository: categoryRepository, TransactionService: transactionService}
+}
+
+func (service *RecurringServiceImpl) CreateRecurring(ctx context.Context, request web.RecurringCreateRequest) (response web.RecurringResponse, err error) {
+	err = validation.CreateRecurringValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	var categoryId *string
+	if request.CategoryID != "" {
+		category, err := service.CategoryRepository.FindCategoryByID(ctx, request.CategoryID)
+		if err != nil || category.UserID != request.UserID {
+			return response, ErrCategoryNotFound
+		}
+		categoryId = &category.CategoryID
+	}
+
+	rule, err := util.ParseRecurrence(request.RRule, request.Timezone, request.StartAt)
+	if err != nil {
+		return response, err
+	}
+
+	currency := request.Currency
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+
+	recurring, err := service.RecurringRepository.InsertRecurring(ctx, entity.RecurringTransaction{
+		RecurringID: uuid.NewString(),
+		UserID:      request.UserID,
+		Name:        request.Name,
+		Amount:      request.Amount,
+		Currency:    currency,
+		CategoryID:  categoryId,
+		Tags:        request.Tags,
+		RRule:       request.RRule,
+		Timezone:    request.Timezone,
+		StartAt:     request.StartAt.UTC(),
+		NextRunAt:   util.NextOccurrence(rule, request.StartAt, true),
+	})
+	if err != nil {
+		return response, err
+	}
+
+	return toRecurringResponse(recurring), nil
+}
+
+func (service *RecurringServiceImpl) GetRecurringById(ctx context.Context, userId string, recurringId string) (response web.RecurringResponse, err error) {
+	recurring, err := service.RecurringRepository.FindRecurringByID(ctx, recurringId)
+	if err != nil || recurring.UserID != userId {
+		return response, ErrRecurringNotFound
+	}
+
+	return toRecurringResponse(recurring), nil
+}
+
+func (service *RecurringServiceImpl) GetRecurringByUserId(ctx context.Context, userId string) (response []web.RecurringResponse, err error) {
+	recurrings, err := service.RecurringRepository.FindRecurringByUserId(ctx, userId)
+	if err != nil {
+		return response, err
+	}
+
+	for _, recurring := range recurrings {
+		response = append(response, toRecurringResponse(recurring))
+	}
+
+	return response, nil
+}
+
+func (service *RecurringServiceImpl) RemoveRecurring(ctx context.Context, userId string, recurringId string) error {
+	recurring, err := service.RecurringRepository.FindRecurringByID(ctx, recurringId)
+	if err != nil || recurring.UserID != userId {
+		return ErrRecurringNotFound
+	}
+	return service.RecurringRepository.DeleteRecurring(ctx, recurring.RecurringID)
+}
+
+// RunDueRecurring materializes every occurrence that is due at now. The next
+// run time only advances after the occurrence is stored, so a crash between
+// the two steps replays the occurrence on the next run; the idempotency key
+// derived from the schedule and occurrence time turns that replay into a no-op.
+// A schedule whose occurrence fails is retried later, so it does not hold back
+// the schedules due after it.
+func (service *RecurringServiceImpl) RunDueRecurring(ctx context.Context, now time.Time) (created int, err error) {
+	recurrings, err := service.RecurringRepository.FindDueRecurring(ctx, now, dueBatchSize)
+	if err != nil {
+		return created, err
+	}
+
+	var firstErr error
+	for _, recurring := range recurrings {
+		count, err := service.materializeRecurring(ctx, recurring, now)
+		created += count
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return created, firstErr
+}
+
+func (service *RecurringServiceImpl) materializeRecurring(ctx context.Context, recurring entity.RecurringTransaction, now time.Time) (created int, err error) {
+	rule, err := util.ParseRecurrence(recurring.RRule, recurring.Timezone, recurring.StartAt)
+	if err != nil {
+		return created, service.recordFailure(ctx, recurring, now, err)
+	}
+
+	next := recurring.NextRunAt
+	for i := 0; next != nil && !next.After(now) && i < maxCatchUp; i++ {
+		inserted, err := service.createOccurrence(ctx, recurring, *next)
+		if err != nil {
+			recurring.NextRunAt = next
+			return created, service.recordFailure(ctx, recurring, now, err)
+		}
+		if inserted {
+			created++
+		}
+
+		next = util.NextOccurrence(rule, *next, false)
+		err = service.RecurringRepository.UpdateRecurringNextRun(ctx, recurring.RecurringID, next)
+		if err != nil {
+			return created, err
+		}
+		recurring.Failures = 0
+	}
+
+	return created, nil
+}
+
+// recordFailure backs the schedule off before it retries the occurrence at
+// NextRunAt, or disables it after maxFailures attempts, and returns err.
+func (service *RecurringServiceImpl) recordFailure(ctx context.Context, recurring entity.RecurringTransaction, now time.Time, err error) error {
+	recurring.Failures++
+	recurring.LastError = err.Error()
+	if len(recurring.LastError) > lastErrorLength {
+		recurring.LastError = recurring.LastError[:lastErrorLength]
+	}
+	if recurring.Failures >= maxFailures {
+		recurring.NextRunAt = nil
+		recurring.RetryAt = nil
+	} else {
+		retryAt := now.Add(retryBackoff << (recurring.Failures - 1))
+		recurring.RetryAt = &retryAt
+	}
+
+	updateErr := service.RecurringRepository.UpdateRecurringFailure(ctx, recurring)
+	if updateErr != nil {
+		return fmt.Errorf("%w (recording the failure: %v)", err, updateErr)
+	}
+	return err
+}
+
+// createOccurrence reports whether a new transaction was inserted. An
+// occurrence that already exists is treated as done.
+func (service *RecurringServiceImpl) createOccurrence(ctx context.Context, recurring entity.RecurringTransaction, occurrence time.Time) (bool, error) {
+	key := fmt.Sprintf("recurring:%s:%d", recurring.RecurringID, occurrence.Unix())
+	transactionId := util.IdempotentID(key)
+
+	_, err := service.TransactionService.GetTransactionById(ctx, transactionId)
+	if err == nil {
+		return false, nil
+	}
+
+	request := web.TransactionCreateRequest{
+		Name:           recurring.Name,
+		Amount:         recurring.Amount,
+		Currency:       recurring.Currency,
+		Tags:           recurring.Tags,
+		UserID:         recurring.UserID,
+		IdempotencyKey: key,
+		CreatedAt:      occurrence,
+	}
+	if recurring.CategoryID != nil {
+		request.CategoryID = *recurring.CategoryID
+	}
+
+	_, err = service.TransactionService.CreateTransaction(ctx, request)
+	if err != nil {
+		// Another replica may have inserted the same occurrence in between.
+		if _, findErr := service.TransactionService.GetTransactionById(ctx, transactionId); findErr == nil {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func toRecurringResponse(recurring entity.RecurringTransaction) web.RecurringResponse {
+	response := web.RecurringResponse{
+		RecurringID: recurring.RecurringID,
+		UserID:      recurring.UserID,
+		Name:        recurring.Name,
+		Amount:      recurring.Amount,
+		Currency:    recurring.Currency,
+		Tags:        recurring.Tags,
+		RRule:       recurring.RRule,
+		Timezone:    recurring.Timezone,
+		StartAt:     recurring.StartAt,
+		NextRunAt:   recurring.NextRunAt,
+		Failures:    recurring.Failures,
+		LastError:   recurring.LastError,
+		RetryAt:     recurring.RetryAt,
+	}
+	if recurring.CategoryID != nil {
+		response.CategoryID = *recurring.CategoryID
+	}
+	return response
+}
@@ -0,0 +1,11 @@
+package report
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type ReportService interface {
+	GetReport(ctx context.Context, request web.ReportRequest) (response web.ReportResponse, err error)
+}
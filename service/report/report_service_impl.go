@@ -0,0 +1,281 @@
+package report
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/exchange"
+	"github.com/vnnyx/golang-dot-api/repository/report"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const (
+	defaultCacheTTLSecond = 300
+	reportDateLayout      = "2006-01-02"
+)
+
+type ReportServiceImpl struct {
+	transaction.TransactionRepository
+	report.ReportRepository
+	exchange.ExchangeRateRepository
+	CacheTTL time.Duration
+}
+
+func NewReportService(transactionRepository transaction.TransactionRepository, reportRepository report.ReportRepository, exchangeRateRepository exchange.ExchangeRateRepository, config *infrastructure.Config) ReportService {
+	cacheTTLSecond := config.ReportCacheTTLSecond
+	if cacheTTLSecond <= 0 {
+		cacheTTLSecond = defaultCacheTTLSecond
+	}
+	return &ReportServiceImpl{
+		TransactionRepository:  transactionRepository,
+		ReportRepository:       reportRepository,
+		ExchangeRateRepository: exchangeRateRepository,
+		CacheTTL:               time.Duration(cacheTTLSecond) * time.Second,
+	}
+}
+
+// GetReport aggregates the transactions the user owns or shares between the
+// From and To dates in the requested timezone. Reports are cached for
+// CacheTTL, so recent changes may take that long to show up. With a
+// Currency, amounts are converted at the exchange rate of the local day they
+// were spent on.
+func (service *ReportServiceImpl) GetReport(ctx context.Context, request web.ReportRequest) (response web.ReportResponse, err error) {
+	err = validation.ReportValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	if request.Timezone == "" {
+		request.Timezone = "UTC"
+	}
+	if request.GroupBy == nil {
+		request.GroupBy = []string{}
+	}
+
+	key := reportCacheKey(request)
+	cached, err := service.ReportRepository.GetReport(ctx, key)
+	if err == nil && json.Unmarshal([]byte(cached), &response) == nil {
+		return response, nil
+	}
+	if err != nil && err != redis.Nil {
+		log.Printf("report cache get %s: %v", key, err)
+	}
+
+	location, err := time.LoadLocation(request.Timezone)
+	if err != nil {
+		return response, err
+	}
+	from, err := time.ParseInLocation(reportDateLayout, request.From, location)
+	if err != nil {
+		return response, err
+	}
+	to, err := time.ParseInLocation(reportDateLayout, request.To, location)
+	if err != nil {
+		return response, err
+	}
+
+	// Converted reports are aggregated per day and currency, so each part
+	// can be converted at its own rate before it is merged into the
+	// requested groups.
+	groupBy := request.GroupBy
+	if request.Currency != "" {
+		groupBy = convertedGroupBy(request.GroupBy)
+	}
+
+	// Each segment has a fixed UTC offset so the database can bucket
+	// created_at into local days across daylight saving changes.
+	var rows []model.ReportRow
+	for _, segment := range util.OffsetSegments(from, to.AddDate(0, 0, 1), location) {
+		segmentRows, err := service.TransactionRepository.AggregateTransaction(ctx, request.UserID, model.ReportFilter{
+			From:    segment.From,
+			To:      segment.To,
+			Offset:  segment.Offset,
+			GroupBy: groupBy,
+		})
+		if err != nil {
+			return response, err
+		}
+		rows = append(rows, segmentRows...)
+	}
+	if request.Currency != "" {
+		rows, err = service.convertReportRows(ctx, rows, request, from, to)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	response = web.ReportResponse{
+		From:     request.From,
+		To:       request.To,
+		Timezone: request.Timezone,
+		GroupBy:  request.GroupBy,
+		Currency: request.Currency,
+		Rows:     []web.ReportRowResponse{},
+	}
+	for _, row := range mergeReportRows(rows) {
+		response.Rows = append(response.Rows, web.ReportRowResponse{
+			Period:     row.Period,
+			CategoryID: row.CategoryID,
+			Currency:   row.Currency,
+			UserID:     row.UserID,
+			Sum:        row.Sum,
+			Count:      row.Count,
+			Average:    math.Round(float64(row.Sum)/float64(row.Count)*100) / 100,
+			Min:        row.Min,
+			Max:        row.Max,
+		})
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return response, err
+	}
+	err = service.ReportRepository.StoreReport(ctx, key, string(b), service.CacheTTL)
+	if err != nil {
+		log.Printf("report cache store %s: %v", key, err)
+	}
+
+	return response, nil
+}
+
+// convertedGroupBy replaces the period in groupBy with days and adds the
+// currency, which is what converting a report needs to know.
+func convertedGroupBy(groupBy []string) []string {
+	converted := []string{model.ReportGroupDay, model.ReportGroupCurrency}
+	for _, group := range groupBy {
+		switch group {
+		case model.ReportGroupDay, model.ReportGroupWeek, model.ReportGroupMonth, model.ReportGroupCurrency:
+		default:
+			converted = append(converted, group)
+		}
+	}
+	return converted
+}
+
+// convertReportRows converts rows grouped by convertedGroupBy into the
+// request's currency and puts them back into the requested groups. Minimum
+// and maximum survive the conversion because a positive rate keeps their
+// order within a day and currency.
+func (service *ReportServiceImpl) convertReportRows(ctx context.Context, rows []model.ReportRow, request web.ReportRequest, from time.Time, to time.Time) ([]model.ReportRow, error) {
+	currencies := []string{request.Currency}
+	seen := map[string]bool{request.Currency: true}
+	for _, row := range rows {
+		if !seen[row.Currency] {
+			seen[row.Currency] = true
+			currencies = append(currencies, row.Currency)
+		}
+	}
+	rates, err := service.ExchangeRateRepository.FindExchangeRates(ctx, currencies, from.AddDate(0, 0, -util.MaxRateAgeDays), to)
+	if err != nil {
+		return nil, err
+	}
+	table, err := util.NewRateTable(rates)
+	if err != nil {
+		return nil, err
+	}
+
+	period, groupedByCurrency := "", false
+	for _, group := range request.GroupBy {
+		switch group {
+		case model.ReportGroupDay, model.ReportGroupWeek, model.ReportGroupMonth:
+			period = group
+		case model.ReportGroupCurrency:
+			groupedByCurrency = true
+		}
+	}
+
+	converted := make([]model.ReportRow, 0, len(rows))
+	for _, row := range rows {
+		if row.Count == 0 {
+			continue
+		}
+		day, err := time.Parse(reportDateLayout, row.Period)
+		if err != nil {
+			return nil, err
+		}
+		for _, amount := range []*int64{&row.Sum, &row.Min, &row.Max} {
+			*amount, err = table.Convert(*amount, row.Currency, request.Currency, day)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch period {
+		case model.ReportGroupDay:
+		case model.ReportGroupWeek:
+			row.Period = day.AddDate(0, 0, -(int(day.Weekday())+6)%7).Format(reportDateLayout)
+		case model.ReportGroupMonth:
+			row.Period = day.Format(util.PeriodLayout)
+		default:
+			row.Period = ""
+		}
+		if !groupedByCurrency {
+			row.Currency = ""
+		}
+		converted = append(converted, row)
+	}
+	return converted, nil
+}
+
+// mergeReportRows combines rows of the same group coming from different
+// offset segments and orders the result by its group keys.
+func mergeReportRows(rows []model.ReportRow) []model.ReportRow {
+	type groupKey struct {
+		Period, CategoryID, Currency, UserID string
+	}
+	index := make(map[groupKey]int)
+	var merged []model.ReportRow
+	for _, row := range rows {
+		if row.Count == 0 {
+			continue
+		}
+		key := groupKey{row.Period, row.CategoryID, row.Currency, row.UserID}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, row)
+			continue
+		}
+		merged[i].Sum += row.Sum
+		merged[i].Count += row.Count
+		if row.Min < merged[i].Min {
+			merged[i].Min = row.Min
+		}
+		if row.Max > merged[i].Max {
+			merged[i].Max = row.Max
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := merged[i], merged[j]
+		if a.Period != b.Period {
+			return a.Period < b.Period
+		}
+		if a.CategoryID != b.CategoryID {
+			return a.CategoryID < b.CategoryID
+		}
+		if a.Currency != b.Currency {
+			return a.Currency < b.Currency
+		}
+		return a.UserID < b.UserID
+	})
+	return merged
+}
+
+func reportCacheKey(request web.ReportRequest) string {
+	hash := sha1.Sum([]byte(strings.Join([]string{request.From, request.To, request.Timezone, strings.Join(request.GroupBy, ","), request.Currency}, "|")))
+	return "report:" + request.UserID + ":" + hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,11 @@
+package search
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, request web.SearchRequest) (response web.SearchResponse, err error)
+}
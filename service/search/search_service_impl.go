@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/infrastructure/search"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+const defaultSearchLimit = 20
+
+type SearchServiceImpl struct {
+	search.Index
+}
+
+func NewSearchService(index search.Index) SearchService {
+	return &SearchServiceImpl{Index: index}
+}
+
+func (service *SearchServiceImpl) Search(ctx context.Context, request web.SearchRequest) (response web.SearchResponse, err error) {
+	err = validation.SearchValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	query := model.SearchQuery{Terms: util.SearchTerms(request.Query), Limit: request.Limit}
+	if query.Limit == 0 {
+		query.Limit = defaultSearchLimit
+	}
+
+	response = web.SearchResponse{
+		Query:        request.Query,
+		Transactions: []web.SearchHitResponse{},
+		Users:        []web.SearchHitResponse{},
+	}
+	if searchesType(request.Types, model.SearchTypeTransaction) {
+		hits, err := service.Index.SearchTransactions(ctx, request.UserID, query)
+		if err != nil {
+			return response, err
+		}
+		response.Transactions = toSearchHitResponses(hits, query.Terms)
+	}
+	if searchesType(request.Types, model.SearchTypeUser) {
+		hits, err := service.Index.SearchUsers(ctx, query)
+		if err != nil {
+			return response, err
+		}
+		response.Users = toSearchHitResponses(hits, query.Terms)
+	}
+
+	return response, nil
+}
+
+func searchesType(types []string, searchType string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == searchType {
+			return true
+		}
+	}
+	return false
+}
+
+func toSearchHitResponses(hits []model.SearchHit, terms []string) []web.SearchHitResponse {
+	responses := make([]web.SearchHitResponse, 0, len(hits))
+	for _, hit := range hits {
+		response := web.SearchHitResponse{ID: hit.ID, Score: hit.Score, Highlights: make(map[string]string)}
+		for name, value := range hit.Fields {
+			if highlighted, ok := util.Highlight(value, terms); ok {
+				response.Highlights[name] = highlighted
+			}
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
@@ -0,0 +1,5 @@
+package statement
+
+import "github.com/vnnyx/golang-dot-api/exception/apperror"
+
+var ErrUserNotFound = apperror.NotFound("USER_NOT_FOUND", "user_id")
@@ -0,0 +1,11 @@
+package statement
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type StatementService interface {
+	GetMonthlyStatement(ctx context.Context, request web.StatementRequest) (response web.ExportFile, err error)
+}
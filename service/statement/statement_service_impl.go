@@ -0,0 +1,159 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/exchange"
+	"github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type StatementServiceImpl struct {
+	user.UserRepository
+	transaction.TransactionRepository
+	exchange.ExchangeRateRepository
+}
+
+func NewStatementService(userRepository user.UserRepository, transactionRepository transaction.TransactionRepository, exchangeRateRepository exchange.ExchangeRateRepository) StatementService {
+	return &StatementServiceImpl{UserRepository: userRepository, TransactionRepository: transactionRepository, ExchangeRateRepository: exchangeRateRepository}
+}
+
+func (service *StatementServiceImpl) GetMonthlyStatement(ctx context.Context, request web.StatementRequest) (response web.ExportFile, err error) {
+	err = validation.StatementValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
+	if err != nil {
+		return response, ErrUserNotFound
+	}
+
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	location, _ := time.LoadLocation(timezone)
+	month, _ := time.ParseInLocation(util.PeriodLayout, request.Period, location)
+	from, to := util.MonthRange(month, location)
+
+	opening, err := service.TransactionRepository.SumTransactionAmountByCurrency(ctx, user.UserID, from)
+	if err != nil {
+		return response, err
+	}
+	transactions, err := service.TransactionRepository.FindTransactionBetween(ctx, user.UserID, from, to)
+	if err != nil {
+		return response, err
+	}
+
+	statement := model.MonthlyStatement{
+		UserID:      user.UserID,
+		Username:    user.Username,
+		Email:       user.Email,
+		Handphone:   user.Handphone,
+		Period:      from,
+		Timezone:    timezone,
+		GeneratedAt: time.Now(),
+	}
+	balances := make(map[string]*model.StatementBalance)
+	balanceOf := func(currency string) *model.StatementBalance {
+		if balances[currency] == nil {
+			balances[currency] = &model.StatementBalance{Currency: currency}
+		}
+		return balances[currency]
+	}
+	for _, total := range opening {
+		balanceOf(total.Currency).Opening = total.Amount
+	}
+	for _, transaction := range transactions {
+		line := model.StatementLine{
+			Date:     transaction.CreatedAt.In(location),
+			Name:     transaction.Name,
+			Currency: transaction.Currency,
+			Amount:   transaction.Amount,
+		}
+		if transaction.Category != nil {
+			line.Category = transaction.Category.Name
+		}
+		statement.Transactions = append(statement.Transactions, line)
+		balanceOf(transaction.Currency).Spent += transaction.Amount
+	}
+	for _, balance := range balances {
+		balance.Closing = balance.Opening + balance.Spent
+		statement.Balances = append(statement.Balances, *balance)
+	}
+	sort.Slice(statement.Balances, func(i, j int) bool {
+		return statement.Balances[i].Currency < statement.Balances[j].Currency
+	})
+	if request.Currency != "" {
+		// Balances are converted at the rates of the last day of the period,
+		// or of today while the period is still running.
+		ratesDate := to.AddDate(0, 0, -1)
+		if now := time.Now().In(location); now.Before(ratesDate) {
+			ratesDate = now
+		}
+		statement.Total, err = service.convertBalances(ctx, statement.Balances, request.Currency, ratesDate)
+		if err != nil {
+			return response, err
+		}
+		statement.RatesDate = ratesDate
+	}
+
+	// The document is rendered before anything is sent, so a rendering
+	// failure can still be reported with a proper status code.
+	var document bytes.Buffer
+	if err = util.RenderStatementPDF(&document, statement); err != nil {
+		return response, err
+	}
+
+	return web.ExportFile{
+		FileName:    "statement-" + request.Period + ".pdf",
+		ContentType: "application/pdf",
+		Write: func(w io.Writer) error {
+			_, err := document.WriteTo(w)
+			return err
+		},
+	}, nil
+}
+
+// convertBalances sums balances converted into currency at the rates of
+// date. Opening and spent amounts are converted on their own, so the closing
+// total always adds up on the statement.
+func (service *StatementServiceImpl) convertBalances(ctx context.Context, balances []model.StatementBalance, currency string, date time.Time) (*model.StatementBalance, error) {
+	currencies := []string{currency}
+	for _, balance := range balances {
+		currencies = append(currencies, balance.Currency)
+	}
+	rates, err := service.ExchangeRateRepository.FindExchangeRates(ctx, currencies, date.AddDate(0, 0, -util.MaxRateAgeDays), date)
+	if err != nil {
+		return nil, err
+	}
+	table, err := util.NewRateTable(rates)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &model.StatementBalance{Currency: currency}
+	for _, balance := range balances {
+		opening, err := table.Convert(balance.Opening, balance.Currency, currency, date)
+		if err != nil {
+			return nil, err
+		}
+		spent, err := table.Convert(balance.Spent, balance.Currency, currency, date)
+		if err != nil {
+			return nil, err
+		}
+		total.Opening += opening
+		total.Spent += spent
+	}
+	total.Closing = total.Opening + total.Spent
+	return total, nil
+}
@@ -0,0 +1,5 @@
+package tag
+
+import "github.com/vnnyx/golang-dot-api/exception/apperror"
+
+var ErrTagNotFound = apperror.NotFound("TAG_NOT_FOUND", "tag_id")
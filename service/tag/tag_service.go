@@ -0,0 +1,13 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+type TagService interface {
+	CreateTag(ctx context.Context, request web.TagCreateRequest) (response web.TagResponse, err error)
+	GetTagByUserId(ctx context.Context, userId string) (response []web.TagResponse, err error)
+	RemoveTag(ctx context.Context, userId string, tagId string) error
+}
@@ -0,0 +1,68 @@
+package tag
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/tag"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+type TagServiceImpl struct {
+	tag.TagRepository
+}
+
+func NewTagService(tagRepository tag.TagRepository) TagService {
+	return &TagServiceImpl{TagRepository: tagRepository}
+}
+
+func (service *TagServiceImpl) CreateTag(ctx context.Context, request web.TagCreateRequest) (response web.TagResponse, err error) {
+	err = validation.CreateTagValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	tag, err := service.TagRepository.FindOrInsertTag(ctx, entity.Tag{
+		TagID:  uuid.NewString(),
+		UserID: request.UserID,
+		Name:   request.Name,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	response = web.TagResponse{
+		TagID:  tag.TagID,
+		UserID: tag.UserID,
+		Name:   tag.Name,
+	}
+
+	return response, nil
+}
+
+func (service *TagServiceImpl) GetTagByUserId(ctx context.Context, userId string) (response []web.TagResponse, err error) {
+	tags, err := service.TagRepository.FindTagByUserId(ctx, userId)
+	if err != nil {
+		return response, err
+	}
+
+	for _, tag := range tags {
+		response = append(response, web.TagResponse{
+			TagID:  tag.TagID,
+			UserID: tag.UserID,
+			Name:   tag.Name,
+		})
+	}
+
+	return response, nil
+}
+
+func (service *TagServiceImpl) RemoveTag(ctx context.Context, userId string, tagId string) error {
+	tag, err := service.TagRepository.FindTagByID(ctx, tagId)
+	if err != nil || tag.UserID != userId {
+		return ErrTagNotFound
+	}
+	return service.TagRepository.DeleteTag(ctx, tag.TagID)
+}
@@ -10,7 +10,14 @@ type TransactionService interface {
 	CreateTransaction(ctx context.Context, request web.TransactionCreateRequest) (response web.TransactionResponse, err error)
 	GetTransactionById(ctx context.Context, transactionId string) (response web.TransactionResponse, err error)
 	GetAllTransaction(ctx context.Context) (response []web.TransactionResponse, err error)
-	GetTransactionByUserId(ctx context.Context, userId string) (response []web.TransactionResponse, err error)
+	GetTransactionByUserId(ctx context.Context, request web.TransactionListRequest) (response []web.TransactionResponse, err error)
 	UpdateTransaction(ctx context.Context, request web.TransactionUpdateRequest) (response web.TransactionResponse, err error)
+	PatchTransaction(ctx context.Context, request web.PatchRequest) (response web.TransactionResponse, err error)
 	RemoveTransaction(ctx context.Context, transactionId string) error
+	CreateTransactionBatch(ctx context.Context, request web.TransactionBatchCreateRequest) (response web.TransactionBatchResponse, err error)
+	UpdateTransactionBatch(ctx context.Context, request web.TransactionBatchUpdateRequest) (response web.TransactionBatchResponse, err error)
+	RemoveTransactionBatch(ctx context.Context, request web.TransactionBatchDeleteRequest) (response web.TransactionBatchResponse, err error)
+	// WaitBudgetChecks blocks until the budget checks started in the
+	// background have finished, or until ctx is done.
+	WaitBudgetChecks(ctx context.Context) error
 }
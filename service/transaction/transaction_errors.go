@@ -0,0 +1,15 @@
+package transaction
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrUserNotFound        = apperror.NotFound("USER_NOT_FOUND", "user_id")
+	ErrTransactionNotFound = apperror.NotFound("TRANSACTION_NOT_FOUND", "transaction_id")
+	ErrCategoryNotFound    = apperror.NotFound("CATEGORY_NOT_FOUND", "category_id")
+	ErrTransactionOnHold   = apperror.New(http.StatusConflict, "TRANSACTION_ON_HOLD", "transaction_id", "is on hold by an open dispute")
+	ErrParticipantNotFound = apperror.NotFound("PARTICIPANT_NOT_FOUND", "participant_id")
+)
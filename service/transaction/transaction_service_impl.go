@@ -2,65 +2,101 @@ package transaction
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+	"github.com/vnnyx/golang-dot-api/infrastructure/notifier"
+	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/budget"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/tag"
 	"github.com/vnnyx/golang-dot-api/repository/transaction"
 	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/util"
 	"github.com/vnnyx/golang-dot-api/validation"
 )
 
+// budgetThresholds are the percentages of a monthly budget that trigger an
+// alert, highest first.
+var budgetThresholds = []int{100, 80}
+
+const budgetCheckTimeout = 10 * time.Second
+
 type TransactionServiceImpl struct {
 	transaction.TransactionRepository
 	user.UserRepository
+	category.CategoryRepository
+	tag.TagRepository
+	budget.BudgetRepository
+	notifier.Notifier
+	budgetChecks sync.WaitGroup
 }
 
-func NewTransactionService(transactionRepository transaction.TransactionRepository, userRepository user.UserRepository) TransactionService {
-	return &TransactionServiceImpl{TransactionRepository: transactionRepository, UserRepository: userRepository}
+func NewTransactionService(transactionRepository transaction.TransactionRepository, userRepository user.UserRepository, categoryRepository category.CategoryRepository, tagRepository tag.TagRepository, budgetRepository budget.BudgetRepository, notifier notifier.Notifier) TransactionService {
+	return &TransactionServiceImpl{
+		TransactionRepository: transactionRepository,
+		UserRepository:        userRepository,
+		CategoryRepository:    categoryRepository,
+		TagRepository:         tagRepository,
+		BudgetRepository:      budgetRepository,
+		Notifier:              notifier,
+	}
 }
 
 func (service *TransactionServiceImpl) CreateTransaction(ctx context.Context, request web.TransactionCreateRequest) (response web.TransactionResponse, err error) {
-	validation.CreateTransactionValidation(request)
+	err = validation.CreateTransactionValidation(request)
+	if err != nil {
+		return response, err
+	}
 
 	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
 	if err != nil {
-		return response, errors.New("USER_NOT_FOUND")
+		return response, ErrUserNotFound
 	}
 
-	transaction, err := service.TransactionRepository.InsertTransaction(ctx, entity.Transaction{
-		TransactionID: uuid.NewString(),
-		Name:          request.Name,
-		UserID:        user.UserID,
-	})
+	transaction, err := service.newTransaction(ctx, user.UserID, request)
+	if err != nil {
+		return response, err
+	}
+	if request.IdempotencyKey != "" {
+		transaction.TransactionID = util.IdempotentID(request.IdempotencyKey)
+	}
 
+	transaction, err = service.TransactionRepository.InsertTransaction(ctx, transaction)
 	if err != nil {
 		return response, err
 	}
+	metrics.TransactionsCreated.Inc()
 
-	response = web.TransactionResponse{
-		TransactionID: transaction.TransactionID,
-		Name:          transaction.Name,
-		UserID:        transaction.UserID,
+	if transaction.CategoryID != nil && transaction.Amount > 0 {
+		service.budgetChecks.Add(1)
+		go func() {
+			defer service.budgetChecks.Done()
+			service.checkBudgets(transaction)
+		}()
 	}
 
-	return response, nil
+	return toTransactionResponse(transaction), nil
 }
 
 func (service *TransactionServiceImpl) GetTransactionById(ctx context.Context, transactionId string) (response web.TransactionResponse, err error) {
 	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, transactionId)
 	if err != nil {
-		return response, errors.New("TRANSACTION_NOT_FOUND")
+		return response, ErrTransactionNotFound
 	}
 
-	response = web.TransactionResponse{
-		TransactionID: transaction.TransactionID,
-		Name:          transaction.Name,
-		UserID:        transaction.UserID,
-	}
-
-	return response, nil
+	return toTransactionResponse(transaction), nil
 }
 
 func (service *TransactionServiceImpl) GetAllTransaction(ctx context.Context) (response []web.TransactionResponse, err error) {
@@ -70,69 +106,718 @@ func (service *TransactionServiceImpl) GetAllTransaction(ctx context.Context) (r
 	}
 
 	for _, transaction := range transactions {
-		response = append(response, web.TransactionResponse{
-			TransactionID: transaction.TransactionID,
-			Name:          transaction.Name,
-			UserID:        transaction.UserID,
-		})
+		response = append(response, toTransactionResponse(transaction))
 	}
 
 	return response, nil
 }
 
-func (service *TransactionServiceImpl) GetTransactionByUserId(ctx context.Context, userId string) (response []web.TransactionResponse, err error) {
-	user, err := service.UserRepository.FindUserByID(ctx, userId)
+func (service *TransactionServiceImpl) GetTransactionByUserId(ctx context.Context, request web.TransactionListRequest) (response []web.TransactionResponse, err error) {
+	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
 	if err != nil {
-		return response, errors.New("USER_NOT_FOUND")
+		return response, ErrUserNotFound
+	}
+
+	filter := model.TransactionFilter{Tag: request.Tag}
+	if request.CategoryID != "" {
+		categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, user.UserID)
+		if err != nil {
+			return response, err
+		}
+		owned := false
+		for _, category := range categories {
+			if category.CategoryID == request.CategoryID {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			return response, ErrCategoryNotFound
+		}
+		filter.CategoryIDs = util.CategoryDescendantIDs(categories, request.CategoryID)
 	}
 
-	transactions, err := service.TransactionRepository.FindTransactionByUserId(ctx, user.UserID)
+	transactions, err := service.TransactionRepository.FindTransactionByUserId(ctx, user.UserID, filter)
 	if err != nil {
 		return response, err
 	}
 
 	for _, transaction := range transactions {
-		response = append(response, web.TransactionResponse{
-			TransactionID: transaction.TransactionID,
-			Name:          transaction.Name,
-			UserID:        transaction.UserID,
-		})
+		transactionResponse := toTransactionResponse(transaction)
+		transactionResponse.Share = userShare(transaction, user.UserID)
+		response = append(response, transactionResponse)
 	}
 
 	return response, nil
 }
 
 func (service *TransactionServiceImpl) UpdateTransaction(ctx context.Context, request web.TransactionUpdateRequest) (response web.TransactionResponse, err error) {
-	validation.UpdateTransactionValidation(request)
+	err = validation.UpdateTransactionValidation(request)
+	if err != nil {
+		return response, err
+	}
 
 	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, request.TransactionID)
 	if err != nil {
-		return response, errors.New("TRANSACTION_NOT_FOUND")
+		return response, ErrTransactionNotFound
+	}
+	if transaction.OnHold {
+		return response, ErrTransactionOnHold
 	}
 
-	transaction, err = service.TransactionRepository.UpdateTransaction(ctx, entity.Transaction{
-		TransactionID: transaction.TransactionID,
-		Name:          request.Name,
-		UserID:        transaction.UserID,
-	})
+	return service.applyTransactionUpdate(ctx, transaction, request)
+}
 
+func (service *TransactionServiceImpl) PatchTransaction(ctx context.Context, request web.PatchRequest) (response web.TransactionResponse, err error) {
+	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, request.ID)
+	if err != nil {
+		return response, ErrTransactionNotFound
+	}
+	if transaction.OnHold {
+		return response, ErrTransactionOnHold
+	}
+
+	current := web.TransactionUpdateRequest{
+		Name:     transaction.Name,
+		Amount:   transaction.Amount,
+		Currency: transaction.Currency,
+		Tags:     tagNames(transaction.Tags),
+		Split:    toSplitRequest(transaction),
+	}
+	if transaction.CategoryID != nil {
+		current.CategoryID = *transaction.CategoryID
+	}
+	document, err := json.Marshal(current)
 	if err != nil {
 		return response, err
 	}
 
-	response = web.TransactionResponse{
-		TransactionID: transaction.TransactionID,
-		Name:          transaction.Name,
-		UserID:        transaction.UserID,
+	patched, err := util.ApplyPatch(request.ContentType, document, request.Patch)
+	if err != nil {
+		return response, err
 	}
 
-	return response, nil
+	var merged web.TransactionUpdateRequest
+	err = json.Unmarshal(patched, &merged)
+	if err != nil {
+		return response, util.ErrInvalidPatch.Wrap(err)
+	}
+	merged.TransactionID = transaction.TransactionID
+	err = validation.UpdateTransactionValidation(merged)
+	if err != nil {
+		return response, err
+	}
+
+	return service.applyTransactionUpdate(ctx, transaction, merged)
 }
 
 func (service *TransactionServiceImpl) RemoveTransaction(ctx context.Context, transactionId string) error {
 	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, transactionId)
 	if err != nil {
-		return errors.New("TRANSACTION_NOT_FOUND")
+		return ErrTransactionNotFound
+	}
+	if transaction.OnHold {
+		return ErrTransactionOnHold
 	}
 	return service.TransactionRepository.DeleteTransaction(ctx, transaction.TransactionID)
 }
+
+func (service *TransactionServiceImpl) CreateTransactionBatch(ctx context.Context, request web.TransactionBatchCreateRequest) (response web.TransactionBatchResponse, err error) {
+	err = validation.TransactionBatchCreateValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
+	if err != nil {
+		return response, ErrUserNotFound
+	}
+
+	response = newBatchResponse(request.Mode, len(request.Items))
+	transactions := make([]entity.Transaction, len(request.Items))
+	for i, item := range request.Items {
+		err := validation.CreateTransactionValidation(item)
+		if err == nil {
+			transactions[i], err = service.newTransaction(ctx, user.UserID, item)
+		}
+		if err != nil {
+			response.Results[i] = failedBatchResult(i, err)
+		}
+	}
+
+	pending := pendingBatchItems(&response)
+	if len(pending) == 0 {
+		return finishBatch(response), nil
+	}
+	batch := make([]entity.Transaction, 0, len(pending))
+	for _, i := range pending {
+		batch = append(batch, transactions[i])
+	}
+
+	saved := make([]bool, len(batch))
+	err = service.TransactionRepository.InsertTransactionBatch(ctx, batch)
+	switch {
+	case err == nil:
+		for n := range batch {
+			saved[n] = true
+		}
+	case response.Mode == web.BatchModeAtomic:
+		return response, err
+	default:
+		// The whole batch was rolled back, so save the items one at a time
+		// to keep every item that can be saved.
+		log.Printf("batch create of %d transactions failed, retrying one by one: %v", len(batch), err)
+		for n := range batch {
+			transaction, err := service.TransactionRepository.InsertTransaction(ctx, batch[n])
+			if err != nil {
+				continue
+			}
+			batch[n], saved[n] = transaction, true
+		}
+	}
+
+	var created []entity.Transaction
+	for n, i := range pending {
+		if !saved[n] {
+			response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusFailed, Errors: map[string]interface{}{"item": "could not be saved"}}
+			continue
+		}
+		metrics.TransactionsCreated.Inc()
+		transactionResponse := toTransactionResponse(batch[n])
+		response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusCreated, TransactionID: batch[n].TransactionID, Transaction: &transactionResponse}
+		if batch[n].CategoryID != nil && batch[n].Amount > 0 {
+			created = append(created, batch[n])
+		}
+	}
+	if len(created) > 0 {
+		service.budgetChecks.Add(1)
+		go func() {
+			defer service.budgetChecks.Done()
+			for _, transaction := range created {
+				service.checkBudgets(transaction)
+			}
+		}()
+	}
+
+	return finishBatch(response), nil
+}
+
+func (service *TransactionServiceImpl) UpdateTransactionBatch(ctx context.Context, request web.TransactionBatchUpdateRequest) (response web.TransactionBatchResponse, err error) {
+	err = validation.TransactionBatchUpdateValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	response = newBatchResponse(request.Mode, len(request.Items))
+	transactions := make([]entity.Transaction, len(request.Items))
+	seen := make(map[string]bool)
+	for i, item := range request.Items {
+		if item.TransactionID != "" && seen[item.TransactionID] {
+			response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusFailed, TransactionID: item.TransactionID, Errors: map[string]interface{}{"transaction_id": "is repeated in the batch"}}
+			continue
+		}
+		seen[item.TransactionID] = true
+
+		var err error
+		transactions[i], err = service.updatedBatchItem(ctx, item)
+		if err != nil {
+			response.Results[i] = failedBatchResult(i, err)
+			response.Results[i].TransactionID = item.TransactionID
+		}
+	}
+
+	pending := pendingBatchItems(&response)
+	if response.Mode == web.BatchModeAtomic && len(pending) > 0 {
+		batch := make([]entity.Transaction, 0, len(pending))
+		for _, i := range pending {
+			batch = append(batch, transactions[i])
+		}
+		err = service.TransactionRepository.UpdateTransactionBatch(ctx, batch)
+		if err != nil {
+			return response, err
+		}
+		for n, i := range pending {
+			transactions[i] = batch[n]
+		}
+	}
+	for _, i := range pending {
+		if response.Mode == web.BatchModeBestEffort {
+			transaction, err := service.TransactionRepository.UpdateTransaction(ctx, transactions[i])
+			if err != nil {
+				log.Printf("batch update of transaction %s: %v", transactions[i].TransactionID, err)
+				response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusFailed, TransactionID: transactions[i].TransactionID, Errors: map[string]interface{}{"item": "could not be saved"}}
+				continue
+			}
+			transactions[i] = transaction
+		}
+		transactionResponse := toTransactionResponse(transactions[i])
+		response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusUpdated, TransactionID: transactions[i].TransactionID, Transaction: &transactionResponse}
+	}
+
+	return finishBatch(response), nil
+}
+
+func (service *TransactionServiceImpl) RemoveTransactionBatch(ctx context.Context, request web.TransactionBatchDeleteRequest) (response web.TransactionBatchResponse, err error) {
+	err = validation.TransactionBatchDeleteValidation(request)
+	if err != nil {
+		return response, err
+	}
+
+	existing, err := service.TransactionRepository.FindExistingTransactionIDs(ctx, request.TransactionIDs)
+	if err != nil {
+		return response, err
+	}
+	found := make(map[string]bool)
+	for _, transactionId := range existing {
+		found[transactionId] = true
+	}
+	held, err := service.TransactionRepository.FindHeldTransactionIDs(ctx, existing)
+	if err != nil {
+		return response, err
+	}
+	onHold := make(map[string]bool)
+	for _, transactionId := range held {
+		onHold[transactionId] = true
+	}
+
+	response = newBatchResponse(request.Mode, len(request.TransactionIDs))
+	seen := make(map[string]bool)
+	for i, transactionId := range request.TransactionIDs {
+		switch {
+		case seen[transactionId]:
+			response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusFailed, TransactionID: transactionId, Errors: map[string]interface{}{"transaction_id": "is repeated in the batch"}}
+		case !found[transactionId]:
+			response.Results[i] = failedBatchResult(i, ErrTransactionNotFound)
+			response.Results[i].TransactionID = transactionId
+		case onHold[transactionId]:
+			response.Results[i] = failedBatchResult(i, ErrTransactionOnHold)
+			response.Results[i].TransactionID = transactionId
+		}
+		seen[transactionId] = true
+	}
+
+	pending := pendingBatchItems(&response)
+	if len(pending) == 0 {
+		return finishBatch(response), nil
+	}
+	transactionIds := make([]string, 0, len(pending))
+	for _, i := range pending {
+		transactionIds = append(transactionIds, request.TransactionIDs[i])
+	}
+	// A single statement deletes every pending item, so both modes either
+	// delete all of them or none.
+	err = service.TransactionRepository.DeleteTransactionBatch(ctx, transactionIds)
+	if err != nil {
+		return response, err
+	}
+	for _, i := range pending {
+		response.Results[i] = web.TransactionBatchResult{Index: i, Status: web.BatchStatusDeleted, TransactionID: request.TransactionIDs[i]}
+	}
+
+	return finishBatch(response), nil
+}
+
+func (service *TransactionServiceImpl) applyTransactionUpdate(ctx context.Context, transaction entity.Transaction, request web.TransactionUpdateRequest) (response web.TransactionResponse, err error) {
+	transaction, err = service.updatedTransaction(ctx, transaction, request)
+	if err != nil {
+		return response, err
+	}
+
+	transaction, err = service.TransactionRepository.UpdateTransaction(ctx, transaction)
+	if err != nil {
+		return response, err
+	}
+
+	return toTransactionResponse(transaction), nil
+}
+
+// newTransaction resolves the category, tags and splits of a validated create
+// request without saving it.
+func (service *TransactionServiceImpl) newTransaction(ctx context.Context, userId string, request web.TransactionCreateRequest) (transaction entity.Transaction, err error) {
+	categoryId, err := service.resolveCategory(ctx, userId, request.CategoryID, request.Name)
+	if err != nil {
+		return transaction, err
+	}
+
+	tags, err := service.resolveTags(ctx, userId, request.Tags)
+	if err != nil {
+		return transaction, err
+	}
+
+	splitMethod, splits, err := service.resolveSplits(ctx, request.Amount, request.Split)
+	if err != nil {
+		return transaction, err
+	}
+
+	currency := request.Currency
+	if currency == "" {
+		currency = entity.DefaultCurrency
+	}
+
+	return entity.Transaction{
+		TransactionID: uuid.NewString(),
+		Name:          request.Name,
+		Amount:        request.Amount,
+		Currency:      currency,
+		UserID:        userId,
+		CategoryID:    categoryId,
+		SplitMethod:   splitMethod,
+		Tags:          tags,
+		Splits:        splits,
+		CreatedAt:     request.CreatedAt,
+	}, nil
+}
+
+// updatedTransaction applies a validated update request to transaction
+// without saving it.
+func (service *TransactionServiceImpl) updatedTransaction(ctx context.Context, transaction entity.Transaction, request web.TransactionUpdateRequest) (entity.Transaction, error) {
+	transaction.CategoryID = nil
+	if request.CategoryID != "" {
+		category, err := service.CategoryRepository.FindCategoryByID(ctx, request.CategoryID)
+		if err != nil || category.UserID != transaction.UserID {
+			return transaction, ErrCategoryNotFound
+		}
+		transaction.CategoryID = &category.CategoryID
+	}
+
+	var err error
+	transaction.Tags, err = service.resolveTags(ctx, transaction.UserID, request.Tags)
+	if err != nil {
+		return transaction, err
+	}
+	transaction.SplitMethod, transaction.Splits, err = service.resolveSplits(ctx, request.Amount, request.Split)
+	if err != nil {
+		return transaction, err
+	}
+	transaction.Name = request.Name
+	transaction.Amount = request.Amount
+	if request.Currency != "" {
+		transaction.Currency = request.Currency
+	}
+	return transaction, nil
+}
+
+// resolveCategory returns the requested category when it belongs to the user,
+// otherwise the category of the first rule that matches the transaction name.
+func (service *TransactionServiceImpl) resolveCategory(ctx context.Context, userId string, categoryId string, name string) (*string, error) {
+	if categoryId != "" {
+		category, err := service.CategoryRepository.FindCategoryByID(ctx, categoryId)
+		if err != nil || category.UserID != userId {
+			return nil, ErrCategoryNotFound
+		}
+		return &category.CategoryID, nil
+	}
+
+	rules, err := service.CategoryRepository.FindCategoryRuleByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	rule, ok := util.MatchCategoryRule(rules, name)
+	if !ok {
+		return nil, nil
+	}
+	return &rule.CategoryID, nil
+}
+
+func (service *TransactionServiceImpl) resolveTags(ctx context.Context, userId string, names []string) (tags []entity.Tag, err error) {
+	seen := make(map[string]bool)
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		tag, err := service.TagRepository.FindOrInsertTag(ctx, entity.Tag{
+			TagID:  uuid.NewString(),
+			UserID: userId,
+			Name:   name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (service *TransactionServiceImpl) WaitBudgetChecks(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		service.budgetChecks.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// checkBudgets runs after the response is sent so budget lookups never slow
+// down transaction creation. Failures are only logged.
+func (service *TransactionServiceImpl) checkBudgets(transaction entity.Transaction) {
+	ctx, cancel := context.WithTimeout(context.Background(), budgetCheckTimeout)
+	defer cancel()
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("budget check for transaction %s: recovered from panic: %v", transaction.TransactionID, r)
+		}
+	}()
+
+	err := service.notifyBudgetThresholds(ctx, transaction)
+	if err != nil {
+		log.Printf("budget check for transaction %s: %v", transaction.TransactionID, err)
+	}
+}
+
+// notifyBudgetThresholds alerts the user about every budget on the
+// transaction's category or its parents, in the transaction's currency, that
+// has now crossed a threshold.
+// Only the highest crossed threshold is sent; lower ones are recorded so they
+// are not sent later in the same month.
+func (service *TransactionServiceImpl) notifyBudgetThresholds(ctx context.Context, transaction entity.Transaction) error {
+	categories, err := service.CategoryRepository.FindCategoryByUserId(ctx, transaction.UserID)
+	if err != nil {
+		return err
+	}
+
+	budgets, err := service.BudgetRepository.FindBudgetByCategoryIds(ctx, transaction.UserID, util.CategoryAncestorIDs(categories, *transaction.CategoryID))
+	if err != nil {
+		return err
+	}
+
+	for _, budget := range budgets {
+		if budget.Currency != transaction.Currency {
+			continue
+		}
+		location, err := time.LoadLocation(budget.Timezone)
+		if err != nil {
+			location = time.UTC
+		}
+		from, to := util.MonthRange(transaction.CreatedAt, location)
+		period := from.Format(util.PeriodLayout)
+
+		spent, err := service.TransactionRepository.SumTransactionAmount(ctx, transaction.UserID, budget.Currency, util.CategoryDescendantIDs(categories, budget.CategoryID), from, to)
+		if err != nil {
+			return err
+		}
+
+		notified := false
+		for _, threshold := range budgetThresholds {
+			if spent*100 < budget.Amount*int64(threshold) {
+				continue
+			}
+			inserted, err := service.BudgetRepository.InsertBudgetAlert(ctx, entity.BudgetAlert{
+				BudgetID:  budget.BudgetID,
+				Period:    period,
+				Threshold: threshold,
+			})
+			if err != nil {
+				return err
+			}
+			if !inserted || notified {
+				continue
+			}
+			notified = true
+
+			err = service.Notifier.Notify(ctx, model.Notification{
+				UserID:  transaction.UserID,
+				Type:    model.NotificationBudgetThreshold,
+				Message: fmt.Sprintf("You have used %d%% of your budget for %s", threshold, period),
+				Data: map[string]interface{}{
+					"budget_id":   budget.BudgetID,
+					"category_id": budget.CategoryID,
+					"period":      period,
+					"threshold":   threshold,
+					"spent":       spent,
+					"amount":      budget.Amount,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSplits checks that every participant exists and computes their
+// shares of amount. A nil split means the transaction is not shared.
+func (service *TransactionServiceImpl) resolveSplits(ctx context.Context, amount int64, split *web.TransactionSplitRequest) (method string, splits []entity.TransactionSplit, err error) {
+	if split == nil {
+		return "", nil, nil
+	}
+
+	shares := util.SplitAmount(amount, split.Method, split.Participants)
+	for i, participant := range split.Participants {
+		_, err := service.UserRepository.FindUserByID(ctx, participant.UserID)
+		if err != nil {
+			return "", nil, ErrParticipantNotFound
+		}
+
+		transactionSplit := entity.TransactionSplit{
+			UserID: participant.UserID,
+			Amount: shares[i],
+		}
+		if split.Method == entity.SplitPercent {
+			transactionSplit.PercentBasisPoints = util.PercentToBasisPoints(participant.Percent)
+		}
+		splits = append(splits, transactionSplit)
+	}
+	return split.Method, splits, nil
+}
+
+// toSplitRequest rebuilds the split rule a transaction was saved with, so a
+// patch that only changes the amount re-divides equal and percentage splits.
+func toSplitRequest(transaction entity.Transaction) *web.TransactionSplitRequest {
+	if transaction.SplitMethod == "" {
+		return nil
+	}
+
+	split := &web.TransactionSplitRequest{Method: transaction.SplitMethod}
+	for _, transactionSplit := range transaction.Splits {
+		participant := web.TransactionSplitParticipant{UserID: transactionSplit.UserID}
+		switch transaction.SplitMethod {
+		case entity.SplitExact:
+			participant.Amount = transactionSplit.Amount
+		case entity.SplitPercent:
+			participant.Percent = float64(transactionSplit.PercentBasisPoints) / 100
+		}
+		split.Participants = append(split.Participants, participant)
+	}
+	return split
+}
+
+// userShare returns what userId owes on a split transaction, or nil when the
+// transaction is not split.
+func userShare(transaction entity.Transaction, userId string) *int64 {
+	if transaction.SplitMethod == "" {
+		return nil
+	}
+
+	share := int64(0)
+	for _, transactionSplit := range transaction.Splits {
+		if transactionSplit.UserID == userId {
+			share = transactionSplit.Amount
+		}
+	}
+	return &share
+}
+
+// newBatchResponse prepares one result per item; an empty status marks an
+// item that is still pending.
+func newBatchResponse(mode string, total int) web.TransactionBatchResponse {
+	if mode == "" {
+		mode = web.BatchModeAtomic
+	}
+	response := web.TransactionBatchResponse{Mode: mode, Total: total, Results: make([]web.TransactionBatchResult, total)}
+	for i := range response.Results {
+		response.Results[i].Index = i
+	}
+	return response
+}
+
+// pendingBatchItems returns the items that are still to be saved. In atomic
+// mode a single failed item skips all of them.
+func pendingBatchItems(response *web.TransactionBatchResponse) (pending []int) {
+	failed := false
+	for i, result := range response.Results {
+		if result.Status == "" {
+			pending = append(pending, i)
+		} else {
+			failed = true
+		}
+	}
+	if failed && response.Mode == web.BatchModeAtomic {
+		for _, i := range pending {
+			response.Results[i].Status = web.BatchStatusSkipped
+		}
+		return nil
+	}
+	return pending
+}
+
+func finishBatch(response web.TransactionBatchResponse) web.TransactionBatchResponse {
+	for _, result := range response.Results {
+		switch result.Status {
+		case web.BatchStatusCreated, web.BatchStatusUpdated, web.BatchStatusDeleted:
+			response.Succeeded++
+		case web.BatchStatusFailed:
+			response.Failed++
+		}
+	}
+	return response
+}
+
+// updatedBatchItem validates a single batch update item and returns its
+// transaction with the update applied.
+func (service *TransactionServiceImpl) updatedBatchItem(ctx context.Context, item web.TransactionBatchUpdateItem) (entity.Transaction, error) {
+	update := item.TransactionUpdateRequest
+	update.TransactionID = item.TransactionID
+	err := validation.UpdateTransactionValidation(update)
+	if err != nil {
+		return entity.Transaction{}, err
+	}
+
+	transaction, err := service.TransactionRepository.FindTransactionByID(ctx, item.TransactionID)
+	if err != nil {
+		return entity.Transaction{}, ErrTransactionNotFound
+	}
+	if transaction.OnHold {
+		return entity.Transaction{}, ErrTransactionOnHold
+	}
+	return service.updatedTransaction(ctx, transaction, update)
+}
+
+// failedBatchResult reports err with the same field errors the single item
+// endpoints respond with.
+func failedBatchResult(index int, err error) web.TransactionBatchResult {
+	result := web.TransactionBatchResult{Index: index, Status: web.BatchStatusFailed}
+	var validationError exception.ValidationError
+	if errors.As(err, &validationError) {
+		result.Errors = validationError.Fields()
+		return result
+	}
+
+	var appError *apperror.Error
+	if errors.As(err, &appError) && appError.Status < http.StatusInternalServerError {
+		result.Errors = map[string]interface{}{appError.Field: appError.Message}
+		return result
+	}
+	log.Printf("batch item %d: %v", index, err)
+	result.Errors = map[string]interface{}{"item": "could not be processed"}
+	return result
+}
+
+func tagNames(tags []entity.Tag) (names []string) {
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func toTransactionResponse(transaction entity.Transaction) web.TransactionResponse {
+	response := web.TransactionResponse{
+		TransactionID: transaction.TransactionID,
+		Name:          transaction.Name,
+		Amount:        transaction.Amount,
+		Currency:      transaction.Currency,
+		UserID:        transaction.UserID,
+		Tags:          tagNames(transaction.Tags),
+		SplitMethod:   transaction.SplitMethod,
+		OnHold:        transaction.OnHold,
+		CreatedAt:     transaction.CreatedAt,
+	}
+	if transaction.CategoryID != nil {
+		response.CategoryID = *transaction.CategoryID
+	}
+	for _, transactionSplit := range transaction.Splits {
+		response.Splits = append(response.Splits, web.TransactionSplitResponse{
+			UserID:  transactionSplit.UserID,
+			Amount:  transactionSplit.Amount,
+			Percent: float64(transactionSplit.PercentBasisPoints) / 100,
+		})
+	}
+	return response
+}
@@ -0,0 +1,13 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+)
+
+var (
+	ErrPasswordNotMatch  = apperror.New(http.StatusBadRequest, "PASSWORD_NOT_MATCH", "password_confirmation", "not match")
+	ErrUserNotFound      = apperror.NotFound("USER_NOT_FOUND", "user_id")
+	ErrTransactionOnHold = apperror.New(http.StatusConflict, "TRANSACTION_ON_HOLD", "transaction_id", "is on hold by an open dispute")
+)
@@ -11,5 +11,6 @@ type UserService interface {
 	GetUserById(ctx context.Context, userId string) (response web.UserResponse, err error)
 	GetAllUser(ctx context.Context) (response []web.UserResponse, err error)
 	UpdateUserProfile(ctx context.Context, request web.UserUpdateProfileRequest) (response web.UserResponse, err error)
+	PatchUserProfile(ctx context.Context, request web.PatchRequest) (response web.UserResponse, err error)
 	RemoveUser(ctx context.Context, userId string) error
 }
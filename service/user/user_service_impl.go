@@ -2,13 +2,15 @@ package user
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 
 	"github.com/google/uuid"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
 	"github.com/vnnyx/golang-dot-api/repository/transaction"
 	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/util"
 	"github.com/vnnyx/golang-dot-api/validation"
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
@@ -25,10 +27,13 @@ func NewUserService(userRepository user.UserRepository, transactionRepository tr
 }
 
 func (service *UserServiceImpl) CreateUser(ctx context.Context, request web.UserCreateRequest) (response web.UserResponse, err error) {
-	validation.CreateUserValidation(request)
+	err = validation.CreateUserValidation(ctx, service.UserRepository, request)
+	if err != nil {
+		return response, err
+	}
 
 	if request.Password != request.PasswordConfirmation {
-		return response, errors.New("PASSWORD_NOT_MATCH")
+		return response, ErrPasswordNotMatch
 	}
 
 	password, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
@@ -42,18 +47,21 @@ func (service *UserServiceImpl) CreateUser(ctx context.Context, request web.User
 		Email:     request.Email,
 		Handphone: request.Handphone,
 		Password:  string(password),
+		Locale:    request.Locale,
 	}
 
 	user, err = service.UserRepository.InsertUser(ctx, user)
 	if err != nil {
 		return response, err
 	}
+	metrics.UsersRegistered.Inc()
 
 	response = web.UserResponse{
 		UserID:    user.UserID,
 		Username:  user.Username,
 		Email:     user.Email,
 		Handphone: user.Handphone,
+		Locale:    user.Locale,
 	}
 
 	return response, nil
@@ -62,7 +70,7 @@ func (service *UserServiceImpl) CreateUser(ctx context.Context, request web.User
 func (service *UserServiceImpl) GetUserById(ctx context.Context, userId string) (response web.UserResponse, err error) {
 	user, err := service.UserRepository.FindUserByID(ctx, userId)
 	if err != nil {
-		return response, errors.New("USER_NOT_FOUND")
+		return response, ErrUserNotFound
 	}
 
 	response = web.UserResponse{
@@ -70,6 +78,7 @@ func (service *UserServiceImpl) GetUserById(ctx context.Context, userId string)
 		Username:  user.Username,
 		Email:     user.Email,
 		Handphone: user.Handphone,
+		Locale:    user.Locale,
 	}
 
 	return response, nil
@@ -87,6 +96,7 @@ func (service *UserServiceImpl) GetAllUser(ctx context.Context) (response []web.
 			Username:  user.Username,
 			Email:     user.Email,
 			Handphone: user.Handphone,
+			Locale:    user.Locale,
 		})
 	}
 
@@ -94,20 +104,76 @@ func (service *UserServiceImpl) GetAllUser(ctx context.Context) (response []web.
 }
 
 func (service *UserServiceImpl) UpdateUserProfile(ctx context.Context, request web.UserUpdateProfileRequest) (response web.UserResponse, err error) {
-	validation.UpdateUserProfileValidation(request)
+	err = validation.UpdateUserProfileValidation(ctx, service.UserRepository, request)
+	if err != nil {
+		return response, err
+	}
 
 	user, err := service.UserRepository.FindUserByID(ctx, request.UserID)
 	if err != nil {
-		return response, errors.New("USER_NOT_FOUND")
+		return response, ErrUserNotFound
+	}
+
+	user.Username = request.Username
+	user.Email = request.Email
+	user.Handphone = request.Handphone
+	if request.Locale != "" {
+		user.Locale = request.Locale
+	}
+	user, err = service.UserRepository.UpdateUser(ctx, user)
+
+	if err != nil {
+		return response, err
 	}
 
-	user, err = service.UserRepository.UpdateUser(ctx, entity.User{
+	response = web.UserResponse{
 		UserID:    user.UserID,
-		Username:  request.Username,
-		Email:     request.Email,
-		Handphone: request.Handphone,
+		Username:  user.Username,
+		Email:     user.Email,
+		Handphone: user.Handphone,
+		Locale:    user.Locale,
+	}
+
+	return response, nil
+}
+
+func (service *UserServiceImpl) PatchUserProfile(ctx context.Context, request web.PatchRequest) (response web.UserResponse, err error) {
+	user, err := service.UserRepository.FindUserByID(ctx, request.ID)
+	if err != nil {
+		return response, ErrUserNotFound
+	}
+
+	document, err := json.Marshal(web.UserUpdateProfileRequest{
+		Username:  user.Username,
+		Email:     user.Email,
+		Handphone: user.Handphone,
+		Locale:    user.Locale,
 	})
+	if err != nil {
+		return response, err
+	}
+
+	patched, err := util.ApplyPatch(request.ContentType, document, request.Patch)
+	if err != nil {
+		return response, err
+	}
+
+	var merged web.UserUpdateProfileRequest
+	err = json.Unmarshal(patched, &merged)
+	if err != nil {
+		return response, util.ErrInvalidPatch.Wrap(err)
+	}
+	merged.UserID = user.UserID
+	err = validation.PatchUserProfileValidation(ctx, service.UserRepository, merged, user.Handphone)
+	if err != nil {
+		return response, err
+	}
 
+	user.Username = merged.Username
+	user.Email = merged.Email
+	user.Handphone = merged.Handphone
+	user.Locale = merged.Locale
+	user, err = service.UserRepository.UpdateUser(ctx, user)
 	if err != nil {
 		return response, err
 	}
@@ -117,15 +183,16 @@ func (service *UserServiceImpl) UpdateUserProfile(ctx context.Context, request w
 		Username:  user.Username,
 		Email:     user.Email,
 		Handphone: user.Handphone,
+		Locale:    user.Locale,
 	}
 
 	return response, nil
 }
 
-func (service *UserServiceImpl) RemoveUser(ctx context.Context, userId string) error {
+func (service *UserServiceImpl) RemoveUser(ctx context.Context, userId string) (err error) {
 	user, err := service.UserRepository.FindUserByID(ctx, userId)
 	if err != nil {
-		return errors.New("USER_NOT_FOUND")
+		return ErrUserNotFound
 	}
 
 	tx := service.DB.Begin()
@@ -145,6 +212,14 @@ func (service *UserServiceImpl) RemoveUser(ctx context.Context, userId string) e
 		}
 	}()
 
+	held, err := service.TransactionRepository.HasHeldTransaction(ctx, tx, user.UserID)
+	if err != nil {
+		return err
+	}
+	if held {
+		return ErrTransactionOnHold
+	}
+
 	err = service.TransactionRepository.DeleteTransactionByUserId(ctx, tx, user.UserID)
 	if err != nil {
 		return err
@@ -0,0 +1,112 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUploadAttachment(t *testing.T) {
+	pngContent := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+	tests := []struct {
+		name               string
+		transactionId      string
+		content            []byte
+		codeExpected       int
+		statusCodeExpected string
+	}{
+		{
+			name:               "Upload Attachment Success",
+			transactionId:      "1",
+			content:            pngContent,
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+		},
+		{
+			name:               "Unsupported Content Type",
+			transactionId:      "1",
+			content:            []byte("plain text is not a receipt"),
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name:               "Transaction Not Found",
+			transactionId:      "wrong_id",
+			content:            pngContent,
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = attachmentRepository.DeleteAllAttachment(ctx)
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Lunch", UserID: "123"})
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			part, _ := writer.CreateFormFile("file", "receipt.png")
+			_, _ = part.Write(tt.content)
+			_ = writer.Close()
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("POST", "/dot-api/transaction/"+tt.transactionId+"/attachments", body)
+			request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+		})
+	}
+}
+
+func TestAttachmentDeletedWithTransaction(t *testing.T) {
+	_ = attachmentRepository.DeleteAllAttachment(ctx)
+	_ = transactionRepository.DeleteAllTransaction(ctx)
+	_ = userRepository.DeleteAllUser(ctx)
+
+	_, _ = userRepository.InsertUser(ctx, entity.User{UserID: "123", Username: "username_test", Email: "email_test@gmail.com", Handphone: "08123456789"})
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Lunch", UserID: "123"})
+	_, err := attachmentRepository.InsertAttachment(ctx, entity.Attachment{AttachmentID: "1", TransactionID: "1", FileName: "receipt.png", ContentType: "image/png", Size: 1, StorageKey: "transactions/1/1"})
+	assert.Nil(t, err)
+
+	err = transactionRepository.DeleteTransaction(ctx, "1")
+	assert.Nil(t, err)
+
+	attachments, err := attachmentRepository.FindAttachmentByTransactionId(ctx, "1")
+	assert.Nil(t, err)
+	assert.Empty(t, attachments)
+}
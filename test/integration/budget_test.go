@@ -0,0 +1,160 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCreateBudget(t *testing.T) {
+	tests := []struct {
+		name               string
+		payload            web.BudgetCreateRequest
+		existing           bool
+		codeExpected       int
+		statusCodeExpected string
+	}{
+		{
+			name:               "Create Budget Success",
+			payload:            web.BudgetCreateRequest{CategoryID: "100", Amount: 10000, Timezone: "Asia/Jakarta"},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+		},
+		{
+			name:               "Budget Already Exists",
+			payload:            web.BudgetCreateRequest{CategoryID: "100", Amount: 10000},
+			existing:           true,
+			codeExpected:       http.StatusConflict,
+			statusCodeExpected: web.CONFLICT,
+		},
+		{
+			name:               "Category Not Found",
+			payload:            web.BudgetCreateRequest{CategoryID: "wrong_id", Amount: 10000},
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+		},
+		{
+			name:               "Invalid Currency",
+			payload:            web.BudgetCreateRequest{CategoryID: "100", Amount: 10000, Currency: "Rupiah"},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name:               "Invalid Timezone",
+			payload:            web.BudgetCreateRequest{CategoryID: "100", Amount: 10000, Timezone: "Mars/Olympus"},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = budgetRepository.DeleteAllBudget(ctx)
+			_ = categoryRepository.DeleteAllCategory(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: "100", UserID: "123", Name: "Bills"})
+			if tt.existing {
+				_, _ = budgetRepository.InsertBudget(ctx, entity.Budget{BudgetID: "1", UserID: "123", CategoryID: "100", Amount: 5000, Timezone: "UTC"})
+			}
+
+			requestBody, _ := json.Marshal(tt.payload)
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("POST", "/dot-api/budget", bytes.NewBuffer(requestBody))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+		})
+	}
+}
+
+func TestGetBudgetSpending(t *testing.T) {
+	_ = transactionRepository.DeleteAllTransaction(ctx)
+	_ = budgetRepository.DeleteAllBudget(ctx)
+	_ = categoryRepository.DeleteAllCategory(ctx)
+	_ = userRepository.DeleteAllUser(ctx)
+	_ = authRepository.FlushAll(ctx)
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+	dataDB := entity.User{
+		UserID:    "123",
+		Username:  "username_test",
+		Email:     "email_test@gmail.com",
+		Handphone: "08123456789",
+		Password:  string(password),
+	}
+
+	_, _ = userRepository.InsertUser(ctx, dataDB)
+	parentId := "100"
+	childId := "101"
+	_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: parentId, UserID: "123", Name: "Bills"})
+	_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: childId, UserID: "123", ParentID: &parentId, Name: "Internet"})
+	_, _ = budgetRepository.InsertBudget(ctx, entity.Budget{BudgetID: "1", UserID: "123", CategoryID: parentId, Amount: 10000, Timezone: "Asia/Jakarta"})
+
+	// 2023-03-31 20:00 UTC is already April in Jakarta.
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Electricity", Amount: 6000, UserID: "123", CategoryID: &parentId, CreatedAt: time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)})
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "Fiber", Amount: 3000, UserID: "123", CategoryID: &childId, CreatedAt: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC)})
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "3", Name: "Fiber", Amount: 3000, UserID: "123", CategoryID: &childId, CreatedAt: time.Date(2023, 3, 31, 20, 0, 0, 0, time.UTC)})
+	// Spending in another currency does not count towards an IDR budget.
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "4", Name: "Roaming", Amount: 20, Currency: "USD", UserID: "123", CategoryID: &childId, CreatedAt: time.Date(2023, 3, 20, 0, 0, 0, 0, time.UTC)})
+
+	accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+	request := httptest.NewRequest("GET", "/dot-api/budget/1?period=2023-03", nil)
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+
+	recorder := httptest.NewRecorder()
+
+	app.ServeHTTP(recorder, request)
+	response := recorder.Result()
+
+	responseBody, _ := io.ReadAll(response.Body)
+	webResponse := web.WebResponse{}
+	json.Unmarshal(responseBody, &webResponse)
+	assert.Equal(t, http.StatusOK, webResponse.Code)
+	assert.Equal(t, web.OK, webResponse.Status)
+
+	var budget web.BudgetResponse
+	jsonData, _ := json.Marshal(webResponse.Data)
+	json.Unmarshal(jsonData, &budget)
+	assert.Equal(t, "2023-03", budget.Period)
+	assert.Equal(t, "IDR", budget.Currency)
+	assert.Equal(t, int64(9000), budget.Spent)
+	assert.Equal(t, int64(1000), budget.Remaining)
+	assert.Equal(t, float64(90), budget.PercentUsed)
+}
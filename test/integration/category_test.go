@@ -0,0 +1,212 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCreateCategory(t *testing.T) {
+	tests := []struct {
+		name               string
+		payload            web.CategoryCreateRequest
+		codeExpected       int
+		statusCodeExpected string
+		wantUnauthorized   bool
+	}{
+		{
+			name: "Create Category Success",
+			payload: web.CategoryCreateRequest{
+				Name: "Bills",
+			},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			wantUnauthorized:   false,
+		},
+		{
+			name: "Parent Not Found",
+			payload: web.CategoryCreateRequest{
+				ParentID: "wrong_id",
+				Name:     "Internet",
+			},
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+			wantUnauthorized:   false,
+		},
+		{
+			name: "Blank Field",
+			payload: web.CategoryCreateRequest{
+				Name: "",
+			},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+			wantUnauthorized:   false,
+		},
+		{
+			name: "Unauthorized",
+			payload: web.CategoryCreateRequest{
+				Name: "Bills",
+			},
+			codeExpected:       http.StatusUnauthorized,
+			statusCodeExpected: web.UNAUTHORIZATION,
+			wantUnauthorized:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = categoryRepository.DeleteAllCategory(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+
+			requestBody, _ := json.Marshal(tt.payload)
+
+			var accessToken string
+			if !tt.wantUnauthorized {
+				accessToken = getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+			}
+
+			request := httptest.NewRequest("POST", "/dot-api/category", bytes.NewBuffer(requestBody))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+		})
+	}
+}
+
+func TestGetTransactionByCategoryAndTag(t *testing.T) {
+	parentId := "100"
+	tests := []struct {
+		name               string
+		query              string
+		codeExpected       int
+		statusCodeExpected string
+		countExpected      int
+	}{
+		{
+			name:               "Filter By Parent Category",
+			query:              "&category_id=" + parentId,
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			countExpected:      2,
+		},
+		{
+			name:               "Filter By Child Category",
+			query:              "&category_id=101",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			countExpected:      1,
+		},
+		{
+			name:               "Filter By Tag",
+			query:              "&tag=subscription",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			countExpected:      1,
+		},
+		{
+			name:               "Category Not Found",
+			query:              "&category_id=wrong_id",
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+			countExpected:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = categoryRepository.DeleteAllCategory(ctx)
+			_ = tagRepository.DeleteAllTag(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+
+			_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: parentId, UserID: "123", Name: "Bills"})
+			_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: "101", UserID: "123", ParentID: &parentId, Name: "Internet"})
+			childId := "101"
+
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{
+				TransactionID: "1",
+				Name:          "Electricity",
+				UserID:        "123",
+				CategoryID:    &parentId,
+			})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{
+				TransactionID: "2",
+				Name:          "Fiber",
+				UserID:        "123",
+				CategoryID:    &childId,
+				Tags:          []entity.Tag{{TagID: "1", UserID: "123", Name: "subscription"}},
+			})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{
+				TransactionID: "3",
+				Name:          "Lunch",
+				UserID:        "123",
+			})
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("GET", "/dot-api/transaction/user?user_id="+dataDB.UserID+tt.query, nil)
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+
+			var transactions []web.TransactionResponse
+			jsonData, _ := json.Marshal(webResponse.Data)
+			json.Unmarshal(jsonData, &transactions)
+			assert.Len(t, transactions, tt.countExpected)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCommentThread(t *testing.T) {
+	_ = commentRepository.DeleteAllComment(ctx)
+	_ = transactionRepository.DeleteAllTransaction(ctx)
+	_ = userRepository.DeleteAllUser(ctx)
+	_ = authRepository.FlushAll(ctx)
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+	owner := entity.User{
+		UserID:    "123",
+		Username:  "username_test",
+		Email:     "email_test@gmail.com",
+		Handphone: "08123456789",
+		Password:  string(password),
+	}
+	stranger := entity.User{
+		UserID:    "124",
+		Username:  "stranger_test",
+		Email:     "stranger_test@gmail.com",
+		Handphone: "08123456780",
+		Password:  string(password),
+	}
+	admin := entity.User{
+		UserID:    "125",
+		Username:  "admin_test",
+		Email:     "admin_test@gmail.com",
+		Handphone: "08123456781",
+		Password:  string(password),
+		Role:      entity.UserRoleAdmin,
+	}
+	_, _ = userRepository.InsertUser(ctx, owner)
+	_, _ = userRepository.InsertUser(ctx, stranger)
+	_, _ = userRepository.InsertUser(ctx, admin)
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{
+		TransactionID: "456",
+		Name:          "Dinner",
+		Amount:        10000,
+		UserID:        owner.UserID,
+		CreatedAt:     time.Now(),
+	})
+
+	ownerToken := getAuthorization(web.LoginRequest{Username: owner.Username, Password: "password"})
+	strangerToken := getAuthorization(web.LoginRequest{Username: stranger.Username, Password: "password"})
+	adminToken := getAuthorization(web.LoginRequest{Username: admin.Username, Password: "password"})
+
+	send := func(method string, target string, token string, body interface{}) (int, []byte) {
+		var requestBody io.Reader
+		if body != nil {
+			payload, _ := json.Marshal(body)
+			requestBody = bytes.NewBuffer(payload)
+		}
+		request := httptest.NewRequest(method, target, requestBody)
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		request.Header.Set("Authorization", "Bearer "+token)
+
+		recorder := httptest.NewRecorder()
+		app.ServeHTTP(recorder, request)
+		response := recorder.Result()
+
+		responseBody, _ := io.ReadAll(response.Body)
+		return response.StatusCode, responseBody
+	}
+	commentResponse := func(responseBody []byte) web.CommentResponse {
+		webResponse := struct {
+			Data web.CommentResponse `json:"data"`
+		}{}
+		json.Unmarshal(responseBody, &webResponse)
+		return webResponse.Data
+	}
+
+	code, _ := send("POST", "/dot-api/transaction/456/comments", strangerToken, map[string]string{"body": "Who paid?"})
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = send("POST", "/dot-api/transaction/456/comments", ownerToken, map[string]string{"body": "   "})
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, responseBody := send("POST", "/dot-api/transaction/456/comments", ownerToken, map[string]string{"body": "Paid by card"})
+	assert.Equal(t, http.StatusCreated, code)
+	created := commentResponse(responseBody)
+	assert.Equal(t, "Paid by card", created.Body)
+	assert.Equal(t, owner.Username, created.Author.Username)
+	target := "/dot-api/transaction/456/comments/" + created.CommentID
+
+	code, _ = send("PUT", target, adminToken, map[string]string{"body": "Paid in cash"})
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, responseBody = send("PUT", target, ownerToken, map[string]string{"body": "Paid in cash"})
+	assert.Equal(t, http.StatusOK, code)
+	updated := commentResponse(responseBody)
+	assert.True(t, updated.Edited)
+	if assert.Len(t, updated.History, 1) {
+		assert.Equal(t, "Paid by card", updated.History[0].Body)
+	}
+
+	code, _ = send("DELETE", target, adminToken, nil)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, responseBody = send("GET", "/dot-api/transaction/456/comments", ownerToken, nil)
+	assert.Equal(t, http.StatusOK, code)
+	listResponse := struct {
+		Data []web.CommentResponse `json:"data"`
+	}{}
+	json.Unmarshal(responseBody, &listResponse)
+	if assert.Len(t, listResponse.Data, 1) {
+		assert.True(t, listResponse.Data[0].Deleted)
+		assert.Empty(t, listResponse.Data[0].Body)
+		assert.Empty(t, listResponse.Data[0].History)
+	}
+
+	code, _ = send("PUT", target, ownerToken, map[string]string{"body": "Paid twice"})
+	assert.Equal(t, http.StatusNotFound, code)
+
+	// Comments outlive their author and show the author as deleted.
+	code, _ = send("POST", "/dot-api/transaction/456/comments", adminToken, map[string]string{"body": "Refund approved"})
+	assert.Equal(t, http.StatusCreated, code)
+	err := userRepository.DeleteUser(ctx, databases, admin.UserID)
+	assert.Nil(t, err)
+
+	code, responseBody = send("GET", "/dot-api/transaction/456/comments", ownerToken, nil)
+	assert.Equal(t, http.StatusOK, code)
+	listResponse.Data = nil
+	json.Unmarshal(responseBody, &listResponse)
+	if assert.Len(t, listResponse.Data, 2) {
+		assert.Equal(t, "Refund approved", listResponse.Data[1].Body)
+		assert.Equal(t, web.CommentAuthorResponse{Deleted: true}, listResponse.Data[1].Author)
+	}
+}
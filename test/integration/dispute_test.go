@@ -0,0 +1,140 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/dispute"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestDisputeWorkflow(t *testing.T) {
+	_ = disputeRepository.DeleteAllDispute(ctx)
+	_ = attachmentRepository.DeleteAllAttachment(ctx)
+	_ = transactionRepository.DeleteAllTransaction(ctx)
+	_ = userRepository.DeleteAllUser(ctx)
+	_ = authRepository.FlushAll(ctx)
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+	owner := entity.User{
+		UserID:    "123",
+		Username:  "username_test",
+		Email:     "email_test@gmail.com",
+		Handphone: "08123456789",
+		Password:  string(password),
+	}
+	admin := entity.User{
+		UserID:    "125",
+		Username:  "admin_test",
+		Email:     "admin_test@gmail.com",
+		Handphone: "08123456781",
+		Password:  string(password),
+		Role:      entity.UserRoleAdmin,
+	}
+	_, _ = userRepository.InsertUser(ctx, owner)
+	_, _ = userRepository.InsertUser(ctx, admin)
+	_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{
+		TransactionID: "456",
+		Name:          "Dinner",
+		Amount:        10000,
+		UserID:        owner.UserID,
+		CreatedAt:     time.Now(),
+	})
+	_, _ = attachmentRepository.InsertAttachment(ctx, entity.Attachment{
+		AttachmentID:  "789",
+		TransactionID: "456",
+		FileName:      "receipt.pdf",
+		ContentType:   "application/pdf",
+		Size:          1024,
+		StorageKey:    "456/789",
+		CreatedAt:     time.Now(),
+	})
+
+	ownerToken := getAuthorization(web.LoginRequest{Username: owner.Username, Password: "password"})
+	adminToken := getAuthorization(web.LoginRequest{Username: admin.Username, Password: "password"})
+
+	send := func(method string, target string, token string, body interface{}) (int, []byte) {
+		var requestBody io.Reader
+		if body != nil {
+			payload, _ := json.Marshal(body)
+			requestBody = bytes.NewBuffer(payload)
+		}
+		request := httptest.NewRequest(method, target, requestBody)
+		request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		request.Header.Set("Authorization", "Bearer "+token)
+
+		recorder := httptest.NewRecorder()
+		app.ServeHTTP(recorder, request)
+		response := recorder.Result()
+
+		responseBody, _ := io.ReadAll(response.Body)
+		return response.StatusCode, responseBody
+	}
+	disputeResponse := func(responseBody []byte) web.DisputeResponse {
+		webResponse := struct {
+			Data web.DisputeResponse `json:"data"`
+		}{}
+		json.Unmarshal(responseBody, &webResponse)
+		return webResponse.Data
+	}
+
+	code, responseBody := send("POST", "/dot-api/transaction/456/disputes", ownerToken, web.DisputeCreateRequest{
+		ReasonCode:  entity.DisputeReasonDuplicate,
+		Description: "Charged twice",
+		EvidenceIDs: []string{"789"},
+	})
+	assert.Equal(t, http.StatusCreated, code)
+	opened := disputeResponse(responseBody)
+	assert.Equal(t, entity.DisputeStatusOpen, opened.Status)
+	assert.Len(t, opened.Evidence, 1)
+
+	code, _ = send("POST", "/dot-api/transaction/456/disputes", ownerToken, web.DisputeCreateRequest{ReasonCode: entity.DisputeReasonFraudulent})
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = send("DELETE", "/dot-api/transaction/456", ownerToken, nil)
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = send("DELETE", "/dot-api/transaction/456/attachments/789", ownerToken, nil)
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = send("DELETE", "/dot-api/user/"+owner.UserID, ownerToken, nil)
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = send("POST", "/dot-api/admin/disputes/"+opened.DisputeID+"/review", ownerToken, nil)
+	assert.Equal(t, http.StatusForbidden, code)
+
+	code, responseBody = send("POST", "/dot-api/admin/disputes/"+opened.DisputeID+"/review", adminToken, nil)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, entity.DisputeStatusUnderReview, disputeResponse(responseBody).Status)
+
+	// A change based on a stale read of the dispute is refused.
+	stale, _ := disputeRepository.FindDisputeByID(ctx, opened.DisputeID)
+	stale.Status = entity.DisputeStatusLost
+	_, err := disputeRepository.UpdateDispute(ctx, stale, entity.DisputeStatusOpen)
+	assert.ErrorIs(t, err, dispute.ErrDisputeChanged)
+
+	code, responseBody = send("POST", "/dot-api/admin/disputes/"+opened.DisputeID+"/resolve", adminToken, web.DisputeResolveRequest{
+		Outcome:        entity.DisputeStatusWon,
+		ResolutionNote: "Refunded by the merchant",
+	})
+	assert.Equal(t, http.StatusOK, code)
+	resolved := disputeResponse(responseBody)
+	assert.Equal(t, entity.DisputeStatusWon, resolved.Status)
+	assert.NotNil(t, resolved.ResolvedAt)
+
+	code, _ = send("POST", "/dot-api/admin/disputes/"+opened.DisputeID+"/resolve", adminToken, web.DisputeResolveRequest{Outcome: entity.DisputeStatusLost})
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = send("DELETE", "/dot-api/transaction/456", ownerToken, nil)
+	assert.Equal(t, http.StatusOK, code)
+}
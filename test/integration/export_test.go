@@ -0,0 +1,85 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestExportTransaction(t *testing.T) {
+	tests := []struct {
+		name                string
+		query               string
+		codeExpected        int
+		contentTypeExpected string
+		bodyExpected        string
+	}{
+		{
+			name:                "Export CSV With Columns",
+			query:               "format=csv&columns=transaction_id,name,amount",
+			codeExpected:        http.StatusOK,
+			contentTypeExpected: "text/csv",
+			bodyExpected:        "transaction_id,name,amount\n1,Electricity,4000\n2,Fiber,3000\n",
+		},
+		{
+			name:                "Export Filtered By Tag",
+			query:               "user_id=123&tag=subscription&columns=transaction_id,tags",
+			codeExpected:        http.StatusOK,
+			contentTypeExpected: "text/csv",
+			bodyExpected:        "transaction_id,tags\n2,subscription\n",
+		},
+		{
+			name:         "Unknown Column",
+			query:        "columns=password",
+			codeExpected: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = tagRepository.DeleteAllTag(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Electricity", Amount: 4000, Currency: "IDR", UserID: "123", CreatedAt: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "Fiber", Amount: 3000, Currency: "IDR", UserID: "123", CreatedAt: time.Date(2023, 3, 2, 0, 0, 0, 0, time.UTC),
+				Tags: []entity.Tag{{TagID: "1", UserID: "123", Name: "subscription"}}})
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("GET", "/dot-api/export/transactions?"+tt.query, nil)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			assert.Equal(t, tt.codeExpected, response.StatusCode)
+			if tt.codeExpected == http.StatusOK {
+				responseBody, _ := io.ReadAll(response.Body)
+				assert.Equal(t, tt.contentTypeExpected, response.Header.Get("Content-Type"))
+				assert.Contains(t, response.Header.Get("Content-Disposition"), "attachment")
+				assert.Equal(t, tt.bodyExpected, string(responseBody))
+			}
+		})
+	}
+}
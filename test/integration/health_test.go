@@ -0,0 +1,60 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func TestHealth(t *testing.T) {
+	tests := []struct {
+		name                 string
+		path                 string
+		codeExpected         int
+		healthExpected       string
+		dependenciesExpected []string
+	}{
+		{
+			name:           "Liveness",
+			path:           "/healthz",
+			codeExpected:   http.StatusOK,
+			healthExpected: web.HealthStatusOK,
+		},
+		{
+			name:                 "Readiness",
+			path:                 "/readyz",
+			codeExpected:         http.StatusOK,
+			healthExpected:       web.HealthStatusOK,
+			dependenciesExpected: []string{"mysql", "redis"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			recorder := httptest.NewRecorder()
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			body, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			_ = json.Unmarshal(body, &webResponse)
+			jsonData, _ := json.Marshal(webResponse.Data)
+			healthResponse := web.HealthResponse{}
+			_ = json.Unmarshal(jsonData, &healthResponse)
+
+			assert.Equal(t, tt.codeExpected, response.StatusCode)
+			assert.Equal(t, tt.healthExpected, healthResponse.Status)
+			var dependencies []string
+			for _, dependency := range healthResponse.Dependencies {
+				dependencies = append(dependencies, dependency.Name)
+				assert.Equal(t, web.DependencyStatusUp, dependency.Status)
+			}
+			assert.Equal(t, tt.dependenciesExpected, dependencies)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestImportTransaction(t *testing.T) {
+	statement := "Tanggal,Keterangan,Jumlah\n10/03/2023,Indomaret,-25000\n11/03/2023,Parkir,-5000\n"
+	tests := []struct {
+		name               string
+		fields             map[string]string
+		importTwice        bool
+		codeExpected       int
+		statusCodeExpected string
+		importedExpected   int
+		duplicatesExpected int
+		savedExpected      int
+	}{
+		{
+			name: "Import CSV With Mapping",
+			fields: map[string]string{
+				"mapping":     `{"name":"Keterangan","amount":"Jumlah","date":"Tanggal"}`,
+				"date_format": "02/01/2006",
+			},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			importedExpected:   2,
+			savedExpected:      2,
+		},
+		{
+			name: "Dry Run",
+			fields: map[string]string{
+				"mapping":     `{"name":"Keterangan","amount":"Jumlah","date":"Tanggal"}`,
+				"date_format": "02/01/2006",
+				"dry_run":     "true",
+			},
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			savedExpected:      0,
+		},
+		{
+			name: "Import Twice",
+			fields: map[string]string{
+				"mapping":     `{"name":"Keterangan","amount":"Jumlah","date":"Tanggal"}`,
+				"date_format": "02/01/2006",
+			},
+			importTwice:        true,
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			duplicatesExpected: 2,
+			savedExpected:      2,
+		},
+		{
+			name:               "Missing Columns",
+			fields:             map[string]string{},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			upload := func() web.WebResponse {
+				body := new(bytes.Buffer)
+				writer := multipart.NewWriter(body)
+				part, _ := writer.CreateFormFile("file", "statement.csv")
+				_, _ = part.Write([]byte(statement))
+				for key, value := range tt.fields {
+					_ = writer.WriteField(key, value)
+				}
+				_ = writer.Close()
+
+				request := httptest.NewRequest("POST", "/dot-api/import/transactions", body)
+				request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+				request.Header.Set("Authorization", "Bearer "+accessToken)
+
+				recorder := httptest.NewRecorder()
+
+				app.ServeHTTP(recorder, request)
+				response := recorder.Result()
+
+				responseBody, _ := io.ReadAll(response.Body)
+				webResponse := web.WebResponse{}
+				json.Unmarshal(responseBody, &webResponse)
+				return webResponse
+			}
+
+			if tt.importTwice {
+				upload()
+			}
+			webResponse := upload()
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+
+			var result web.ImportResponse
+			jsonData, _ := json.Marshal(webResponse.Data)
+			json.Unmarshal(jsonData, &result)
+			assert.Equal(t, tt.importedExpected, result.Imported)
+			assert.Equal(t, tt.duplicatesExpected, result.Duplicates)
+
+			transactions, _ := transactionRepository.FindAllTransaction(ctx)
+			assert.Len(t, transactions, tt.savedExpected)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCreateRecurring(t *testing.T) {
+	tests := []struct {
+		name               string
+		payload            web.RecurringCreateRequest
+		codeExpected       int
+		statusCodeExpected string
+	}{
+		{
+			name: "Create Recurring Success",
+			payload: web.RecurringCreateRequest{
+				Name:     "Rent",
+				Amount:   500000000,
+				Currency: "IDR",
+				RRule:    "FREQ=MONTHLY;BYMONTHDAY=1",
+				Timezone: "Asia/Jakarta",
+				StartAt:  time.Now(),
+			},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+		},
+		{
+			name: "Invalid RRule",
+			payload: web.RecurringCreateRequest{
+				Name:     "Rent",
+				RRule:    "FREQ=SOMETIMES",
+				Timezone: "Asia/Jakarta",
+				StartAt:  time.Now(),
+			},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name: "Invalid Timezone",
+			payload: web.RecurringCreateRequest{
+				Name:     "Rent",
+				RRule:    "FREQ=DAILY",
+				Timezone: "Mars/Olympus",
+				StartAt:  time.Now(),
+			},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = recurringRepository.DeleteAllRecurring(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+
+			requestBody, _ := json.Marshal(tt.payload)
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("POST", "/dot-api/recurring", bytes.NewBuffer(requestBody))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+		})
+	}
+}
+
+func TestRecurringLeaderLock(t *testing.T) {
+	_ = authRepository.FlushAll(ctx)
+	key := "scheduler:recurring:leader"
+
+	acquired, err := lockRepository.AcquireLock(ctx, key, "replica-a", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = lockRepository.AcquireLock(ctx, key, "replica-b", time.Minute)
+	assert.Nil(t, err)
+	assert.False(t, acquired)
+
+	acquired, err = lockRepository.AcquireLock(ctx, key, "replica-a", time.Minute)
+	assert.Nil(t, err)
+	assert.True(t, acquired)
+
+	err = lockRepository.ReleaseLock(ctx, key, "replica-b")
+	assert.Nil(t, err)
+	acquired, _ = lockRepository.AcquireLock(ctx, key, "replica-b", time.Minute)
+	assert.False(t, acquired)
+
+	err = lockRepository.ReleaseLock(ctx, key, "replica-a")
+	assert.Nil(t, err)
+	acquired, _ = lockRepository.AcquireLock(ctx, key, "replica-b", time.Minute)
+	assert.True(t, acquired)
+}
+
+func TestFindDueRecurringSkipsRetries(t *testing.T) {
+	_ = recurringRepository.DeleteAllRecurring(ctx)
+	_ = userRepository.DeleteAllUser(ctx)
+	_, _ = userRepository.InsertUser(ctx, entity.User{UserID: "123", Username: "username_test", Email: "email_test@gmail.com", Handphone: "08123456789"})
+
+	now := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	overdue := now.AddDate(0, 0, -1)
+	due := now.Add(-time.Hour)
+	retryAt := now.Add(5 * time.Minute)
+	_, _ = recurringRepository.InsertRecurring(ctx, entity.RecurringTransaction{RecurringID: "455", UserID: "123", Name: "Gym", RRule: "FREQ=DAILY", Timezone: "UTC", StartAt: overdue, NextRunAt: &overdue})
+	_, _ = recurringRepository.InsertRecurring(ctx, entity.RecurringTransaction{RecurringID: "456", UserID: "123", Name: "Coffee", RRule: "FREQ=DAILY", Timezone: "UTC", StartAt: due, NextRunAt: &due})
+
+	err := recurringRepository.UpdateRecurringFailure(ctx, entity.RecurringTransaction{RecurringID: "455", NextRunAt: &overdue, Failures: 1, LastError: "CATEGORY_NOT_FOUND", RetryAt: &retryAt})
+	assert.Nil(t, err)
+
+	// The most overdue schedule waits for its retry and does not hold back the other.
+	recurrings, err := recurringRepository.FindDueRecurring(ctx, now, 1)
+	assert.Nil(t, err)
+	if assert.Len(t, recurrings, 1) {
+		assert.Equal(t, "456", recurrings[0].RecurringID)
+	}
+
+	recurrings, _ = recurringRepository.FindDueRecurring(ctx, retryAt, 1)
+	if assert.Len(t, recurrings, 1) {
+		assert.Equal(t, "455", recurrings[0].RecurringID)
+		assert.Equal(t, 1, recurrings[0].Failures)
+	}
+
+	err = recurringRepository.UpdateRecurringNextRun(ctx, "455", &now)
+	assert.Nil(t, err)
+	recurring, _ := recurringRepository.FindRecurringByID(ctx, "455")
+	assert.Equal(t, 0, recurring.Failures)
+	assert.Nil(t, recurring.RetryAt)
+}
@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestGetReport(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		codeExpected       int
+		statusCodeExpected string
+		rowsExpected       []web.ReportRowResponse
+	}{
+		{
+			name:               "Group By Day In Timezone",
+			query:              "from=2023-03-01&to=2023-03-31&timezone=Asia/Jakarta&group_by=day",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			rowsExpected: []web.ReportRowResponse{
+				{Period: "2023-03-10", Sum: 6000, Count: 2, Average: 3000, Min: 2000, Max: 4000},
+			},
+		},
+		{
+			name:               "Group By Category And Currency",
+			query:              "from=2023-03-01&to=2023-04-30&group_by=category,currency",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			rowsExpected: []web.ReportRowResponse{
+				{Currency: "USD", Sum: 15, Count: 1, Average: 15, Min: 15, Max: 15},
+				{CategoryID: "100", Currency: "IDR", Sum: 6000, Count: 2, Average: 3000, Min: 2000, Max: 4000},
+			},
+		},
+		{
+			name:               "Convert Into Reporting Currency",
+			query:              "from=2023-03-01&to=2023-04-30&timezone=Asia/Jakarta&currency=USD",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			rowsExpected: []web.ReportRowResponse{
+				{Sum: 30, Count: 3, Average: 10, Min: 5, Max: 15},
+			},
+		},
+		{
+			name:               "Missing Exchange Rate",
+			query:              "from=2023-03-01&to=2023-04-30&currency=JPY",
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name:               "Invalid Group",
+			query:              "from=2023-03-01&to=2023-03-31&group_by=day,month",
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name:               "Missing Range",
+			query:              "group_by=day",
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = categoryRepository.DeleteAllCategory(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			categoryId := "100"
+			_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: categoryId, UserID: "123", Name: "Bills"})
+
+			// 2023-03-09 20:00 UTC is 2023-03-10 in Jakarta.
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Electricity", Amount: 4000, Currency: "IDR", UserID: "123", CategoryID: &categoryId, CreatedAt: time.Date(2023, 3, 9, 20, 0, 0, 0, time.UTC)})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "Water", Amount: 2000, Currency: "IDR", UserID: "123", CategoryID: &categoryId, CreatedAt: time.Date(2023, 3, 10, 3, 0, 0, 0, time.UTC)})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "3", Name: "Book", Amount: 15, Currency: "USD", UserID: "123", CreatedAt: time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC)})
+			_ = exchangeRateRepository.UpsertExchangeRates(ctx, []entity.ExchangeRate{{Date: time.Date(2023, 3, 9, 0, 0, 0, 0, time.UTC), BaseCurrency: "USD", QuoteCurrency: "IDR", Rate: "400"}})
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("GET", "/dot-api/reports?"+tt.query, nil)
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+
+			if tt.rowsExpected != nil {
+				var report web.ReportResponse
+				jsonData, _ := json.Marshal(webResponse.Data)
+				json.Unmarshal(jsonData, &report)
+				assert.Equal(t, tt.rowsExpected, report.Rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name                 string
+		query                string
+		codeExpected         int
+		statusCodeExpected   string
+		transactionsExpected []string
+		usersExpected        int
+	}{
+		{
+			name:                 "Search Transactions By Prefix",
+			query:                "q=coff&type=transaction",
+			codeExpected:         http.StatusOK,
+			statusCodeExpected:   web.OK,
+			transactionsExpected: []string{"1", "2"},
+		},
+		{
+			name:               "Search Users By Email",
+			query:              "q=email_test&type=user",
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			usersExpected:      1,
+		},
+		{
+			name:               "Empty Query",
+			query:              "q=",
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			_, _ = userRepository.InsertUser(ctx, entity.User{UserID: "124", Username: "other_user", Email: "other@gmail.com", Password: string(password)})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Coffee beans and coffee filters", Amount: 10000, UserID: "123"})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "Coffeeshop", Amount: 10000, UserID: "123"})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "3", Name: "Coffee", Amount: 10000, UserID: "124"})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "4", Name: "Groceries", Amount: 10000, UserID: "123"})
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("GET", "/dot-api/search?"+tt.query, nil)
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+			if tt.codeExpected != http.StatusOK {
+				return
+			}
+
+			var search web.SearchResponse
+			jsonData, _ := json.Marshal(webResponse.Data)
+			json.Unmarshal(jsonData, &search)
+			var transactionIds []string
+			for _, hit := range search.Transactions {
+				transactionIds = append(transactionIds, hit.ID)
+				assert.Contains(t, hit.Highlights["name"], "<mark>")
+			}
+			assert.Equal(t, tt.transactionsExpected, transactionIds)
+			assert.Equal(t, tt.usersExpected, len(search.Users))
+		})
+	}
+}
@@ -5,33 +5,48 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"log"
 	"net/http/httptest"
+	"os"
+	"testing"
 
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
-	"github.com/vnnyx/golang-dot-api/exception"
-	"github.com/vnnyx/golang-dot-api/infrastructure"
 	"github.com/vnnyx/golang-dot-api/injector/wire"
 	"github.com/vnnyx/golang-dot-api/migration"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/attachment"
 	"github.com/vnnyx/golang-dot-api/repository/auth"
+	"github.com/vnnyx/golang-dot-api/repository/budget"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/comment"
+	"github.com/vnnyx/golang-dot-api/repository/dispute"
+	"github.com/vnnyx/golang-dot-api/repository/exchange"
+	"github.com/vnnyx/golang-dot-api/repository/lock"
+	"github.com/vnnyx/golang-dot-api/repository/recurring"
+	"github.com/vnnyx/golang-dot-api/repository/tag"
 	"github.com/vnnyx/golang-dot-api/repository/transaction"
 	"github.com/vnnyx/golang-dot-api/repository/user"
 )
 
 var (
-	configuration         = infrastructure.NewConfig(".env.test")
-	databases             = infrastructure.NewMySQLDatabase(configuration)
-	redis                 = infrastructure.NewRedisClient(".env.test")
-	userController        = wire.InitializeUserController(".env.test")
-	transactionController = wire.InitializeTransactionController(".env.test")
-	authController        = wire.InitializeAuthController(".env.test")
-	app                   = testApp()
-	userRepository        = user.NewUserRepository(databases)
-	transactionRepository = transaction.NewTransactionRepository(databases)
-	authRepository        = auth.NewAuthRepository(redis)
-	ctx                   = context.TODO()
+	application            = wire.InitializeApplication(".env.test")
+	databases              = application.DB
+	redis                  = application.Redis
+	app                    = testApp()
+	userRepository         = user.NewUserRepository(databases)
+	transactionRepository  = transaction.NewTransactionRepository(databases)
+	authRepository         = auth.NewAuthRepository(redis)
+	categoryRepository     = category.NewCategoryRepository(databases)
+	tagRepository          = tag.NewTagRepository(databases)
+	recurringRepository    = recurring.NewRecurringRepository(databases)
+	lockRepository         = lock.NewLockRepository(redis)
+	attachmentRepository   = attachment.NewAttachmentRepository(databases)
+	commentRepository      = comment.NewCommentRepository(databases)
+	disputeRepository      = dispute.NewDisputeRepository(databases)
+	budgetRepository       = budget.NewBudgetRepository(databases)
+	exchangeRateRepository = exchange.NewExchangeRateRepository(databases)
+	ctx                    = context.TODO()
 )
 
 func getAuthorization(payload web.LoginRequest) string {
@@ -58,13 +73,20 @@ func getAuthorization(payload web.LoginRequest) string {
 }
 
 func testApp() *echo.Echo {
-	migration.Migrate(databases, entity.Transaction{}, entity.User{})
-	var app = echo.New()
-	app.Use(middleware.RecoverWithConfig(middleware.RecoverConfig{DisablePrintStack: true}))
-	app.Use(middleware.CORS())
-	app.HTTPErrorHandler = exception.ErrorHandler
-	userController.Route(app)
-	transactionController.Route(app)
-	authController.Route(app)
-	return app
+	migration.Migrate(databases, entity.Transaction{}, entity.User{}, entity.Category{}, entity.CategoryRule{}, entity.Tag{}, entity.RecurringTransaction{}, entity.Attachment{}, entity.TransactionSplit{}, entity.Budget{}, entity.BudgetAlert{}, entity.ExchangeRate{}, entity.Comment{}, entity.CommentRevision{}, entity.Dispute{}, entity.DisputeEvidence{})
+	migration.MigrateCommentAuthor(databases)
+	return application.Echo
+}
+
+// TestMain runs the tests between the start and the stop of the application,
+// without the recurring scheduler, which the tests drive themselves.
+func TestMain(m *testing.M) {
+	if err := application.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
+	code := m.Run()
+	if err := application.Stop(ctx); err != nil {
+		log.Print(err)
+	}
+	os.Exit(code)
 }
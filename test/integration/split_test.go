@@ -0,0 +1,122 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCreateSplitTransaction(t *testing.T) {
+	tests := []struct {
+		name               string
+		split              *web.TransactionSplitRequest
+		codeExpected       int
+		statusCodeExpected string
+		shareExpected      int64
+	}{
+		{
+			name: "Split Equally",
+			split: &web.TransactionSplitRequest{
+				Method:       entity.SplitEqual,
+				Participants: []web.TransactionSplitParticipant{{UserID: "123"}, {UserID: "124"}},
+			},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			shareExpected:      5000,
+		},
+		{
+			name: "Exact Amounts Do Not Add Up",
+			split: &web.TransactionSplitRequest{
+				Method:       entity.SplitExact,
+				Participants: []web.TransactionSplitParticipant{{UserID: "123", Amount: 2000}, {UserID: "124", Amount: 3000}},
+			},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+		},
+		{
+			name: "Participant Not Found",
+			split: &web.TransactionSplitRequest{
+				Method:       entity.SplitPercent,
+				Participants: []web.TransactionSplitParticipant{{UserID: "123", Percent: 50}, {UserID: "wrong_id", Percent: 50}},
+			},
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			owner := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+			participant := entity.User{
+				UserID:    "124",
+				Username:  "participant_test",
+				Email:     "participant_test@gmail.com",
+				Handphone: "08123456780",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, owner)
+			_, _ = userRepository.InsertUser(ctx, participant)
+
+			requestBody, _ := json.Marshal(web.TransactionCreateRequest{Name: "Dinner", Amount: 10000, Split: tt.split})
+			accessToken := getAuthorization(web.LoginRequest{Username: owner.Username, Password: "password"})
+
+			request := httptest.NewRequest("POST", "/dot-api/transaction?user_id="+owner.UserID, bytes.NewBuffer(requestBody))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+			if tt.codeExpected != http.StatusCreated {
+				return
+			}
+
+			request = httptest.NewRequest("GET", "/dot-api/transaction/user?user_id="+participant.UserID, nil)
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder = httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response = recorder.Result()
+
+			responseBody, _ = io.ReadAll(response.Body)
+			listResponse := struct {
+				Data []web.TransactionResponse `json:"data"`
+			}{}
+			json.Unmarshal(responseBody, &listResponse)
+			assert.Len(t, listResponse.Data, 1)
+			if len(listResponse.Data) == 1 {
+				assert.Equal(t, tt.shareExpected, *listResponse.Data[0].Share)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestGetMonthlyStatement(t *testing.T) {
+	tests := []struct {
+		name            string
+		period          string
+		codeExpected    int
+		contentExpected string
+	}{
+		{
+			name:            "Get Monthly Statement Success",
+			period:          "2023-03?timezone=Asia/Jakarta",
+			codeExpected:    http.StatusOK,
+			contentExpected: "application/pdf",
+		},
+		{
+			name:            "Statement With Converted Total",
+			period:          "2023-03?currency=USD",
+			codeExpected:    http.StatusOK,
+			contentExpected: "application/pdf",
+		},
+		{
+			name:            "Missing Exchange Rate",
+			period:          "2023-03?currency=JPY",
+			codeExpected:    http.StatusBadRequest,
+			contentExpected: echo.MIMEApplicationJSON,
+		},
+		{
+			name:            "Invalid Period",
+			period:          "march",
+			codeExpected:    http.StatusBadRequest,
+			contentExpected: echo.MIMEApplicationJSON,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = categoryRepository.DeleteAllCategory(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			categoryId := "100"
+			_, _ = categoryRepository.InsertCategory(ctx, entity.Category{CategoryID: categoryId, UserID: "123", Name: "Bills"})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "Electricity", Amount: 6000, Currency: "IDR", UserID: "123", CategoryID: &categoryId, CreatedAt: time.Date(2023, 2, 10, 0, 0, 0, 0, time.UTC)})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "Fiber", Amount: 3000, Currency: "IDR", UserID: "123", CategoryID: &categoryId, CreatedAt: time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC)})
+			_ = exchangeRateRepository.UpsertExchangeRates(ctx, []entity.ExchangeRate{{Date: time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC), BaseCurrency: "USD", QuoteCurrency: "IDR", Rate: "15000"}})
+
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest("GET", "/dot-api/statement/"+tt.period, nil)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			assert.Equal(t, tt.codeExpected, response.StatusCode)
+			assert.Contains(t, response.Header.Get(echo.HeaderContentType), tt.contentExpected)
+			if tt.codeExpected == http.StatusOK {
+				assert.True(t, bytes.HasPrefix(responseBody, []byte("%PDF-")))
+				assert.Contains(t, response.Header.Get(echo.HeaderContentDisposition), "statement-2023-03.pdf")
+				return
+			}
+
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, web.BAD_REQUEST, webResponse.Status)
+		})
+	}
+}
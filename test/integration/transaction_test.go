@@ -539,3 +539,114 @@ func TestDeleteTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionBatch(t *testing.T) {
+	tests := []struct {
+		name               string
+		method             string
+		payload            interface{}
+		codeExpected       int
+		statusCodeExpected string
+		countExpected      int
+	}{
+		{
+			name:   "Create Batch Atomically",
+			method: "POST",
+			payload: web.TransactionBatchCreateRequest{Items: []web.TransactionCreateRequest{
+				{Name: "product_test", Amount: 10000}, {Name: "product_test", Amount: 20000},
+			}},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			countExpected:      4,
+		},
+		{
+			name:   "Atomic Create Batch With An Invalid Item",
+			method: "POST",
+			payload: web.TransactionBatchCreateRequest{Items: []web.TransactionCreateRequest{
+				{Name: "product_test", Amount: 10000}, {Amount: 20000},
+			}},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+			countExpected:      2,
+		},
+		{
+			name:   "Best Effort Create Batch With An Invalid Item",
+			method: "POST",
+			payload: web.TransactionBatchCreateRequest{Mode: web.BatchModeBestEffort, Items: []web.TransactionCreateRequest{
+				{Name: "product_test", Amount: 10000}, {Amount: 20000},
+			}},
+			codeExpected:       http.StatusCreated,
+			statusCodeExpected: web.CREATED,
+			countExpected:      3,
+		},
+		{
+			name:   "Update Batch",
+			method: "PUT",
+			payload: web.TransactionBatchUpdateRequest{Items: []web.TransactionBatchUpdateItem{
+				{TransactionID: "1", TransactionUpdateRequest: web.TransactionUpdateRequest{Name: "product_update", Amount: 5000}},
+				{TransactionID: "2", TransactionUpdateRequest: web.TransactionUpdateRequest{Name: "product_update", Amount: 5000}},
+			}},
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			countExpected:      2,
+		},
+		{
+			name:               "Delete Batch",
+			method:             "DELETE",
+			payload:            web.TransactionBatchDeleteRequest{TransactionIDs: []string{"1", "2"}},
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			countExpected:      0,
+		},
+		{
+			name:               "Atomic Delete Batch With A Missing Transaction",
+			method:             "DELETE",
+			payload:            web.TransactionBatchDeleteRequest{TransactionIDs: []string{"1", "wrong_id"}},
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+			countExpected:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = transactionRepository.DeleteAllTransaction(ctx)
+			_ = userRepository.DeleteAllUser(ctx)
+			_ = authRepository.FlushAll(ctx)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_test@gmail.com",
+				Handphone: "08123456789",
+				Password:  string(password),
+			}
+
+			_, _ = userRepository.InsertUser(ctx, dataDB)
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "1", Name: "product_test", Amount: 10000, UserID: "123"})
+			_, _ = transactionRepository.InsertTransaction(ctx, entity.Transaction{TransactionID: "2", Name: "product_test", Amount: 20000, UserID: "123"})
+
+			requestBody, _ := json.Marshal(tt.payload)
+			accessToken := getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+
+			request := httptest.NewRequest(tt.method, "/dot-api/transaction/batch?user_id="+dataDB.UserID, bytes.NewBuffer(requestBody))
+			request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+
+			transactions, _ := transactionRepository.FindAllTransaction(ctx)
+			assert.Equal(t, tt.countExpected, len(transactions))
+		})
+	}
+}
@@ -30,7 +30,7 @@ func TestCreateUser(t *testing.T) {
 			payload: web.UserCreateRequest{
 				Username:             fmt.Sprintf("username_test_1%d", time.Now().UnixMilli()),
 				Email:                fmt.Sprintf("integration_1%d@email.com", time.Now().UnixMilli()),
-				Handphone:            "08123456789",
+				Handphone:            "+628123456789",
 				Password:             "password",
 				PasswordConfirmation: "password",
 			},
@@ -42,7 +42,7 @@ func TestCreateUser(t *testing.T) {
 			payload: web.UserCreateRequest{
 				Username:             fmt.Sprintf("username_test_2%d", time.Now().UnixMilli()),
 				Email:                fmt.Sprintf("integration_2%d@email.com", time.Now().UnixMilli()),
-				Handphone:            "08123456789",
+				Handphone:            "+628123456789",
 				Password:             "password",
 				PasswordConfirmation: "wrong_password",
 			},
@@ -123,7 +123,7 @@ func TestGetUserById(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test",
 				Email:     "email_test@gmail.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 				Password:  string(password),
 			}
 
@@ -180,7 +180,7 @@ func TestGetAllUserId(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test",
 				Email:     "email_test@gmail.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 				Password:  string(password),
 			}
 
@@ -217,19 +217,31 @@ func TestUpdateUserProfile(t *testing.T) {
 		statusCodeExpected string
 		wanErrNotFound     bool
 		wantUnauthorized   bool
+		role               string
 	}{
 		{
 			name: "Update User Success",
 			payload: web.UserUpdateProfileRequest{
 				Username:  fmt.Sprintf("username_test_1%d", time.Now().UnixMilli()),
 				Email:     fmt.Sprintf("integration_1%d@email.com", time.Now().UnixMilli()),
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 			},
 			codeExpected:       http.StatusOK,
 			statusCodeExpected: web.OK,
 			wanErrNotFound:     false,
 			wantUnauthorized:   false,
 		},
+		{
+			name: "Admin Keeps Role",
+			payload: web.UserUpdateProfileRequest{
+				Username:  fmt.Sprintf("username_test_5%d", time.Now().UnixMilli()),
+				Email:     fmt.Sprintf("integration_5%d@email.com", time.Now().UnixMilli()),
+				Handphone: "+628123456789",
+			},
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			role:               entity.UserRoleAdmin,
+		},
 		{
 			name: "Some Field Empty",
 			payload: web.UserUpdateProfileRequest{
@@ -247,7 +259,7 @@ func TestUpdateUserProfile(t *testing.T) {
 			payload: web.UserUpdateProfileRequest{
 				Username:  fmt.Sprintf("username_test_3%d", time.Now().UnixMilli()),
 				Email:     fmt.Sprintf("integration_3%d@email.com", time.Now().UnixMilli()),
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 			},
 			codeExpected:       http.StatusNotFound,
 			statusCodeExpected: web.NOT_FOUND,
@@ -259,7 +271,7 @@ func TestUpdateUserProfile(t *testing.T) {
 			payload: web.UserUpdateProfileRequest{
 				Username:  fmt.Sprintf("username_test_4%d", time.Now().UnixMilli()),
 				Email:     fmt.Sprintf("integration_4%d@email.com", time.Now().UnixMilli()),
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 			},
 			codeExpected:       http.StatusUnauthorized,
 			statusCodeExpected: web.UNAUTHORIZATION,
@@ -280,8 +292,9 @@ func TestUpdateUserProfile(t *testing.T) {
 				UserID:    uuid.NewString(),
 				Username:  fmt.Sprintf("username_test_%d", time.Now().UnixMilli()),
 				Email:     fmt.Sprintf("integration%d@email.com", time.Now().UnixMilli()),
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 				Password:  string(password),
+				Role:      tt.role,
 			}
 
 			_, err = userRepository.InsertUser(ctx, dataDB)
@@ -308,6 +321,124 @@ func TestUpdateUserProfile(t *testing.T) {
 			app.ServeHTTP(recorder, request)
 			response := recorder.Result()
 
+			responseBody, _ := io.ReadAll(response.Body)
+			webResponse := web.WebResponse{}
+			json.Unmarshal(responseBody, &webResponse)
+			assert.Equal(t, tt.codeExpected, webResponse.Code)
+			assert.Equal(t, tt.statusCodeExpected, webResponse.Status)
+			if tt.role != "" {
+				updated, err := userRepository.FindUserByID(ctx, dataDB.UserID)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.role, updated.Role)
+			}
+		})
+	}
+}
+
+func TestPatchUserProfile(t *testing.T) {
+	tests := []struct {
+		name               string
+		contentType        string
+		payload            string
+		codeExpected       int
+		statusCodeExpected string
+		wanErrNotFound     bool
+		wantUnauthorized   bool
+	}{
+		{
+			name:               "Merge Patch Clear Handphone",
+			contentType:        web.MergePatchContentType,
+			payload:            `{"handphone":null}`,
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			wanErrNotFound:     false,
+			wantUnauthorized:   false,
+		},
+		{
+			name:               "JSON Patch Replace Username",
+			contentType:        web.JSONPatchContentType,
+			payload:            fmt.Sprintf(`[{"op":"replace","path":"/username","value":"username_patch_%d"}]`, time.Now().UnixMilli()),
+			codeExpected:       http.StatusOK,
+			statusCodeExpected: web.OK,
+			wanErrNotFound:     false,
+			wantUnauthorized:   false,
+		},
+		{
+			name:               "Clear Required Field",
+			contentType:        web.MergePatchContentType,
+			payload:            `{"email":null}`,
+			codeExpected:       http.StatusBadRequest,
+			statusCodeExpected: web.BAD_REQUEST,
+			wanErrNotFound:     false,
+			wantUnauthorized:   false,
+		},
+		{
+			name:               "Unsupported Media Type",
+			contentType:        echo.MIMETextPlain,
+			payload:            `handphone=`,
+			codeExpected:       http.StatusUnsupportedMediaType,
+			statusCodeExpected: web.UNSUPPORTED_MEDIA,
+			wanErrNotFound:     false,
+			wantUnauthorized:   false,
+		},
+		{
+			name:               "User Not Found",
+			contentType:        web.MergePatchContentType,
+			payload:            `{"handphone":null}`,
+			codeExpected:       http.StatusNotFound,
+			statusCodeExpected: web.NOT_FOUND,
+			wanErrNotFound:     true,
+			wantUnauthorized:   false,
+		},
+		{
+			name:               "Unauthorized",
+			contentType:        web.MergePatchContentType,
+			payload:            `{"handphone":null}`,
+			codeExpected:       http.StatusUnauthorized,
+			statusCodeExpected: web.UNAUTHORIZATION,
+			wanErrNotFound:     false,
+			wantUnauthorized:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := transactionRepository.DeleteAllTransaction(ctx)
+			assert.NoError(t, err)
+			err = userRepository.DeleteAllUser(ctx)
+			assert.NoError(t, err)
+
+			password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+
+			dataDB := entity.User{
+				UserID:    uuid.NewString(),
+				Username:  fmt.Sprintf("username_test_%d", time.Now().UnixMilli()),
+				Email:     fmt.Sprintf("integration%d@email.com", time.Now().UnixMilli()),
+				Handphone: "+628123456789",
+				Password:  string(password),
+			}
+
+			_, err = userRepository.InsertUser(ctx, dataDB)
+			assert.NoError(t, err)
+
+			var accessToken string
+			if !tt.wantUnauthorized {
+				accessToken = getAuthorization(web.LoginRequest{Username: dataDB.Username, Password: "password"})
+			}
+
+			var request *http.Request
+			if !tt.wanErrNotFound {
+				request = httptest.NewRequest("PATCH", "/dot-api/user/"+dataDB.UserID, bytes.NewBufferString(tt.payload))
+			} else {
+				request = httptest.NewRequest("PATCH", "/dot-api/user/wrong_id", bytes.NewBufferString(tt.payload))
+			}
+			request.Header.Set(echo.HeaderContentType, tt.contentType)
+			request.Header.Set("Authorization", "Bearer "+accessToken)
+
+			recorder := httptest.NewRecorder()
+
+			app.ServeHTTP(recorder, request)
+			response := recorder.Result()
+
 			responseBody, _ := io.ReadAll(response.Body)
 			webResponse := web.WebResponse{}
 			json.Unmarshal(responseBody, &webResponse)
@@ -350,7 +481,7 @@ func TestRemoveUser(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test",
 				Email:     "email_test@gmail.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 				Password:  string(password),
 			}
 
@@ -0,0 +1,104 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/application"
+	mockLockRepository "github.com/vnnyx/golang-dot-api/repository/lock/mocks"
+	"github.com/vnnyx/golang-dot-api/scheduler"
+)
+
+func TestLifecycle(t *testing.T) {
+	type hook struct {
+		name      string
+		startErr  error
+		stopErr   error
+		noOnStart bool
+	}
+	tests := []struct {
+		name         string
+		hooks        []hook
+		wantStartErr string
+		wantStopErr  string
+		wantEvents   []string
+	}{
+		{
+			name:       "Start In Order And Stop In Reverse Order",
+			hooks:      []hook{{name: "mysql", noOnStart: true}, {name: "redis"}, {name: "scheduler"}},
+			wantEvents: []string{"start redis", "start scheduler", "stop scheduler", "stop redis", "stop mysql"},
+		},
+		{
+			name:         "Start Failure Stops The Started Hooks",
+			hooks:        []hook{{name: "mysql"}, {name: "redis", startErr: errors.New("connection refused")}, {name: "scheduler"}},
+			wantStartErr: "start redis: connection refused",
+			wantEvents:   []string{"start mysql", "start redis", "stop mysql"},
+		},
+		{
+			name:        "Stop Failure Stops The Other Hooks",
+			hooks:       []hook{{name: "mysql", stopErr: errors.New("bad connection")}, {name: "redis", stopErr: errors.New("closed")}},
+			wantStopErr: "stop redis: closed",
+			wantEvents:  []string{"start mysql", "start redis", "stop redis", "stop mysql"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+			lifecycle := application.NewLifecycle()
+			for _, h := range tt.hooks {
+				h := h
+				hook := application.Hook{
+					Name: h.name,
+					OnStop: func(ctx context.Context) error {
+						events = append(events, "stop "+h.name)
+						return h.stopErr
+					},
+				}
+				if !h.noOnStart {
+					hook.OnStart = func(ctx context.Context) error {
+						events = append(events, "start "+h.name)
+						return h.startErr
+					}
+				}
+				lifecycle.Append(hook)
+			}
+
+			err := lifecycle.Start(context.Background())
+			if tt.wantStartErr != "" {
+				assert.EqualError(t, err, tt.wantStartErr)
+			} else {
+				assert.NoError(t, err)
+				err = lifecycle.Stop(context.Background())
+				if tt.wantStopErr != "" {
+					assert.EqualError(t, err, tt.wantStopErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+			assert.NoError(t, lifecycle.Stop(context.Background()), "hooks were stopped twice")
+			assert.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
+
+func TestApplication_RunRecurringScheduler(t *testing.T) {
+	lockRepository := mockLockRepository.NewLockRepository(t)
+	lockRepository.On("AcquireLock", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	lockRepository.On("ReleaseLock", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	app := &application.Application{
+		RecurringScheduler: &scheduler.RecurringScheduler{LockRepository: lockRepository, Interval: time.Hour, Token: "replica-a"},
+		Lifecycle:          application.NewLifecycle(),
+	}
+	app.RunRecurringScheduler()
+
+	assert.NoError(t, app.Start(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// Stop returns once the scheduler has released its leader lock.
+	assert.NoError(t, app.Stop(ctx))
+	lockRepository.AssertExpectations(t)
+}
@@ -0,0 +1,341 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/storage"
+	mockStorage "github.com/vnnyx/golang-dot-api/infrastructure/storage/mocks"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockAttachmentRepository "github.com/vnnyx/golang-dot-api/repository/attachment/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	"github.com/vnnyx/golang-dot-api/service/attachment"
+)
+
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+func TestAttachmentService_UploadAttachment(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.AttachmentUploadRequest
+	}
+	type mockFindTransactionByIDRepository struct {
+		res entity.Transaction
+		err error
+	}
+	type mockPutStorage struct {
+		err error
+	}
+	type mockInsertAttachmentRepository struct {
+		res entity.Attachment
+		err error
+	}
+	tests := []struct {
+		name                              string
+		args                              args
+		mockFindTransactionByIDRepository *mockFindTransactionByIDRepository
+		mockPutStorage                    *mockPutStorage
+		mockInsertAttachmentRepository    *mockInsertAttachmentRepository
+		wantDeleteStorage                 bool
+		want                              web.AttachmentResponse
+		wantErr                           bool
+		wantValidationError               bool
+	}{
+		{
+			name: "Upload Attachment Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.AttachmentUploadRequest{
+					TransactionID: "456",
+					UserID:        "123",
+					FileName:      "receipt.png",
+					Size:          int64(len(pngContent)),
+					Content:       bytes.NewReader(pngContent),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{TransactionID: "456", UserID: "123", Name: "Lunch"},
+				err: nil,
+			},
+			mockPutStorage: &mockPutStorage{
+				err: nil,
+			},
+			mockInsertAttachmentRepository: &mockInsertAttachmentRepository{
+				res: entity.Attachment{AttachmentID: "789", TransactionID: "456", FileName: "receipt.png", ContentType: "image/png", Size: int64(len(pngContent))},
+				err: nil,
+			},
+			want: web.AttachmentResponse{
+				AttachmentID:  "789",
+				TransactionID: "456",
+				FileName:      "receipt.png",
+				ContentType:   "image/png",
+				Size:          int64(len(pngContent)),
+			},
+			wantErr: false,
+		},
+		{
+			name: "Transaction Belongs To Another User",
+			args: args{
+				ctx: context.TODO(),
+				req: web.AttachmentUploadRequest{
+					TransactionID: "456",
+					UserID:        "123",
+					FileName:      "receipt.png",
+					Size:          int64(len(pngContent)),
+					Content:       bytes.NewReader(pngContent),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{TransactionID: "456", UserID: "999", Name: "Lunch"},
+				err: nil,
+			},
+			want:    web.AttachmentResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported Content Type",
+			args: args{
+				ctx: context.TODO(),
+				req: web.AttachmentUploadRequest{
+					TransactionID: "456",
+					UserID:        "123",
+					FileName:      "receipt.png",
+					Size:          11,
+					Content:       bytes.NewReader([]byte("hello world")),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{TransactionID: "456", UserID: "123", Name: "Lunch"},
+				err: nil,
+			},
+			wantValidationError: true,
+		},
+		{
+			name: "File Too Large",
+			args: args{
+				ctx: context.TODO(),
+				req: web.AttachmentUploadRequest{
+					TransactionID: "456",
+					UserID:        "123",
+					FileName:      "receipt.png",
+					Size:          6 << 20,
+					Content:       bytes.NewReader(pngContent),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{TransactionID: "456", UserID: "123", Name: "Lunch"},
+				err: nil,
+			},
+			wantValidationError: true,
+		},
+		{
+			name: "Remove Stored File When Insert Fails",
+			args: args{
+				ctx: context.TODO(),
+				req: web.AttachmentUploadRequest{
+					TransactionID: "456",
+					UserID:        "123",
+					FileName:      "receipt.png",
+					Size:          int64(len(pngContent)),
+					Content:       bytes.NewReader(pngContent),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{TransactionID: "456", UserID: "123", Name: "Lunch"},
+				err: nil,
+			},
+			mockPutStorage: &mockPutStorage{
+				err: nil,
+			},
+			mockInsertAttachmentRepository: &mockInsertAttachmentRepository{
+				res: entity.Attachment{},
+				err: errors.New("error"),
+			},
+			wantDeleteStorage: true,
+			want:              web.AttachmentResponse{},
+			wantErr:           true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockAttachmentRepository := new(mockAttachmentRepository.AttachmentRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockStorage := new(mockStorage.Storage)
+
+			if tt.mockFindTransactionByIDRepository != nil {
+				mockTransactionRepository.On("FindTransactionByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByIDRepository.res, tt.mockFindTransactionByIDRepository.err)
+			}
+			var stored []byte
+			if tt.mockPutStorage != nil {
+				mockStorage.On("Put", tt.args.ctx, mock.Anything, "image/png", mock.Anything, tt.args.req.Size).Return(tt.mockPutStorage.err).Run(func(args mock.Arguments) {
+					stored, _ = io.ReadAll(args.Get(3).(io.Reader))
+				})
+			}
+			if tt.mockInsertAttachmentRepository != nil {
+				mockAttachmentRepository.On("InsertAttachment", tt.args.ctx, mock.Anything).Return(tt.mockInsertAttachmentRepository.res, tt.mockInsertAttachmentRepository.err)
+			}
+			if tt.wantDeleteStorage {
+				mockStorage.On("Delete", tt.args.ctx, mock.Anything).Return(nil)
+			}
+
+			attachmentService := attachment.NewAttachmentService(mockAttachmentRepository, mockTransactionRepository, mockStorage, &infrastructure.Config{AttachmentMaxSizeMB: 5})
+			got, err := attachmentService.UploadAttachment(tt.args.ctx, tt.args.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.UploadAttachment() want validation error")
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.UploadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.UploadAttachment() = %v, want %v", got, tt.want)
+			}
+			if tt.mockPutStorage != nil && !bytes.Equal(stored, pngContent) {
+				t.Errorf("stored content = %v, want %v", stored, pngContent)
+			}
+			mockStorage.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAttachmentService_DownloadAttachment(t *testing.T) {
+	type mockFindAttachmentByIDRepository struct {
+		res entity.Attachment
+		err error
+	}
+	type mockGetStorage struct {
+		res io.ReadCloser
+		err error
+	}
+	tests := []struct {
+		name                             string
+		req                              web.AttachmentRequest
+		mockFindAttachmentByIDRepository *mockFindAttachmentByIDRepository
+		mockGetStorage                   *mockGetStorage
+		wantErr                          bool
+	}{
+		{
+			name: "Download Attachment Success",
+			req:  web.AttachmentRequest{TransactionID: "456", AttachmentID: "789", UserID: "123"},
+			mockFindAttachmentByIDRepository: &mockFindAttachmentByIDRepository{
+				res: entity.Attachment{AttachmentID: "789", TransactionID: "456", StorageKey: "transactions/456/789"},
+				err: nil,
+			},
+			mockGetStorage: &mockGetStorage{
+				res: io.NopCloser(bytes.NewReader(pngContent)),
+				err: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Attachment Belongs To Another Transaction",
+			req:  web.AttachmentRequest{TransactionID: "456", AttachmentID: "789", UserID: "123"},
+			mockFindAttachmentByIDRepository: &mockFindAttachmentByIDRepository{
+				res: entity.Attachment{AttachmentID: "789", TransactionID: "999", StorageKey: "transactions/999/789"},
+				err: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Stored File Missing",
+			req:  web.AttachmentRequest{TransactionID: "456", AttachmentID: "789", UserID: "123"},
+			mockFindAttachmentByIDRepository: &mockFindAttachmentByIDRepository{
+				res: entity.Attachment{AttachmentID: "789", TransactionID: "456", StorageKey: "transactions/456/789"},
+				err: nil,
+			},
+			mockGetStorage: &mockGetStorage{
+				res: nil,
+				err: storage.ErrObjectNotFound,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockAttachmentRepository := new(mockAttachmentRepository.AttachmentRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockStorage := new(mockStorage.Storage)
+
+			mockTransactionRepository.On("FindTransactionByID", ctx, "456").Return(entity.Transaction{TransactionID: "456", UserID: "123"}, nil)
+			mockAttachmentRepository.On("FindAttachmentByID", ctx, tt.req.AttachmentID).Return(tt.mockFindAttachmentByIDRepository.res, tt.mockFindAttachmentByIDRepository.err)
+			if tt.mockGetStorage != nil {
+				mockStorage.On("Get", ctx, "transactions/456/789").Return(tt.mockGetStorage.res, tt.mockGetStorage.err)
+			}
+
+			attachmentService := attachment.NewAttachmentService(mockAttachmentRepository, mockTransactionRepository, mockStorage, &infrastructure.Config{})
+			got, err := attachmentService.DownloadAttachment(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.DownloadAttachment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				content, _ := io.ReadAll(got.Content)
+				assert.Equal(t, pngContent, content)
+			}
+		})
+	}
+}
+
+func TestAttachmentService_RemoveAttachment(t *testing.T) {
+	tests := []struct {
+		name        string
+		transaction entity.Transaction
+		evidence    bool
+		wantErr     error
+		wantRemoved bool
+	}{
+		{
+			name:        "Remove Attachment Success",
+			transaction: entity.Transaction{TransactionID: "456", UserID: "123"},
+			wantRemoved: true,
+		},
+		{
+			name:        "Transaction On Hold",
+			transaction: entity.Transaction{TransactionID: "456", UserID: "123", OnHold: true},
+			wantErr:     attachment.ErrTransactionOnHold,
+		},
+		{
+			name:        "Evidence Of Open Dispute",
+			transaction: entity.Transaction{TransactionID: "456", UserID: "123"},
+			evidence:    true,
+			wantErr:     attachment.ErrTransactionOnHold,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockAttachmentRepository := new(mockAttachmentRepository.AttachmentRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockStorage := new(mockStorage.Storage)
+
+			mockTransactionRepository.On("FindTransactionByID", ctx, "456").Return(tt.transaction, nil)
+			mockAttachmentRepository.On("FindAttachmentByID", ctx, "789").Return(entity.Attachment{AttachmentID: "789", TransactionID: "456", StorageKey: "transactions/456/789"}, nil)
+			mockAttachmentRepository.On("IsActiveDisputeEvidence", ctx, "789").Return(tt.evidence, nil)
+			mockAttachmentRepository.On("DeleteAttachment", ctx, "789").Return(nil)
+			mockStorage.On("Delete", ctx, "transactions/456/789").Return(nil)
+
+			attachmentService := attachment.NewAttachmentService(mockAttachmentRepository, mockTransactionRepository, mockStorage, &infrastructure.Config{})
+			err := attachmentService.RemoveAttachment(ctx, web.AttachmentRequest{TransactionID: "456", AttachmentID: "789", UserID: "123"})
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantRemoved {
+				mockAttachmentRepository.AssertCalled(t, "DeleteAttachment", ctx, "789")
+				mockStorage.AssertCalled(t, "Delete", ctx, "transactions/456/789")
+			} else {
+				mockAttachmentRepository.AssertNotCalled(t, "DeleteAttachment", ctx, "789")
+				mockStorage.AssertNotCalled(t, "Delete", ctx, "transactions/456/789")
+			}
+		})
+	}
+}
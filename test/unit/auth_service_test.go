@@ -8,9 +8,11 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/agiledragon/gomonkey"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
 	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
@@ -48,6 +50,7 @@ func TestAuthService_Login(t *testing.T) {
 		want                             web.LoginResponse
 		wantErrComparePassword           bool
 		wantErr                          bool
+		wantLoginResult                  string
 	}{
 		{
 			name: "Login Success",
@@ -78,6 +81,7 @@ func TestAuthService_Login(t *testing.T) {
 			},
 			wantErrComparePassword: false,
 			wantErr:                false,
+			wantLoginResult:        metrics.LoginSuccess,
 		},
 		{
 			name: "Error When Find User By Username",
@@ -92,8 +96,9 @@ func TestAuthService_Login(t *testing.T) {
 				res: entity.User{},
 				err: errors.New("error"),
 			},
-			want:    web.LoginResponse{},
-			wantErr: true,
+			want:            web.LoginResponse{},
+			wantErr:         true,
+			wantLoginResult: metrics.LoginFailure,
 		},
 		{
 			name: "Error When Wrong Input Password",
@@ -119,6 +124,7 @@ func TestAuthService_Login(t *testing.T) {
 			want:                   web.LoginResponse{},
 			wantErrComparePassword: true,
 			wantErr:                true,
+			wantLoginResult:        metrics.LoginFailure,
 		},
 		{
 			name: "Error When Store Token To Redis",
@@ -187,8 +193,22 @@ func TestAuthService_Login(t *testing.T) {
 			})
 			defer td.Reset()
 
+			logins := map[string]float64{}
+			for _, result := range []string{metrics.LoginSuccess, metrics.LoginFailure} {
+				logins[result] = testutil.ToFloat64(metrics.Logins.WithLabelValues(result))
+			}
+
 			authService := auth.NewAuthService(config, DB, mockUserRepository, mockAuthRepository)
 			got, err := authService.Login(tt.args.ctx, tt.args.req)
+			for result, before := range logins {
+				want := before
+				if result == tt.wantLoginResult {
+					want++
+				}
+				if got := testutil.ToFloat64(metrics.Logins.WithLabelValues(result)); got != want {
+					t.Errorf("logins_total{result=%q} = %v, want %v", result, got, want)
+				}
+			}
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.Login() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,357 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockBudgetRepository "github.com/vnnyx/golang-dot-api/repository/budget/mocks"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	"github.com/vnnyx/golang-dot-api/service/budget"
+)
+
+func TestBudgetService_CreateBudget(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.BudgetCreateRequest
+	}
+	type mockFindCategoryByIDRepository struct {
+		res entity.Category
+		err error
+	}
+	type mockFindBudgetByCategoryIdsRepository struct {
+		res []entity.Budget
+		err error
+	}
+	type mockInsertBudgetRepository struct {
+		res entity.Budget
+		err error
+	}
+	tests := []struct {
+		name                                  string
+		args                                  args
+		mockFindCategoryByIDRepository        *mockFindCategoryByIDRepository
+		mockFindBudgetByCategoryIdsRepository *mockFindBudgetByCategoryIdsRepository
+		mockInsertBudgetRepository            *mockInsertBudgetRepository
+		want                                  web.BudgetResponse
+		wantErr                               bool
+	}{
+		{
+			name: "Create Budget Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.BudgetCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					Amount:     10000,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "100", UserID: "123", Name: "Bills"},
+				err: nil,
+			},
+			mockFindBudgetByCategoryIdsRepository: &mockFindBudgetByCategoryIdsRepository{
+				res: []entity.Budget{},
+				err: nil,
+			},
+			mockInsertBudgetRepository: &mockInsertBudgetRepository{
+				res: entity.Budget{BudgetID: "456", UserID: "123", CategoryID: "100", Amount: 10000, Currency: "IDR", Timezone: "UTC"},
+				err: nil,
+			},
+			want: web.BudgetResponse{
+				BudgetID:    "456",
+				UserID:      "123",
+				CategoryID:  "100",
+				Amount:      10000,
+				Currency:    "IDR",
+				Timezone:    "UTC",
+				Period:      time.Now().UTC().Format("2006-01"),
+				Spent:       2500,
+				Remaining:   7500,
+				PercentUsed: 25,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Invalid Currency",
+			args: args{
+				ctx: context.TODO(),
+				req: web.BudgetCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					Amount:     10000,
+					Currency:   "usd",
+				},
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Category Belongs To Another User",
+			args: args{
+				ctx: context.TODO(),
+				req: web.BudgetCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					Amount:     10000,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "100", UserID: "999", Name: "Bills"},
+				err: nil,
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Budget Already Exists",
+			args: args{
+				ctx: context.TODO(),
+				req: web.BudgetCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					Amount:     10000,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "100", UserID: "123", Name: "Bills"},
+				err: nil,
+			},
+			mockFindBudgetByCategoryIdsRepository: &mockFindBudgetByCategoryIdsRepository{
+				res: []entity.Budget{{BudgetID: "1", UserID: "123", CategoryID: "100"}},
+				err: nil,
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Error When Insert data to DB",
+			args: args{
+				ctx: context.TODO(),
+				req: web.BudgetCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					Amount:     10000,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "100", UserID: "123", Name: "Bills"},
+				err: nil,
+			},
+			mockFindBudgetByCategoryIdsRepository: &mockFindBudgetByCategoryIdsRepository{
+				res: []entity.Budget{},
+				err: nil,
+			},
+			mockInsertBudgetRepository: &mockInsertBudgetRepository{
+				res: entity.Budget{},
+				err: errors.New("error"),
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+
+			if tt.mockFindCategoryByIDRepository != nil {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, tt.args.req.CategoryID).Return(tt.mockFindCategoryByIDRepository.res, tt.mockFindCategoryByIDRepository.err)
+			}
+			if tt.mockFindBudgetByCategoryIdsRepository != nil {
+				mockBudgetRepository.On("FindBudgetByCategoryIds", tt.args.ctx, tt.args.req.UserID, []string{tt.args.req.CategoryID}).Return(tt.mockFindBudgetByCategoryIdsRepository.res, tt.mockFindBudgetByCategoryIdsRepository.err)
+			}
+			if tt.mockInsertBudgetRepository != nil {
+				mockBudgetRepository.On("InsertBudget", tt.args.ctx, mock.Anything).Return(tt.mockInsertBudgetRepository.res, tt.mockInsertBudgetRepository.err)
+			}
+			mockCategoryRepository.On("FindCategoryByUserId", tt.args.ctx, tt.args.req.UserID).Return([]entity.Category{{CategoryID: "100", UserID: "123", Name: "Bills"}}, nil)
+			mockTransactionRepository.On("SumTransactionAmount", tt.args.ctx, tt.args.req.UserID, "IDR", []string{"100"}, mock.Anything, mock.Anything).Return(int64(2500), nil)
+
+			budgetId := gomonkey.ApplyFunc(uuid.NewString, func() string {
+				return "456"
+			})
+			defer budgetId.Reset()
+
+			budgetService := budget.NewBudgetService(mockBudgetRepository, mockCategoryRepository, mockTransactionRepository)
+			got, err := budgetService.CreateBudget(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateBudget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateBudget() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBudgetService_GetBudgetById(t *testing.T) {
+	parentId := "100"
+	categories := []entity.Category{
+		{CategoryID: parentId, UserID: "123", Name: "Bills"},
+		{CategoryID: "101", UserID: "123", ParentID: &parentId, Name: "Internet"},
+	}
+	type mockFindBudgetByIDRepository struct {
+		res entity.Budget
+		err error
+	}
+	tests := []struct {
+		name                         string
+		req                          web.BudgetRequest
+		mockFindBudgetByIDRepository *mockFindBudgetByIDRepository
+		wantFrom                     time.Time
+		want                         web.BudgetResponse
+		wantErr                      bool
+	}{
+		{
+			name: "Get Budget For Period In Budget Timezone",
+			req:  web.BudgetRequest{BudgetID: "1", UserID: "123", Period: "2023-03"},
+			mockFindBudgetByIDRepository: &mockFindBudgetByIDRepository{
+				res: entity.Budget{BudgetID: "1", UserID: "123", CategoryID: parentId, Amount: 10000, Currency: "IDR", Timezone: "Asia/Jakarta"},
+				err: nil,
+			},
+			wantFrom: time.Date(2023, 2, 28, 17, 0, 0, 0, time.UTC),
+			want: web.BudgetResponse{
+				BudgetID:    "1",
+				UserID:      "123",
+				CategoryID:  parentId,
+				Amount:      10000,
+				Currency:    "IDR",
+				Timezone:    "Asia/Jakarta",
+				Period:      "2023-03",
+				Spent:       12500,
+				Remaining:   -2500,
+				PercentUsed: 125,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Only Spending In Budget Currency",
+			req:  web.BudgetRequest{BudgetID: "1", UserID: "123", Period: "2023-03"},
+			mockFindBudgetByIDRepository: &mockFindBudgetByIDRepository{
+				res: entity.Budget{BudgetID: "1", UserID: "123", CategoryID: parentId, Amount: 1000, Currency: "USD", Timezone: "UTC"},
+				err: nil,
+			},
+			want: web.BudgetResponse{
+				BudgetID:    "1",
+				UserID:      "123",
+				CategoryID:  parentId,
+				Amount:      1000,
+				Currency:    "USD",
+				Timezone:    "UTC",
+				Period:      "2023-03",
+				Spent:       300,
+				Remaining:   700,
+				PercentUsed: 30,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Budget Belongs To Another User",
+			req:  web.BudgetRequest{BudgetID: "1", UserID: "123"},
+			mockFindBudgetByIDRepository: &mockFindBudgetByIDRepository{
+				res: entity.Budget{BudgetID: "1", UserID: "999", CategoryID: parentId, Amount: 10000, Timezone: "UTC"},
+				err: nil,
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Invalid Period",
+			req:  web.BudgetRequest{BudgetID: "1", UserID: "123", Period: "03-2023"},
+			mockFindBudgetByIDRepository: &mockFindBudgetByIDRepository{
+				res: entity.Budget{BudgetID: "1", UserID: "123", CategoryID: parentId, Amount: 10000, Timezone: "UTC"},
+				err: nil,
+			},
+			want:    web.BudgetResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+
+			mockBudgetRepository.On("FindBudgetByID", ctx, tt.req.BudgetID).Return(tt.mockFindBudgetByIDRepository.res, tt.mockFindBudgetByIDRepository.err)
+			mockCategoryRepository.On("FindCategoryByUserId", ctx, "123").Return(categories, nil)
+			var gotFrom time.Time
+			// The user spent in both currencies during the month.
+			spent := map[string]int64{"IDR": 12500, "USD": 300}
+			mockTransactionRepository.On("SumTransactionAmount", ctx, "123", mock.Anything, []string{parentId, "101"}, mock.Anything, mock.Anything).Return(func(ctx context.Context, userId string, currency string, categoryIds []string, from time.Time, to time.Time) int64 {
+				return spent[currency]
+			}, nil).Run(func(args mock.Arguments) {
+				gotFrom = args.Get(4).(time.Time)
+			})
+
+			budgetService := budget.NewBudgetService(mockBudgetRepository, mockCategoryRepository, mockTransactionRepository)
+			got, err := budgetService.GetBudgetById(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.GetBudgetById() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.GetBudgetById() = %v, want %v", got, tt.want)
+			}
+			if !tt.wantFrom.IsZero() && !gotFrom.Equal(tt.wantFrom) {
+				t.Errorf("service.GetBudgetById() summed from %v, want %v", gotFrom, tt.wantFrom)
+			}
+		})
+	}
+}
+
+func TestBudgetService_RemoveBudget(t *testing.T) {
+	tests := []struct {
+		name    string
+		budget  entity.Budget
+		err     error
+		wantErr bool
+	}{
+		{
+			name:    "Remove Budget Success",
+			budget:  entity.Budget{BudgetID: "1", UserID: "123"},
+			wantErr: false,
+		},
+		{
+			name:    "Budget Not Found",
+			err:     errors.New("record not found"),
+			wantErr: true,
+		},
+		{
+			name:    "Budget Belongs To Another User",
+			budget:  entity.Budget{BudgetID: "1", UserID: "999"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+
+			mockBudgetRepository.On("FindBudgetByID", ctx, "1").Return(tt.budget, tt.err)
+			mockBudgetRepository.On("DeleteBudget", ctx, "1").Return(nil)
+
+			budgetService := budget.NewBudgetService(mockBudgetRepository, mockCategoryRepository, mockTransactionRepository)
+			err := budgetService.RemoveBudget(ctx, "123", "1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RemoveBudget() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				mockBudgetRepository.AssertNotCalled(t, "DeleteBudget", ctx, "1")
+			}
+		})
+	}
+}
@@ -0,0 +1,457 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	"github.com/vnnyx/golang-dot-api/service/category"
+)
+
+func TestCategoryService_CreateCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.CategoryCreateRequest
+	}
+	type mockFindCategoryByIDRepository struct {
+		res entity.Category
+		err error
+	}
+	type mockInsertCategoryRepository struct {
+		res entity.Category
+		err error
+	}
+	parentId := "100"
+	tests := []struct {
+		name                           string
+		args                           args
+		mockFindCategoryByIDRepository *mockFindCategoryByIDRepository
+		mockInsertCategoryRepository   *mockInsertCategoryRepository
+		want                           web.CategoryResponse
+		wantErr                        bool
+	}{
+		{
+			name: "Create Root Category Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryCreateRequest{
+					UserID: "123",
+					Name:   "Bills",
+				},
+			},
+			mockInsertCategoryRepository: &mockInsertCategoryRepository{
+				res: entity.Category{
+					CategoryID: "456",
+					UserID:     "123",
+					Name:       "Bills",
+				},
+				err: nil,
+			},
+			want: web.CategoryResponse{
+				CategoryID: "456",
+				UserID:     "123",
+				Name:       "Bills",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Create Nested Category Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryCreateRequest{
+					UserID:   "123",
+					ParentID: parentId,
+					Name:     "Internet",
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{
+					CategoryID: parentId,
+					UserID:     "123",
+					Name:       "Bills",
+				},
+				err: nil,
+			},
+			mockInsertCategoryRepository: &mockInsertCategoryRepository{
+				res: entity.Category{
+					CategoryID: "456",
+					UserID:     "123",
+					ParentID:   &parentId,
+					Name:       "Internet",
+				},
+				err: nil,
+			},
+			want: web.CategoryResponse{
+				CategoryID: "456",
+				UserID:     "123",
+				ParentID:   parentId,
+				Name:       "Internet",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Parent Belongs To Another User",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryCreateRequest{
+					UserID:   "123",
+					ParentID: parentId,
+					Name:     "Internet",
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{
+					CategoryID: parentId,
+					UserID:     "999",
+					Name:       "Bills",
+				},
+				err: nil,
+			},
+			want:    web.CategoryResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Error When Insert data to DB",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryCreateRequest{
+					UserID: "123",
+					Name:   "Bills",
+				},
+			},
+			mockInsertCategoryRepository: &mockInsertCategoryRepository{
+				res: entity.Category{},
+				err: errors.New("error"),
+			},
+			want:    web.CategoryResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			if tt.mockFindCategoryByIDRepository != nil {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByIDRepository.res, tt.mockFindCategoryByIDRepository.err)
+			}
+			if tt.mockInsertCategoryRepository != nil {
+				mockCategoryRepository.On("InsertCategory", tt.args.ctx, mock.Anything).Return(tt.mockInsertCategoryRepository.res, tt.mockInsertCategoryRepository.err)
+			}
+
+			categoryId := gomonkey.ApplyFunc(uuid.NewString, func() string {
+				return "456"
+			})
+			defer categoryId.Reset()
+
+			categoryService := category.NewCategoryService(mockCategoryRepository)
+			got, err := categoryService.CreateCategory(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateCategory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateCategory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryService_GetCategoryByUserId(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req string
+	}
+	type mockFindCategoryByUserIdRepository struct {
+		res []entity.Category
+		err error
+	}
+	parentId := "100"
+	tests := []struct {
+		name                               string
+		args                               args
+		mockFindCategoryByUserIdRepository *mockFindCategoryByUserIdRepository
+		want                               []web.CategoryResponse
+		wantErr                            bool
+	}{
+		{
+			name: "Get Category Tree Success",
+			args: args{
+				ctx: context.TODO(),
+				req: "123",
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: []entity.Category{
+					{CategoryID: parentId, UserID: "123", Name: "Bills"},
+					{CategoryID: "200", UserID: "123", Name: "Food"},
+					{CategoryID: "101", UserID: "123", ParentID: &parentId, Name: "Internet"},
+				},
+				err: nil,
+			},
+			want: []web.CategoryResponse{
+				{
+					CategoryID: parentId,
+					UserID:     "123",
+					Name:       "Bills",
+					Children: []web.CategoryResponse{
+						{CategoryID: "101", UserID: "123", ParentID: parentId, Name: "Internet"},
+					},
+				},
+				{CategoryID: "200", UserID: "123", Name: "Food"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error When Get Category Data",
+			args: args{
+				ctx: context.TODO(),
+				req: "123",
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: []entity.Category{},
+				err: errors.New("error"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			if tt.mockFindCategoryByUserIdRepository != nil {
+				mockCategoryRepository.On("FindCategoryByUserId", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByUserIdRepository.res, tt.mockFindCategoryByUserIdRepository.err)
+			}
+
+			categoryService := category.NewCategoryService(mockCategoryRepository)
+			got, err := categoryService.GetCategoryByUserId(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.GetCategoryByUserId() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.GetCategoryByUserId() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryService_UpdateCategory(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.CategoryUpdateRequest
+	}
+	type mockFindCategoryByUserIdRepository struct {
+		res []entity.Category
+		err error
+	}
+	type mockUpdateCategoryRepository struct {
+		res entity.Category
+		err error
+	}
+	parentId := "100"
+	childId := "101"
+	categories := []entity.Category{
+		{CategoryID: parentId, UserID: "123", Name: "Bills"},
+		{CategoryID: childId, UserID: "123", ParentID: &parentId, Name: "Internet"},
+		{CategoryID: "200", UserID: "123", Name: "Food"},
+	}
+	tests := []struct {
+		name                               string
+		args                               args
+		mockFindCategoryByUserIdRepository *mockFindCategoryByUserIdRepository
+		mockUpdateCategoryRepository       *mockUpdateCategoryRepository
+		want                               web.CategoryResponse
+		wantErr                            bool
+	}{
+		{
+			name: "Move Category Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryUpdateRequest{
+					CategoryID: childId,
+					UserID:     "123",
+					ParentID:   "200",
+					Name:       "Groceries",
+				},
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: categories,
+				err: nil,
+			},
+			mockUpdateCategoryRepository: &mockUpdateCategoryRepository{
+				res: entity.Category{CategoryID: childId, UserID: "123", ParentID: &categories[2].CategoryID, Name: "Groceries"},
+				err: nil,
+			},
+			want: web.CategoryResponse{
+				CategoryID: childId,
+				UserID:     "123",
+				ParentID:   "200",
+				Name:       "Groceries",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Parent Is A Descendant",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryUpdateRequest{
+					CategoryID: parentId,
+					UserID:     "123",
+					ParentID:   childId,
+					Name:       "Bills",
+				},
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: categories,
+				err: nil,
+			},
+			want:    web.CategoryResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Category Not Found",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryUpdateRequest{
+					CategoryID: "404",
+					UserID:     "123",
+					Name:       "Bills",
+				},
+			},
+			want:    web.CategoryResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			for _, category := range categories {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, category.CategoryID).Return(category, nil)
+			}
+			mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, mock.Anything).Return(entity.Category{}, errors.New("record not found"))
+			if tt.mockFindCategoryByUserIdRepository != nil {
+				mockCategoryRepository.On("FindCategoryByUserId", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByUserIdRepository.res, tt.mockFindCategoryByUserIdRepository.err)
+			}
+			if tt.mockUpdateCategoryRepository != nil {
+				mockCategoryRepository.On("UpdateCategory", tt.args.ctx, mock.Anything).Return(tt.mockUpdateCategoryRepository.res, tt.mockUpdateCategoryRepository.err)
+			}
+
+			categoryService := category.NewCategoryService(mockCategoryRepository)
+			got, err := categoryService.UpdateCategory(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.UpdateCategory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.UpdateCategory() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCategoryService_CreateCategoryRule(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.CategoryRuleCreateRequest
+	}
+	type mockFindCategoryByIDRepository struct {
+		res entity.Category
+		err error
+	}
+	type mockInsertCategoryRuleRepository struct {
+		res entity.CategoryRule
+		err error
+	}
+	tests := []struct {
+		name                             string
+		args                             args
+		mockFindCategoryByIDRepository   *mockFindCategoryByIDRepository
+		mockInsertCategoryRuleRepository *mockInsertCategoryRuleRepository
+		want                             web.CategoryRuleResponse
+		wantErr                          bool
+	}{
+		{
+			name: "Create Rule Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryRuleCreateRequest{
+					UserID:     "123",
+					CategoryID: "100",
+					MatchType:  entity.RuleMatchContains,
+					Pattern:    "netflix",
+					Priority:   1,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "100", UserID: "123", Name: "Bills"},
+				err: nil,
+			},
+			mockInsertCategoryRuleRepository: &mockInsertCategoryRuleRepository{
+				res: entity.CategoryRule{
+					RuleID:     "456",
+					UserID:     "123",
+					CategoryID: "100",
+					MatchType:  entity.RuleMatchContains,
+					Pattern:    "netflix",
+					Priority:   1,
+				},
+				err: nil,
+			},
+			want: web.CategoryRuleResponse{
+				RuleID:     "456",
+				CategoryID: "100",
+				MatchType:  entity.RuleMatchContains,
+				Pattern:    "netflix",
+				Priority:   1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Category Not Found",
+			args: args{
+				ctx: context.TODO(),
+				req: web.CategoryRuleCreateRequest{
+					UserID:     "123",
+					CategoryID: "404",
+					MatchType:  entity.RuleMatchPrefix,
+					Pattern:    "grab",
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{},
+				err: errors.New("record not found"),
+			},
+			want:    web.CategoryRuleResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			if tt.mockFindCategoryByIDRepository != nil {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByIDRepository.res, tt.mockFindCategoryByIDRepository.err)
+			}
+			if tt.mockInsertCategoryRuleRepository != nil {
+				mockCategoryRepository.On("InsertCategoryRule", tt.args.ctx, mock.Anything).Return(tt.mockInsertCategoryRuleRepository.res, tt.mockInsertCategoryRuleRepository.err)
+			}
+
+			categoryService := category.NewCategoryService(mockCategoryRepository)
+			got, err := categoryService.CreateCategoryRule(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateCategoryRule() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateCategoryRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
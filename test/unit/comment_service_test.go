@@ -0,0 +1,326 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockCommentRepository "github.com/vnnyx/golang-dot-api/repository/comment/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	mockUserRepository "github.com/vnnyx/golang-dot-api/repository/user/mocks"
+	"github.com/vnnyx/golang-dot-api/service/comment"
+	"gorm.io/gorm"
+)
+
+var (
+	commentCreatedAt = time.Date(2023, 3, 10, 8, 0, 0, 0, time.UTC)
+	// The author ids of comments are pointers, as they are cleared when the
+	// author is deleted.
+	commentOwnerID       = "123"
+	commentParticipantID = "124"
+	commentUsers         = map[string]entity.User{
+		"123": {UserID: "123", Username: "owner", Role: entity.UserRoleMember},
+		"124": {UserID: "124", Username: "participant", Role: entity.UserRoleMember},
+		"125": {UserID: "125", Username: "stranger", Role: entity.UserRoleMember},
+		"126": {UserID: "126", Username: "support", Role: entity.UserRoleAdmin},
+	}
+	commentTransaction = entity.Transaction{TransactionID: "456", UserID: "123", Name: "Dinner", Splits: []entity.TransactionSplit{{TransactionID: "456", UserID: "124"}}}
+)
+
+// newCommentService returns a comment service whose users and transaction
+// come from the fixtures above, and the comment repository mock to set up.
+func newCommentService(ctx context.Context) (comment.CommentService, *mockCommentRepository.CommentRepository) {
+	mockCommentRepository := new(mockCommentRepository.CommentRepository)
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockUserRepository := new(mockUserRepository.UserRepository)
+
+	for id, user := range commentUsers {
+		mockUserRepository.On("FindUserByID", ctx, id).Return(user, nil)
+	}
+	mockTransactionRepository.On("FindTransactionByID", ctx, "456").Return(commentTransaction, nil)
+	mockTransactionRepository.On("FindTransactionByID", ctx, "404").Return(entity.Transaction{}, errors.New("record not found"))
+
+	return comment.NewCommentService(mockCommentRepository, mockTransactionRepository, mockUserRepository), mockCommentRepository
+}
+
+func TestCommentService_CreateComment(t *testing.T) {
+	tests := []struct {
+		name                string
+		req                 web.CommentRequest
+		want                web.CommentResponse
+		wantErr             error
+		wantValidationError bool
+	}{
+		{
+			name: "Owner Comments",
+			req:  web.CommentRequest{TransactionID: "456", UserID: "123", Body: "  Was this charged twice?  "},
+			want: web.CommentResponse{
+				TransactionID: "456",
+				Author:        web.CommentAuthorResponse{UserID: "123", Username: "owner"},
+				Body:          "Was this charged twice?",
+				CreatedAt:     commentCreatedAt,
+				UpdatedAt:     commentCreatedAt,
+			},
+		},
+		{
+			name: "Participant Comments",
+			req:  web.CommentRequest{TransactionID: "456", UserID: "124", Body: "Yes, I see it too."},
+			want: web.CommentResponse{
+				TransactionID: "456",
+				Author:        web.CommentAuthorResponse{UserID: "124", Username: "participant"},
+				Body:          "Yes, I see it too.",
+				CreatedAt:     commentCreatedAt,
+				UpdatedAt:     commentCreatedAt,
+			},
+		},
+		{
+			name: "Admin Comments",
+			req:  web.CommentRequest{TransactionID: "456", UserID: "126", Body: "We are looking into it."},
+			want: web.CommentResponse{
+				TransactionID: "456",
+				Author:        web.CommentAuthorResponse{UserID: "126", Username: "support"},
+				Body:          "We are looking into it.",
+				CreatedAt:     commentCreatedAt,
+				UpdatedAt:     commentCreatedAt,
+			},
+		},
+		{
+			name:    "Stranger Cannot Comment",
+			req:     web.CommentRequest{TransactionID: "456", UserID: "125", Body: "Hello"},
+			wantErr: comment.ErrTransactionNotFound,
+		},
+		{
+			name:    "Transaction Not Found",
+			req:     web.CommentRequest{TransactionID: "404", UserID: "123", Body: "Hello"},
+			wantErr: comment.ErrTransactionNotFound,
+		},
+		{
+			name:                "Blank Body",
+			req:                 web.CommentRequest{TransactionID: "456", UserID: "123", Body: "   "},
+			wantValidationError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockCommentRepository := newCommentService(ctx)
+			mockCommentRepository.On("InsertComment", ctx, mock.Anything).Return(func(ctx context.Context, comment entity.Comment) entity.Comment {
+				comment.CreatedAt, comment.UpdatedAt = commentCreatedAt, commentCreatedAt
+				return comment
+			}, nil)
+
+			got, err := service.CreateComment(ctx, tt.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.CreateComment() want validation error")
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.CreateComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				mockCommentRepository.AssertNotCalled(t, "InsertComment", ctx, mock.Anything)
+				return
+			}
+			assert.NotEmpty(t, got.CommentID)
+			got.CommentID = ""
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommentService_GetCommentByTransactionId(t *testing.T) {
+	editedAt := commentCreatedAt.Add(time.Hour)
+	thread := []entity.Comment{
+		{
+			CommentID: "1", TransactionID: "456", AuthorID: &commentOwnerID, Author: &entity.User{UserID: "123", Username: "owner"},
+			Body: "Was this charged twice?", CreatedAt: commentCreatedAt, UpdatedAt: editedAt,
+			Revisions: []entity.CommentRevision{{RevisionID: "r1", CommentID: "1", Body: "Was this charged 2x?", CreatedAt: editedAt}},
+		},
+		{
+			CommentID: "2", TransactionID: "456", AuthorID: &commentParticipantID, Author: &entity.User{UserID: "124", Username: "participant"},
+			Body: "Never mind", CreatedAt: editedAt, UpdatedAt: editedAt,
+			DeletedAt: gorm.DeletedAt{Time: editedAt, Valid: true},
+			Revisions: []entity.CommentRevision{{RevisionID: "r2", CommentID: "2", Body: "Something private", CreatedAt: editedAt}},
+		},
+		{
+			CommentID: "3", TransactionID: "456", Body: "Refund requested", CreatedAt: editedAt, UpdatedAt: editedAt,
+		},
+	}
+	tests := []struct {
+		name    string
+		userId  string
+		want    []web.CommentResponse
+		wantErr bool
+	}{
+		{
+			name:   "Participant Reads Thread",
+			userId: "124",
+			want: []web.CommentResponse{
+				{
+					CommentID: "1", TransactionID: "456", Author: web.CommentAuthorResponse{UserID: "123", Username: "owner"},
+					Body: "Was this charged twice?", Edited: true, CreatedAt: commentCreatedAt, UpdatedAt: editedAt,
+					History: []web.CommentRevisionResponse{{Body: "Was this charged 2x?", ReplacedAt: editedAt}},
+				},
+				{
+					CommentID: "2", TransactionID: "456", Author: web.CommentAuthorResponse{UserID: "124", Username: "participant"},
+					Edited: true, Deleted: true, CreatedAt: editedAt, UpdatedAt: editedAt,
+				},
+				{
+					CommentID: "3", TransactionID: "456", Author: web.CommentAuthorResponse{Deleted: true},
+					Body: "Refund requested", CreatedAt: editedAt, UpdatedAt: editedAt,
+				},
+			},
+		},
+		{
+			name:    "Stranger Cannot Read Thread",
+			userId:  "125",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockCommentRepository := newCommentService(ctx)
+			mockCommentRepository.On("FindCommentByTransactionId", ctx, "456").Return(thread, nil)
+
+			got, err := service.GetCommentByTransactionId(ctx, tt.userId, "456")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.GetCommentByTransactionId() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.GetCommentByTransactionId() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommentService_UpdateComment(t *testing.T) {
+	editedAt := commentCreatedAt.Add(time.Hour)
+	comments := map[string]entity.Comment{
+		"1": {CommentID: "1", TransactionID: "456", AuthorID: &commentOwnerID, Author: &entity.User{UserID: "123", Username: "owner"}, Body: "Charged 2x?", CreatedAt: commentCreatedAt, UpdatedAt: commentCreatedAt},
+		"2": {CommentID: "2", TransactionID: "456", AuthorID: &commentOwnerID, Body: "Gone", DeletedAt: gorm.DeletedAt{Time: editedAt, Valid: true}},
+		"3": {CommentID: "3", TransactionID: "789", AuthorID: &commentOwnerID, Body: "Elsewhere"},
+	}
+	tests := []struct {
+		name         string
+		req          web.CommentRequest
+		wantRevision string
+		want         web.CommentResponse
+		wantErr      error
+	}{
+		{
+			name:         "Author Edits Comment",
+			req:          web.CommentRequest{TransactionID: "456", CommentID: "1", UserID: "123", Body: "Charged twice?"},
+			wantRevision: "Charged 2x?",
+			want: web.CommentResponse{
+				CommentID: "1", TransactionID: "456", Author: web.CommentAuthorResponse{UserID: "123", Username: "owner"},
+				Body: "Charged twice?", Edited: true, CreatedAt: commentCreatedAt, UpdatedAt: editedAt,
+				History: []web.CommentRevisionResponse{{Body: "Charged 2x?", ReplacedAt: editedAt}},
+			},
+		},
+		{
+			name: "Unchanged Body Is Not Recorded",
+			req:  web.CommentRequest{TransactionID: "456", CommentID: "1", UserID: "123", Body: "Charged 2x?"},
+			want: web.CommentResponse{
+				CommentID: "1", TransactionID: "456", Author: web.CommentAuthorResponse{UserID: "123", Username: "owner"},
+				Body: "Charged 2x?", CreatedAt: commentCreatedAt, UpdatedAt: commentCreatedAt,
+			},
+		},
+		{
+			name:    "Admin Cannot Edit Someone Else's Comment",
+			req:     web.CommentRequest{TransactionID: "456", CommentID: "1", UserID: "126", Body: "Edited"},
+			wantErr: comment.ErrCommentForbidden,
+		},
+		{
+			name:    "Deleted Comment",
+			req:     web.CommentRequest{TransactionID: "456", CommentID: "2", UserID: "123", Body: "Back"},
+			wantErr: comment.ErrCommentNotFound,
+		},
+		{
+			name:    "Comment Of Another Transaction",
+			req:     web.CommentRequest{TransactionID: "456", CommentID: "3", UserID: "123", Body: "Moved"},
+			wantErr: comment.ErrCommentNotFound,
+		},
+		{
+			name:    "Comment Not Found",
+			req:     web.CommentRequest{TransactionID: "456", CommentID: "404", UserID: "123", Body: "Hello"},
+			wantErr: comment.ErrCommentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockCommentRepository := newCommentService(ctx)
+			for id, comment := range comments {
+				mockCommentRepository.On("FindCommentByID", ctx, id).Return(comment, nil)
+			}
+			mockCommentRepository.On("FindCommentByID", ctx, "404").Return(entity.Comment{}, errors.New("record not found"))
+			mockCommentRepository.On("UpdateComment", ctx, mock.Anything, mock.Anything).Return(func(ctx context.Context, comment entity.Comment, revision entity.CommentRevision) entity.Comment {
+				revision.CreatedAt = editedAt
+				comment.UpdatedAt = editedAt
+				comment.Revisions = append(comment.Revisions, revision)
+				return comment
+			}, nil)
+
+			got, err := service.UpdateComment(ctx, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.UpdateComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantRevision == "" {
+				mockCommentRepository.AssertNotCalled(t, "UpdateComment", ctx, mock.Anything, mock.Anything)
+			} else {
+				mockCommentRepository.AssertCalled(t, "UpdateComment", ctx, mock.Anything, mock.MatchedBy(func(revision entity.CommentRevision) bool {
+					return revision.CommentID == tt.req.CommentID && revision.Body == tt.wantRevision && revision.RevisionID != ""
+				}))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.UpdateComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommentService_RemoveComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr error
+	}{
+		{name: "Author Removes Comment", userId: "123", wantErr: nil},
+		{name: "Admin Removes Comment", userId: "126", wantErr: nil},
+		{name: "Participant Cannot Remove Someone Else's Comment", userId: "124", wantErr: comment.ErrCommentForbidden},
+		{name: "Stranger Cannot Remove Comment", userId: "125", wantErr: comment.ErrTransactionNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockCommentRepository := newCommentService(ctx)
+			mockCommentRepository.On("FindCommentByID", ctx, "1").Return(entity.Comment{CommentID: "1", TransactionID: "456", AuthorID: &commentOwnerID, Body: "Charged twice?"}, nil)
+			mockCommentRepository.On("DeleteComment", ctx, "1").Return(nil)
+
+			err := service.RemoveComment(ctx, web.CommentRequest{TransactionID: "456", CommentID: "1", UserID: tt.userId})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.RemoveComment() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil {
+				mockCommentRepository.AssertCalled(t, "DeleteComment", ctx, "1")
+			} else {
+				mockCommentRepository.AssertNotCalled(t, "DeleteComment", ctx, "1")
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	mockPublisher "github.com/vnnyx/golang-dot-api/infrastructure/event/mocks"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockAttachmentRepository "github.com/vnnyx/golang-dot-api/repository/attachment/mocks"
+	disputeRepository "github.com/vnnyx/golang-dot-api/repository/dispute"
+	mockDisputeRepository "github.com/vnnyx/golang-dot-api/repository/dispute/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	mockUserRepository "github.com/vnnyx/golang-dot-api/repository/user/mocks"
+	"github.com/vnnyx/golang-dot-api/service/dispute"
+)
+
+var (
+	disputeCreatedAt = time.Date(2023, 3, 10, 8, 0, 0, 0, time.UTC)
+	disputeUsers     = map[string]entity.User{
+		"123": {UserID: "123", Username: "owner", Role: entity.UserRoleMember},
+		"125": {UserID: "125", Username: "stranger", Role: entity.UserRoleMember},
+		"126": {UserID: "126", Username: "support", Role: entity.UserRoleAdmin},
+	}
+	disputeTransactions = map[string]entity.Transaction{
+		"456": {TransactionID: "456", UserID: "123", Name: "Dinner"},
+		"457": {TransactionID: "457", UserID: "123", Name: "Taxi", OnHold: true},
+	}
+	disputeAttachments = map[string]entity.Attachment{
+		"a1": {AttachmentID: "a1", TransactionID: "456", FileName: "receipt.pdf", ContentType: "application/pdf", Size: 1024, CreatedAt: disputeCreatedAt},
+		"a2": {AttachmentID: "a2", TransactionID: "456", FileName: "statement.png", ContentType: "image/png", Size: 2048, CreatedAt: disputeCreatedAt},
+		"a3": {AttachmentID: "a3", TransactionID: "457", FileName: "taxi.pdf", ContentType: "application/pdf", Size: 512, CreatedAt: disputeCreatedAt},
+		"a4": {AttachmentID: "a4", TransactionID: "457", FileName: "chat.png", ContentType: "image/png", Size: 256, CreatedAt: disputeCreatedAt},
+		"a9": {AttachmentID: "a9", TransactionID: "999", FileName: "other.png", ContentType: "image/png", Size: 10, CreatedAt: disputeCreatedAt},
+	}
+	disputes = map[string]entity.Dispute{
+		"d1": {DisputeID: "d1", TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate, Status: entity.DisputeStatusOpen, CreatedAt: disputeCreatedAt, UpdatedAt: disputeCreatedAt,
+			Evidence: []entity.DisputeEvidence{{DisputeID: "d1", AttachmentID: "a3", Attachment: &entity.Attachment{AttachmentID: "a3", TransactionID: "457", FileName: "taxi.pdf"}}}},
+		"d2": {DisputeID: "d2", TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonFraudulent, Status: entity.DisputeStatusUnderReview, CreatedAt: disputeCreatedAt, UpdatedAt: disputeCreatedAt},
+		"d3": {DisputeID: "d3", TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonCancelled, Status: entity.DisputeStatusLost, CreatedAt: disputeCreatedAt, UpdatedAt: disputeCreatedAt},
+	}
+)
+
+// newDisputeService returns a dispute service whose users, transactions,
+// attachments and disputes come from the fixtures above, with the dispute
+// repository and publisher mocks to set up.
+func newDisputeService(ctx context.Context) (dispute.DisputeService, *mockDisputeRepository.DisputeRepository, *mockPublisher.Publisher) {
+	mockDisputeRepository := new(mockDisputeRepository.DisputeRepository)
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockAttachmentRepository := new(mockAttachmentRepository.AttachmentRepository)
+	mockUserRepository := new(mockUserRepository.UserRepository)
+	mockPublisher := new(mockPublisher.Publisher)
+
+	for id, user := range disputeUsers {
+		mockUserRepository.On("FindUserByID", ctx, id).Return(user, nil)
+	}
+	for id, transaction := range disputeTransactions {
+		mockTransactionRepository.On("FindTransactionByID", ctx, id).Return(transaction, nil)
+	}
+	mockTransactionRepository.On("FindTransactionByID", ctx, "404").Return(entity.Transaction{}, errors.New("record not found"))
+	for id, attachment := range disputeAttachments {
+		mockAttachmentRepository.On("FindAttachmentByID", ctx, id).Return(attachment, nil)
+	}
+	for id, dispute := range disputes {
+		mockDisputeRepository.On("FindDisputeByID", ctx, id).Return(dispute, nil)
+	}
+	mockDisputeRepository.On("FindDisputeByID", ctx, "404").Return(entity.Dispute{}, errors.New("record not found"))
+
+	return dispute.NewDisputeService(mockDisputeRepository, mockTransactionRepository, mockAttachmentRepository, mockUserRepository, mockPublisher), mockDisputeRepository, mockPublisher
+}
+
+func TestDisputeService_OpenDispute(t *testing.T) {
+	tests := []struct {
+		name                string
+		req                 web.DisputeCreateRequest
+		insertErr           error
+		publishErr          error
+		want                web.DisputeResponse
+		wantErr             error
+		wantValidationError bool
+	}{
+		{
+			name: "Owner Opens Dispute With Evidence",
+			req:  web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate, Description: " Charged twice ", EvidenceIDs: []string{"a1", "a2", "a1"}},
+			want: web.DisputeResponse{
+				TransactionID: "456",
+				UserID:        "123",
+				ReasonCode:    entity.DisputeReasonDuplicate,
+				Description:   "Charged twice",
+				Status:        entity.DisputeStatusOpen,
+				Evidence: []web.AttachmentResponse{
+					{AttachmentID: "a1", TransactionID: "456", FileName: "receipt.pdf", ContentType: "application/pdf", Size: 1024, CreatedAt: disputeCreatedAt},
+					{AttachmentID: "a2", TransactionID: "456", FileName: "statement.png", ContentType: "image/png", Size: 2048, CreatedAt: disputeCreatedAt},
+				},
+				CreatedAt: disputeCreatedAt,
+				UpdatedAt: disputeCreatedAt,
+			},
+		},
+		{
+			name:       "Publish Failure Keeps The Dispute",
+			req:        web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: entity.DisputeReasonNotReceived},
+			publishErr: errors.New("broker unavailable"),
+			want: web.DisputeResponse{
+				TransactionID: "456",
+				UserID:        "123",
+				ReasonCode:    entity.DisputeReasonNotReceived,
+				Status:        entity.DisputeStatusOpen,
+				Evidence:      []web.AttachmentResponse{},
+				CreatedAt:     disputeCreatedAt,
+				UpdatedAt:     disputeCreatedAt,
+			},
+		},
+		{
+			name:    "Transaction Already On Hold",
+			req:     web.DisputeCreateRequest{TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate},
+			wantErr: dispute.ErrDisputeAlreadyOpen,
+		},
+		{
+			name:      "Dispute Opened Concurrently",
+			req:       web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate},
+			insertErr: disputeRepository.ErrTransactionOnHold,
+			wantErr:   dispute.ErrDisputeAlreadyOpen,
+		},
+		{
+			name:    "Admin Cannot Open For The Owner",
+			req:     web.DisputeCreateRequest{TransactionID: "456", UserID: "126", ReasonCode: entity.DisputeReasonDuplicate},
+			wantErr: dispute.ErrTransactionNotFound,
+		},
+		{
+			name:    "Stranger Cannot Open",
+			req:     web.DisputeCreateRequest{TransactionID: "456", UserID: "125", ReasonCode: entity.DisputeReasonDuplicate},
+			wantErr: dispute.ErrTransactionNotFound,
+		},
+		{
+			name:    "Transaction Not Found",
+			req:     web.DisputeCreateRequest{TransactionID: "404", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate},
+			wantErr: dispute.ErrTransactionNotFound,
+		},
+		{
+			name:    "Evidence From Another Transaction",
+			req:     web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate, EvidenceIDs: []string{"a1", "a9"}},
+			wantErr: dispute.ErrAttachmentNotFound,
+		},
+		{
+			name:                "Unknown Reason Code",
+			req:                 web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: "changed_my_mind"},
+			wantValidationError: true,
+		},
+		{
+			name:                "Other Reason Without Description",
+			req:                 web.DisputeCreateRequest{TransactionID: "456", UserID: "123", ReasonCode: entity.DisputeReasonOther, Description: "  "},
+			wantValidationError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockDisputeRepository, mockPublisher := newDisputeService(ctx)
+			mockDisputeRepository.On("InsertDispute", ctx, mock.Anything).Return(func(ctx context.Context, dispute entity.Dispute) entity.Dispute {
+				dispute.CreatedAt, dispute.UpdatedAt = disputeCreatedAt, disputeCreatedAt
+				return dispute
+			}, tt.insertErr)
+			mockPublisher.On("Publish", ctx, mock.Anything).Return(tt.publishErr)
+
+			got, err := service.OpenDispute(ctx, tt.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.OpenDispute() want validation error")
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.OpenDispute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				mockPublisher.AssertNotCalled(t, "Publish", ctx, mock.Anything)
+				return
+			}
+			assert.NotEmpty(t, got.DisputeID)
+			mockPublisher.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event model.Event) bool {
+				return event.Type == model.EventDisputeOpened && event.AggregateID == got.DisputeID && event.UserID == "123"
+			}))
+			got.DisputeID = ""
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.OpenDispute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisputeService_GetDisputeById(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     web.DisputeRequest
+		wantErr error
+	}{
+		{name: "Owner Reads Dispute", req: web.DisputeRequest{TransactionID: "457", DisputeID: "d1", UserID: "123"}},
+		{name: "Admin Reads Dispute", req: web.DisputeRequest{TransactionID: "457", DisputeID: "d1", UserID: "126"}},
+		{name: "Stranger Cannot Read", req: web.DisputeRequest{TransactionID: "457", DisputeID: "d1", UserID: "125"}, wantErr: dispute.ErrTransactionNotFound},
+		{name: "Dispute Of Another Transaction", req: web.DisputeRequest{TransactionID: "456", DisputeID: "d1", UserID: "123"}, wantErr: dispute.ErrDisputeNotFound},
+		{name: "Dispute Not Found", req: web.DisputeRequest{TransactionID: "457", DisputeID: "404", UserID: "123"}, wantErr: dispute.ErrDisputeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, _, _ := newDisputeService(ctx)
+
+			got, err := service.GetDisputeById(ctx, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.GetDisputeById() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && (got.DisputeID != "d1" || len(got.Evidence) != 1) {
+				t.Errorf("service.GetDisputeById() = %v, want dispute d1 with its evidence", got)
+			}
+		})
+	}
+}
+
+func TestDisputeService_AddDisputeEvidence(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          web.DisputeEvidenceRequest
+		wantInserted []string
+		wantErr      error
+	}{
+		{
+			name:         "Owner Adds Evidence",
+			req:          web.DisputeEvidenceRequest{TransactionID: "457", DisputeID: "d1", UserID: "123", EvidenceIDs: []string{"a3", "a4", "a4"}},
+			wantInserted: []string{"a4"},
+		},
+		{
+			name:    "Admin Cannot Add Evidence",
+			req:     web.DisputeEvidenceRequest{TransactionID: "457", DisputeID: "d1", UserID: "126", EvidenceIDs: []string{"a4"}},
+			wantErr: dispute.ErrDisputeNotFound,
+		},
+		{
+			name:    "Resolved Dispute",
+			req:     web.DisputeEvidenceRequest{TransactionID: "457", DisputeID: "d3", UserID: "123", EvidenceIDs: []string{"a4"}},
+			wantErr: dispute.ErrDisputeClosed,
+		},
+		{
+			name:    "Evidence From Another Transaction",
+			req:     web.DisputeEvidenceRequest{TransactionID: "457", DisputeID: "d2", UserID: "123", EvidenceIDs: []string{"a1"}},
+			wantErr: dispute.ErrAttachmentNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockDisputeRepository, _ := newDisputeService(ctx)
+			mockDisputeRepository.On("InsertDisputeEvidence", ctx, mock.Anything).Return(nil)
+
+			got, err := service.AddDisputeEvidence(ctx, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.AddDisputeEvidence() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr != nil {
+				mockDisputeRepository.AssertNotCalled(t, "InsertDisputeEvidence", ctx, mock.Anything)
+				return
+			}
+			mockDisputeRepository.AssertCalled(t, "InsertDisputeEvidence", ctx, mock.MatchedBy(func(evidence []entity.DisputeEvidence) bool {
+				var inserted []string
+				for _, item := range evidence {
+					inserted = append(inserted, item.AttachmentID)
+				}
+				return reflect.DeepEqual(inserted, tt.wantInserted)
+			}))
+			if len(got.Evidence) != 2 || got.Evidence[1].AttachmentID != "a4" {
+				t.Errorf("service.AddDisputeEvidence() evidence = %v, want a3 and a4", got.Evidence)
+			}
+		})
+	}
+}
+
+func TestDisputeService_GetAllDispute(t *testing.T) {
+	ctx := context.TODO()
+	service, mockDisputeRepository, _ := newDisputeService(ctx)
+	mockDisputeRepository.On("FindDisputeByStatus", ctx, []string{entity.DisputeStatusOpen}).Return([]entity.Dispute{disputes["d1"]}, nil)
+
+	got, err := service.GetAllDispute(ctx, web.DisputeListRequest{UserID: "126", Status: []string{entity.DisputeStatusOpen}})
+	if err != nil || len(got) != 1 || got[0].DisputeID != "d1" {
+		t.Errorf("service.GetAllDispute() = %v, %v, want dispute d1", got, err)
+	}
+
+	_, err = service.GetAllDispute(ctx, web.DisputeListRequest{UserID: "123"})
+	if !errors.Is(err, dispute.ErrAdminRequired) {
+		t.Errorf("service.GetAllDispute() error = %v, want ADMIN_REQUIRED", err)
+	}
+}
+
+func TestDisputeService_ReviewDispute(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        web.DisputeRequest
+		changed    bool
+		wantStatus string
+		wantUpdate bool
+		wantErr    error
+	}{
+		{name: "Admin Reviews Open Dispute", req: web.DisputeRequest{DisputeID: "d1", UserID: "126"}, wantStatus: entity.DisputeStatusUnderReview, wantUpdate: true},
+		{name: "Changed By Another Admin", req: web.DisputeRequest{DisputeID: "d1", UserID: "126"}, changed: true, wantErr: dispute.ErrDisputeChanged},
+		{name: "Already Under Review", req: web.DisputeRequest{DisputeID: "d2", UserID: "126"}, wantStatus: entity.DisputeStatusUnderReview},
+		{name: "Resolved Dispute", req: web.DisputeRequest{DisputeID: "d3", UserID: "126"}, wantErr: dispute.ErrDisputeClosed},
+		{name: "Dispute Not Found", req: web.DisputeRequest{DisputeID: "404", UserID: "126"}, wantErr: dispute.ErrDisputeNotFound},
+		{name: "Owner Cannot Review", req: web.DisputeRequest{DisputeID: "d1", UserID: "123"}, wantErr: dispute.ErrAdminRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockDisputeRepository, mockPublisher := newDisputeService(ctx)
+			mockDisputeRepository.On("UpdateDispute", ctx, mock.Anything, mock.Anything).Return(func(ctx context.Context, dispute entity.Dispute, fromStatus string) entity.Dispute {
+				return dispute
+			}, func(ctx context.Context, dispute entity.Dispute, fromStatus string) error {
+				if tt.changed {
+					return disputeRepository.ErrDisputeChanged
+				}
+				return nil
+			})
+			mockPublisher.On("Publish", ctx, mock.Anything).Return(nil)
+
+			got, err := service.ReviewDispute(ctx, tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.ReviewDispute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.changed {
+				mockDisputeRepository.AssertCalled(t, "UpdateDispute", ctx, mock.Anything, entity.DisputeStatusOpen)
+				mockPublisher.AssertNotCalled(t, "Publish", ctx, mock.Anything)
+				return
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("service.ReviewDispute() status = %v, want %v", got.Status, tt.wantStatus)
+			}
+			if !tt.wantUpdate {
+				mockDisputeRepository.AssertNotCalled(t, "UpdateDispute", ctx, mock.Anything, mock.Anything)
+				mockPublisher.AssertNotCalled(t, "Publish", ctx, mock.Anything)
+				return
+			}
+			mockPublisher.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event model.Event) bool {
+				return event.Type == model.EventDisputeUnderReview && event.AggregateID == "d1" && event.UserID == "126"
+			}))
+		})
+	}
+}
+
+func TestDisputeService_ResolveDispute(t *testing.T) {
+	resolvedAt := time.Date(2023, 3, 20, 9, 0, 0, 0, time.UTC)
+	admin := "126"
+	tests := []struct {
+		name                string
+		req                 web.DisputeResolveRequest
+		changed             bool
+		want                entity.Dispute
+		wantFromStatus      string
+		wantEvent           string
+		wantErr             error
+		wantValidationError bool
+	}{
+		{
+			name: "Dispute Won",
+			req:  web.DisputeResolveRequest{DisputeID: "d1", UserID: "126", Outcome: entity.DisputeStatusWon, ResolutionNote: " Refunded by the merchant "},
+			want: entity.Dispute{DisputeID: "d1", TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonDuplicate, Status: entity.DisputeStatusWon,
+				ResolutionNote: "Refunded by the merchant", ResolvedBy: &admin, ResolvedAt: &resolvedAt, CreatedAt: disputeCreatedAt, UpdatedAt: disputeCreatedAt,
+				Evidence: disputes["d1"].Evidence},
+			wantFromStatus: entity.DisputeStatusOpen,
+			wantEvent:      model.EventDisputeWon,
+		},
+		{
+			name: "Dispute Under Review Lost",
+			req:  web.DisputeResolveRequest{DisputeID: "d2", UserID: "126", Outcome: entity.DisputeStatusLost},
+			want: entity.Dispute{DisputeID: "d2", TransactionID: "457", UserID: "123", ReasonCode: entity.DisputeReasonFraudulent, Status: entity.DisputeStatusLost,
+				ResolvedBy: &admin, ResolvedAt: &resolvedAt, CreatedAt: disputeCreatedAt, UpdatedAt: disputeCreatedAt},
+			wantFromStatus: entity.DisputeStatusUnderReview,
+			wantEvent:      model.EventDisputeLost,
+		},
+		{
+			name:    "Resolved By Another Admin Meanwhile",
+			req:     web.DisputeResolveRequest{DisputeID: "d1", UserID: "126", Outcome: entity.DisputeStatusLost},
+			changed: true,
+			wantErr: dispute.ErrDisputeChanged,
+		},
+		{
+			name:    "Already Resolved",
+			req:     web.DisputeResolveRequest{DisputeID: "d3", UserID: "126", Outcome: entity.DisputeStatusWon},
+			wantErr: dispute.ErrDisputeClosed,
+		},
+		{
+			name:    "Owner Cannot Resolve",
+			req:     web.DisputeResolveRequest{DisputeID: "d1", UserID: "123", Outcome: entity.DisputeStatusWon},
+			wantErr: dispute.ErrAdminRequired,
+		},
+		{
+			name:                "Outcome Must Be Final",
+			req:                 web.DisputeResolveRequest{DisputeID: "d1", UserID: "126", Outcome: entity.DisputeStatusUnderReview},
+			wantValidationError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			service, mockDisputeRepository, mockPublisher := newDisputeService(ctx)
+			mockDisputeRepository.On("UpdateDispute", ctx, mock.Anything, mock.Anything).Return(func(ctx context.Context, dispute entity.Dispute, fromStatus string) entity.Dispute {
+				return dispute
+			}, func(ctx context.Context, dispute entity.Dispute, fromStatus string) error {
+				if tt.changed {
+					return disputeRepository.ErrDisputeChanged
+				}
+				return nil
+			})
+			mockPublisher.On("Publish", ctx, mock.Anything).Return(nil)
+
+			now := gomonkey.ApplyFunc(time.Now, func() time.Time {
+				return resolvedAt
+			})
+			defer now.Reset()
+
+			_, err := service.ResolveDispute(ctx, tt.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.ResolveDispute() want validation error")
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("service.ResolveDispute() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.changed {
+				mockPublisher.AssertNotCalled(t, "Publish", ctx, mock.Anything)
+				return
+			}
+			if tt.wantErr != nil {
+				mockDisputeRepository.AssertNotCalled(t, "UpdateDispute", ctx, mock.Anything, mock.Anything)
+				return
+			}
+			mockDisputeRepository.AssertCalled(t, "UpdateDispute", ctx, tt.want, tt.wantFromStatus)
+			mockPublisher.AssertCalled(t, "Publish", ctx, mock.MatchedBy(func(event model.Event) bool {
+				return event.Type == tt.wantEvent && event.AggregateID == tt.want.DisputeID && event.OccurredAt.Equal(resolvedAt)
+			}))
+		})
+	}
+}
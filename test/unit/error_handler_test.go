@@ -0,0 +1,268 @@
+package unit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/labstack/echo/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+	"github.com/vnnyx/golang-dot-api/i18n"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/service/dispute"
+	"github.com/vnnyx/golang-dot-api/service/transaction"
+	"github.com/vnnyx/golang-dot-api/util"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+func TestAppError(t *testing.T) {
+	cause := errors.New("invalid character")
+	wrapped := util.ErrInvalidPatch.Wrap(cause)
+
+	if !errors.Is(wrapped, util.ErrInvalidPatch) {
+		t.Errorf("errors.Is(%v, ErrInvalidPatch) = false, want true", wrapped)
+	}
+	if !errors.Is(wrapped, cause) {
+		t.Errorf("errors.Is(%v, cause) = false, want true", wrapped)
+	}
+	if wrapped.Error() != "INVALID_PATCH: invalid character" {
+		t.Errorf("wrapped.Error() = %q, want %q", wrapped.Error(), "INVALID_PATCH: invalid character")
+	}
+	if util.ErrInvalidPatch.Cause != nil {
+		t.Errorf("Wrap() changed the sentinel cause to %v", util.ErrInvalidPatch.Cause)
+	}
+
+	if !errors.Is(transaction.ErrTransactionNotFound, dispute.ErrTransactionNotFound) {
+		t.Errorf("errors with the same code are not the same error")
+	}
+	if errors.Is(transaction.ErrTransactionNotFound, transaction.ErrUserNotFound) {
+		t.Errorf("errors with different codes are the same error")
+	}
+
+	var appError *apperror.Error
+	if !errors.As(fmt.Errorf("update: %w", transaction.ErrTransactionOnHold), &appError) || appError.Status != http.StatusConflict {
+		t.Errorf("errors.As() = %v, want TRANSACTION_ON_HOLD", appError)
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCode  int
+		wantError map[string]interface{}
+	}{
+		{
+			name:      "Domain Not Found",
+			err:       transaction.ErrTransactionNotFound,
+			wantCode:  http.StatusNotFound,
+			wantError: map[string]interface{}{"transaction_id": "NOT_FOUND"},
+		},
+		{
+			name:      "Wrapped Domain Error",
+			err:       fmt.Errorf("open dispute: %w", dispute.ErrDisputeAlreadyOpen),
+			wantCode:  http.StatusConflict,
+			wantError: map[string]interface{}{"transaction_id": "already has an open dispute"},
+		},
+		{
+			name:      "Domain Error Without Field",
+			err:       apperror.New(http.StatusForbidden, "FORBIDDEN", "", "Forbidden"),
+			wantCode:  http.StatusForbidden,
+			wantError: map[string]interface{}{"message": "Forbidden"},
+		},
+		{
+			name:      "Echo Method Not Allowed",
+			err:       echo.ErrMethodNotAllowed,
+			wantCode:  http.StatusMethodNotAllowed,
+			wantError: map[string]interface{}{"message": "Method Not Allowed"},
+		},
+		{
+			name:      "Wrapped Duplicate Key",
+			err:       fmt.Errorf("insert user: %w", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'dot@example.com' for key 'users.email'"}),
+			wantCode:  http.StatusBadRequest,
+			wantError: map[string]interface{}{"email": "must be unique"},
+		},
+		{
+			name:      "Unknown Error",
+			err:       errors.New("connection refused"),
+			wantCode:  http.StatusInternalServerError,
+			wantError: map[string]interface{}{"message": "Internal server error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			recorder := httptest.NewRecorder()
+			exception.ErrorHandler(tt.err, echo.New().NewContext(request, recorder))
+
+			if recorder.Code != tt.wantCode {
+				t.Errorf("ErrorHandler() code = %d, want %d", recorder.Code, tt.wantCode)
+			}
+			var response web.WebResponse
+			_ = json.Unmarshal(recorder.Body.Bytes(), &response)
+			if response.Code != tt.wantCode {
+				t.Errorf("ErrorHandler() body code = %d, want %d", response.Code, tt.wantCode)
+			}
+			if !reflect.DeepEqual(response.Error, tt.wantError) {
+				t.Errorf("ErrorHandler() error = %v, want %v", response.Error, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestErrorHandler_Problem(t *testing.T) {
+	validation := exception.ValidationError{Message: `{"amount":"cannot be blank","items":{"0":{"name":"cannot be blank"}}}`}
+	tests := []struct {
+		name            string
+		format          string
+		accept          string
+		err             error
+		wantContentType string
+		wantProblem     web.ProblemResponse
+	}{
+		{
+			name:            "Default Format",
+			format:          exception.FormatDefault,
+			accept:          echo.MIMEApplicationJSON,
+			err:             transaction.ErrTransactionNotFound,
+			wantContentType: echo.MIMEApplicationJSONCharsetUTF8,
+		},
+		{
+			name:            "Accept Header",
+			format:          exception.FormatDefault,
+			accept:          "application/problem+json; q=0.9, application/json",
+			err:             transaction.ErrTransactionNotFound,
+			wantContentType: exception.MIMEApplicationProblemJSON,
+			wantProblem: web.ProblemResponse{
+				Type:          "urn:dot-api:problem:transaction-not-found",
+				Title:         "Not Found",
+				Status:        http.StatusNotFound,
+				Instance:      "/dot-api/transaction/456?currency=USD",
+				Code:          "TRANSACTION_NOT_FOUND",
+				InvalidParams: []web.InvalidParam{{Name: "transaction_id", Reason: "NOT_FOUND"}},
+			},
+		},
+		{
+			name:            "Config Format",
+			format:          exception.FormatProblem,
+			err:             echo.ErrNotFound,
+			wantContentType: exception.MIMEApplicationProblemJSON,
+			wantProblem: web.ProblemResponse{
+				Type:     "about:blank",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   "Not Found",
+				Instance: "/dot-api/transaction/456?currency=USD",
+			},
+		},
+		{
+			name:            "Validation Error",
+			format:          exception.FormatProblem,
+			err:             validation,
+			wantContentType: exception.MIMEApplicationProblemJSON,
+			wantProblem: web.ProblemResponse{
+				Type:     "urn:dot-api:problem:validation-error",
+				Title:    "Bad Request",
+				Status:   http.StatusBadRequest,
+				Instance: "/dot-api/transaction/456?currency=USD",
+				Code:     "VALIDATION_ERROR",
+				InvalidParams: []web.InvalidParam{
+					{Name: "amount", Reason: "cannot be blank"},
+					{Name: "items.0.name", Reason: "cannot be blank"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/dot-api/transaction/456?currency=USD", nil)
+			request.Header.Set(echo.HeaderAccept, tt.accept)
+			recorder := httptest.NewRecorder()
+			exception.NewErrorHandler(tt.format)(tt.err, echo.New().NewContext(request, recorder))
+
+			if contentType := recorder.Header().Get(echo.HeaderContentType); contentType != tt.wantContentType {
+				t.Fatalf("ErrorHandler() content type = %q, want %q", contentType, tt.wantContentType)
+			}
+			if tt.wantContentType != exception.MIMEApplicationProblemJSON {
+				return
+			}
+			var problem web.ProblemResponse
+			_ = json.Unmarshal(recorder.Body.Bytes(), &problem)
+			if recorder.Code != tt.wantProblem.Status {
+				t.Errorf("ErrorHandler() code = %d, want %d", recorder.Code, tt.wantProblem.Status)
+			}
+			if !reflect.DeepEqual(problem, tt.wantProblem) {
+				t.Errorf("ErrorHandler() problem = %+v, want %+v", problem, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestErrorHandler_Locale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		preference     string
+		err            error
+		wantLanguage   string
+		wantError      map[string]interface{}
+	}{
+		{
+			name:           "Validation Error In Indonesian",
+			acceptLanguage: "id-ID,id;q=0.9",
+			err:            validation.CreateTagValidation(web.TagCreateRequest{Name: strings.Repeat("a", 51)}),
+			wantLanguage:   i18n.IdID,
+			wantError:      map[string]interface{}{"name": "panjangnya harus antara 1 dan 50"},
+		},
+		{
+			name:           "Domain Error In Indonesian",
+			acceptLanguage: "id",
+			err:            dispute.ErrDisputeAlreadyOpen,
+			wantLanguage:   i18n.IdID,
+			wantError:      map[string]interface{}{"transaction_id": "sudah memiliki sengketa yang masih terbuka"},
+		},
+		{
+			name:           "User Preference Over Header",
+			acceptLanguage: "id-ID",
+			preference:     i18n.EnUS,
+			err:            transaction.ErrTransactionNotFound,
+			wantLanguage:   i18n.EnUS,
+			wantError:      map[string]interface{}{"transaction_id": "NOT_FOUND"},
+		},
+		{
+			name:         "Untranslated Validation Message",
+			preference:   i18n.IdID,
+			err:          exception.ValidationError{Message: `{"file":"line 3: invalid amount"}`},
+			wantLanguage: i18n.IdID,
+			wantError:    map[string]interface{}{"file": "line 3: invalid amount"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodGet, "/", nil)
+			request.Header.Set("Accept-Language", tt.acceptLanguage)
+			recorder := httptest.NewRecorder()
+			ctx := echo.New().NewContext(request, recorder)
+			if tt.preference != "" {
+				ctx.Set("currentLocale", tt.preference)
+			}
+			exception.ErrorHandler(tt.err, ctx)
+
+			if language := recorder.Header().Get("Content-Language"); language != tt.wantLanguage {
+				t.Errorf("ErrorHandler() Content-Language = %q, want %q", language, tt.wantLanguage)
+			}
+			var response web.WebResponse
+			_ = json.Unmarshal(recorder.Body.Bytes(), &response)
+			if !reflect.DeepEqual(response.Error, tt.wantError) {
+				t.Errorf("ErrorHandler() error = %v, want %v", response.Error, tt.wantError)
+			}
+		})
+	}
+}
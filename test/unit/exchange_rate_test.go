@@ -0,0 +1,247 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	mockExchangeRateRepository "github.com/vnnyx/golang-dot-api/repository/exchange/mocks"
+	"github.com/vnnyx/golang-dot-api/service/exchange"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestRoundHalfEven(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{value: "0.5", want: 0},
+		{value: "1.5", want: 2},
+		{value: "2.5", want: 2},
+		{value: "3.5", want: 4},
+		{value: "-0.5", want: 0},
+		{value: "-1.5", want: -2},
+		{value: "-2.5", want: -2},
+		{value: "2.4999", want: 2},
+		{value: "2.5001", want: 3},
+		{value: "-2.5001", want: -3},
+		{value: "0.3", want: 0},
+		{value: "-0.7", want: -1},
+		{value: "7", want: 7},
+		{value: "1/3", want: 0},
+		{value: "5/2", want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, _ := new(big.Rat).SetString(tt.value)
+			got := util.RoundHalfEven(value)
+			if got.Int64() != tt.want {
+				t.Errorf("util.RoundHalfEven() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertMinorUnits(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		from    string
+		to      string
+		rate    string
+		want    int64
+		wantErr bool
+	}{
+		{name: "Half Rounds Down To Even", amount: 150, from: "EUR", to: "USD", rate: "1.07", want: 160},
+		{name: "Half Rounds Up To Even", amount: 50, from: "EUR", to: "USD", rate: "1.07", want: 54},
+		{name: "Negative Half", amount: -150, from: "EUR", to: "USD", rate: "1.07", want: -160},
+		{name: "Into Currency Without Minor Unit", amount: 1050, from: "USD", to: "JPY", rate: "130", want: 1365},
+		{name: "From Currency Without Minor Unit", amount: 1500, from: "JPY", to: "USD", rate: "0.0075", want: 1125},
+		{name: "Into Currency With Three Decimals", amount: 100, from: "USD", to: "KWD", rate: "0.30665", want: 307},
+		{name: "Too Large", amount: 9223372036854775807, from: "USD", to: "IDR", rate: "15000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, _ := new(big.Rat).SetString(tt.rate)
+			got, err := util.ConvertMinorUnits(tt.amount, tt.from, tt.to, rate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("util.ConvertMinorUnits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("util.ConvertMinorUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateTable_Rate(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2023, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	table, err := util.NewRateTable([]entity.ExchangeRate{
+		{Date: day(10), BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0578"},
+		{Date: day(3), BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0630"},
+		{Date: day(10), BaseCurrency: "EUR", QuoteCurrency: "JPY", Rate: "144.5"},
+		{Date: day(10), BaseCurrency: "USD", QuoteCurrency: "IDR", Rate: "15437.5"},
+	})
+	if err != nil {
+		t.Fatalf("util.NewRateTable() error = %v", err)
+	}
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		date   time.Time
+		want   string
+		wantOk bool
+	}{
+		{name: "Same Currency", from: "IDR", to: "IDR", date: day(1), want: "1", wantOk: true},
+		{name: "Direct", from: "EUR", to: "USD", date: day(10), want: "1.0578", wantOk: true},
+		{name: "Earlier Rate", from: "EUR", to: "USD", date: day(9), want: "1.063", wantOk: true},
+		{name: "Weekend Uses Last Rate", from: "EUR", to: "USD", date: day(12), want: "1.0578", wantOk: true},
+		{name: "Local Day", from: "EUR", to: "USD", date: time.Date(2023, 3, 10, 1, 0, 0, 0, jakarta), want: "1.0578", wantOk: true},
+		{name: "Inverse", from: "USD", to: "EUR", date: day(10), want: "5000/5289", wantOk: true},
+		{name: "Cross Through EUR", from: "USD", to: "JPY", date: day(10), want: "1445000/10578", wantOk: true},
+		{name: "Cross Through USD", from: "EUR", to: "IDR", date: day(10), want: "16329.7875", wantOk: true},
+		{name: "Stale", from: "EUR", to: "USD", date: day(18), wantOk: false},
+		{name: "Before First Rate", from: "EUR", to: "USD", date: day(2), wantOk: false},
+		{name: "Unknown Currency", from: "EUR", to: "GBP", date: day(10), wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := table.Rate(tt.from, tt.to, tt.date)
+			if ok != tt.wantOk {
+				t.Errorf("table.Rate() ok = %v, want %v", ok, tt.wantOk)
+				return
+			}
+			if !ok {
+				return
+			}
+			want, _ := new(big.Rat).SetString(tt.want)
+			if got.Cmp(want) != 0 {
+				t.Errorf("table.Rate() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	_, err = table.Convert(100, "EUR", "GBP", day(10))
+	if err == nil || err.Error() != "EXCHANGE_RATE_NOT_FOUND" {
+		t.Errorf("table.Convert() error = %v, want EXCHANGE_RATE_NOT_FOUND", err)
+	}
+}
+
+func TestParseExchangeRates(t *testing.T) {
+	day := time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		parse   func(string) ([]entity.ExchangeRate, error)
+		content string
+		want    []entity.ExchangeRate
+		wantErr bool
+	}{
+		{
+			name:    "CSV",
+			parse:   csvRates,
+			content: "\ufeffRate,Date,Base,Quote\n15437.50,2023-03-10,usd,IDR\n0.00000001,2023-03-10,IDR,BTC\n",
+			want: []entity.ExchangeRate{
+				{Date: day, BaseCurrency: "USD", QuoteCurrency: "IDR", Rate: "15437.5"},
+				{Date: day, BaseCurrency: "IDR", QuoteCurrency: "BTC", Rate: "0.00000001"},
+			},
+		},
+		{name: "CSV Missing Column", parse: csvRates, content: "date,base,rate\n2023-03-10,USD,1\n", wantErr: true},
+		{name: "CSV Invalid Rate", parse: csvRates, content: "date,base,quote,rate\n2023-03-10,USD,IDR,1/3\n", wantErr: true},
+		{name: "CSV Negative Rate", parse: csvRates, content: "date,base,quote,rate\n2023-03-10,USD,IDR,-1\n", wantErr: true},
+		{name: "CSV Invalid Date", parse: csvRates, content: "date,base,quote,rate\n10/03/2023,USD,IDR,1\n", wantErr: true},
+		{name: "CSV Same Currency", parse: csvRates, content: "date,base,quote,rate\n2023-03-10,USD,USD,1\n", wantErr: true},
+		{name: "CSV Without Rows", parse: csvRates, content: "date,base,quote,rate\n", wantErr: true},
+		{
+			name:  "ECB",
+			parse: ecbRates,
+			content: `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<Cube>
+		<Cube time="2023-03-10">
+			<Cube currency="USD" rate="1.0578"/>
+			<Cube currency="JPY" rate="144.50"/>
+		</Cube>
+		<Cube time="2023-03-09">
+			<Cube currency="USD" rate="1.0549"/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`,
+			want: []entity.ExchangeRate{
+				{Date: day, BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0578"},
+				{Date: day, BaseCurrency: "EUR", QuoteCurrency: "JPY", Rate: "144.5"},
+				{Date: day.AddDate(0, 0, -1), BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0549"},
+			},
+		},
+		{name: "ECB Invalid Rate", parse: ecbRates, content: `<Envelope><Cube><Cube time="2023-03-10"><Cube currency="USD" rate="N/A"/></Cube></Cube></Envelope>`, wantErr: true},
+		{name: "Not ECB", parse: ecbRates, content: "date,base,quote,rate\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parse(tt.content)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func csvRates(content string) ([]entity.ExchangeRate, error) {
+	return util.ParseCSVExchangeRates(strings.NewReader(content))
+}
+
+func ecbRates(content string) ([]entity.ExchangeRate, error) {
+	return util.ParseECBExchangeRates(strings.NewReader(content))
+}
+
+func TestExchangeRateService_ImportExchangeRates(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		content   string
+		upsertErr error
+		want      int
+		wantErr   bool
+	}{
+		{name: "Import CSV Success", format: "csv", content: "date,base,quote,rate\n2023-03-10,USD,IDR,15437.5\n2023-03-10,EUR,USD,1.0578\n", want: 2},
+		{name: "Invalid File", format: "csv", content: "date,base,quote,rate\n2023-03-10,USD,IDR,abc\n", wantErr: true},
+		{name: "Unknown Format", format: "json", content: "[]", wantErr: true},
+		{name: "Error When Upsert", format: "csv", content: "date,base,quote,rate\n2023-03-10,USD,IDR,15437.5\n", upsertErr: errors.New("error"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockExchangeRateRepository := new(mockExchangeRateRepository.ExchangeRateRepository)
+			mockExchangeRateRepository.On("UpsertExchangeRates", ctx, mock.Anything).Return(tt.upsertErr)
+
+			service := exchange.NewExchangeRateService(mockExchangeRateRepository)
+			got, err := service.ImportExchangeRates(ctx, tt.format, strings.NewReader(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.ImportExchangeRates() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("service.ImportExchangeRates() = %v, want %v", got, tt.want)
+			}
+			if tt.wantErr && tt.upsertErr == nil {
+				mockExchangeRateRepository.AssertNotCalled(t, "UpsertExchangeRates", ctx, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	mockUserRepository "github.com/vnnyx/golang-dot-api/repository/user/mocks"
+	"github.com/vnnyx/golang-dot-api/service/export"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestExportService_ExportTransaction(t *testing.T) {
+	rows := []model.TransactionRow{
+		{TransactionID: "1", Name: "Electricity", Amount: 4000, Currency: "IDR", UserID: "123", CategoryID: "100", Tags: "bills,home", CreatedAt: time.Date(2023, 3, 10, 3, 0, 0, 0, time.UTC)},
+		{TransactionID: "2", Name: "=HYPERLINK(\"x\")", Amount: 15, Currency: "USD", UserID: "123", CreatedAt: time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC)},
+	}
+	parentId := "100"
+	tests := []struct {
+		name       string
+		req        web.TransactionExportRequest
+		userErr    error
+		wantFilter model.TransactionFilter
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "Export Selected Columns As CSV",
+			req: web.TransactionExportRequest{
+				Format:  "csv",
+				Columns: []string{"transaction_id", "name", "amount", "tags", "created_at"},
+			},
+			want: "transaction_id,name,amount,tags,created_at\n" +
+				"1,Electricity,4000,\"bills,home\",2023-03-10T03:00:00Z\n" +
+				"2,\"'=HYPERLINK(\"\"x\"\")\",15,,2023-04-02T00:00:00Z\n",
+			wantErr: false,
+		},
+		{
+			name: "Export Filtered By Category",
+			req: web.TransactionExportRequest{
+				TransactionListRequest: web.TransactionListRequest{UserID: "123", CategoryID: parentId},
+				Columns:                []string{"transaction_id"},
+			},
+			wantFilter: model.TransactionFilter{CategoryIDs: []string{parentId, "101"}},
+			want:       "transaction_id\n1\n2\n",
+			wantErr:    false,
+		},
+		{
+			name: "User Not Found",
+			req: web.TransactionExportRequest{
+				TransactionListRequest: web.TransactionListRequest{UserID: "404"},
+			},
+			userErr: errors.New("record not found"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			mockUserRepository.On("FindUserByID", ctx, tt.req.UserID).Return(entity.User{UserID: tt.req.UserID}, tt.userErr)
+			mockCategoryRepository.On("FindCategoryByUserId", ctx, tt.req.UserID).Return([]entity.Category{
+				{CategoryID: parentId, UserID: "123", Name: "Bills"},
+				{CategoryID: "101", UserID: "123", ParentID: &parentId, Name: "Internet"},
+			}, nil)
+			var gotFilter model.TransactionFilter
+			mockTransactionRepository.On("StreamTransaction", ctx, tt.req.UserID, mock.Anything, mock.Anything).Return(func(ctx context.Context, userId string, filter model.TransactionFilter, fn func(row model.TransactionRow) error) error {
+				gotFilter = filter
+				for _, row := range rows {
+					if err := fn(row); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+
+			exportService := export.NewExportService(mockTransactionRepository, mockUserRepository, mockCategoryRepository)
+			file, err := exportService.ExportTransaction(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.ExportTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var got bytes.Buffer
+			err = file.Write(&got)
+			if err != nil {
+				t.Fatalf("file.Write() error = %v", err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("file.Write() = %q, want %q", got.String(), tt.want)
+			}
+			if !reflect.DeepEqual(gotFilter, tt.wantFilter) {
+				t.Errorf("repository.StreamTransaction() filter = %v, want %v", gotFilter, tt.wantFilter)
+			}
+			if file.ContentType != "text/csv" {
+				t.Errorf("service.ExportTransaction() content type = %s, want text/csv", file.ContentType)
+			}
+		})
+	}
+}
+
+func TestExportService_ExportUserXLSX(t *testing.T) {
+	ctx := context.TODO()
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockUserRepository := new(mockUserRepository.UserRepository)
+	mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+	mockUserRepository.On("StreamUser", ctx, mock.Anything).Return(func(ctx context.Context, fn func(user entity.User) error) error {
+		for _, user := range []entity.User{
+			{UserID: "123", Username: "user_sample", Email: "user@gmail.com", Handphone: "08123456789"},
+			{UserID: "124", Username: "user_other", Email: "other@gmail.com", Handphone: "08987654321"},
+		} {
+			if err := fn(user); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	exportService := export.NewExportService(mockTransactionRepository, mockUserRepository, mockCategoryRepository)
+	file, err := exportService.ExportUser(ctx, web.UserExportRequest{Format: "xlsx", Columns: []string{"username", "handphone"}})
+	if err != nil {
+		t.Fatalf("service.ExportUser() error = %v", err)
+	}
+
+	var got bytes.Buffer
+	err = file.Write(&got)
+	if err != nil {
+		t.Fatalf("file.Write() error = %v", err)
+	}
+
+	workbook, err := excelize.OpenReader(&got)
+	if err != nil {
+		t.Fatalf("excelize.OpenReader() error = %v", err)
+	}
+	defer workbook.Close()
+	sheetRows, err := workbook.GetRows("Sheet1")
+	if err != nil {
+		t.Fatalf("workbook.GetRows() error = %v", err)
+	}
+	want := [][]string{
+		{"username", "handphone"},
+		{"user_sample", "08123456789"},
+		{"user_other", "08987654321"},
+	}
+	if !reflect.DeepEqual(sheetRows, want) {
+		t.Errorf("service.ExportUser() rows = %v, want %v", sheetRows, want)
+	}
+}
+
+func TestExportService_InvalidColumn(t *testing.T) {
+	exportService := export.NewExportService(new(mockTransactionRepository.TransactionRepository), new(mockUserRepository.UserRepository), new(mockCategoryRepository.CategoryRepository))
+	_, err := exportService.ExportUser(context.TODO(), web.UserExportRequest{Columns: []string{"password"}})
+	var validationError exception.ValidationError
+	assert.ErrorAs(t, err, &validationError, "service.ExportUser() want validation error")
+}
@@ -0,0 +1,155 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthController "github.com/vnnyx/golang-dot-api/controller/health"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func up(ctx context.Context) error {
+	return nil
+}
+
+func down(ctx context.Context) error {
+	return errors.New("connection refused")
+}
+
+// hang ignores its context, like a client stuck on a dead connection.
+func hang(ctx context.Context) error {
+	time.Sleep(time.Second)
+	return nil
+}
+
+func TestHealthController_Readiness(t *testing.T) {
+	type dependency struct {
+		name     string
+		critical bool
+		check    health.CheckFunc
+	}
+	tests := []struct {
+		name               string
+		dependencies       []dependency
+		wantCode           int
+		wantStatus         string
+		wantDependencyDown map[string]string
+	}{
+		{
+			name: "All Up",
+			dependencies: []dependency{
+				{name: "mysql", critical: true, check: up},
+				{name: "redis", critical: true, check: up},
+			},
+			wantCode:   http.StatusOK,
+			wantStatus: web.HealthStatusOK,
+		},
+		{
+			name: "Non-Critical Down",
+			dependencies: []dependency{
+				{name: "mysql", critical: true, check: up},
+				{name: "storage", critical: false, check: down},
+			},
+			wantCode:           http.StatusOK,
+			wantStatus:         web.HealthStatusDegraded,
+			wantDependencyDown: map[string]string{"storage": "connection refused"},
+		},
+		{
+			name: "Critical Down",
+			dependencies: []dependency{
+				{name: "mysql", critical: true, check: down},
+				{name: "storage", critical: false, check: down},
+			},
+			wantCode:           http.StatusServiceUnavailable,
+			wantStatus:         web.HealthStatusUnavailable,
+			wantDependencyDown: map[string]string{"mysql": "connection refused", "storage": "connection refused"},
+		},
+		{
+			name: "Critical Timeout",
+			dependencies: []dependency{
+				{name: "mysql", critical: true, check: up},
+				{name: "redis", critical: true, check: hang},
+			},
+			wantCode:           http.StatusServiceUnavailable,
+			wantStatus:         web.HealthStatusUnavailable,
+			wantDependencyDown: map[string]string{"redis": context.DeadlineExceeded.Error()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := health.NewChecker(50 * time.Millisecond)
+			for _, dependency := range tt.dependencies {
+				checker.Register(dependency.name, dependency.critical, dependency.check)
+			}
+			app := echo.New()
+			healthController.NewHealthController(checker).Route(app)
+
+			start := time.Now()
+			recorder := httptest.NewRecorder()
+			app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			assert.Less(t, time.Since(start), 500*time.Millisecond, "readiness waited for a hanging check")
+
+			var response struct {
+				Code int                `json:"code"`
+				Data web.HealthResponse `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+			assert.Equal(t, tt.wantCode, recorder.Code)
+			assert.Equal(t, tt.wantStatus, response.Data.Status)
+			require.Len(t, response.Data.Dependencies, len(tt.dependencies))
+			for i, dependency := range response.Data.Dependencies {
+				assert.Equal(t, tt.dependencies[i].name, dependency.Name)
+				assert.Equal(t, tt.dependencies[i].critical, dependency.Critical)
+				if reason, ok := tt.wantDependencyDown[dependency.Name]; ok {
+					assert.Equal(t, web.DependencyStatusDown, dependency.Status)
+					assert.Equal(t, reason, dependency.Error)
+					continue
+				}
+				assert.Equal(t, web.DependencyStatusUp, dependency.Status)
+			}
+		})
+	}
+}
+
+func TestHealthController_Liveness(t *testing.T) {
+	checker := health.NewChecker(0)
+	checker.Register("mysql", true, down)
+	app := echo.New()
+	healthController.NewHealthController(checker).Route(app)
+
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"code":200,"status":"OK","data":{"status":"ok"},"errors":null}`, recorder.Body.String())
+}
+
+func TestMySQLCheck(t *testing.T) {
+	db, sqlMock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+	// gorm.Open pings the database.
+	sqlMock.ExpectPing()
+	DB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+
+	sqlMock.ExpectPing()
+	assert.NoError(t, health.MySQLCheck(DB)(context.Background()))
+	sqlMock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	assert.EqualError(t, health.MySQLCheck(DB)(context.Background()), "connection refused")
+}
@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"testing"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/vnnyx/golang-dot-api/i18n"
+	"github.com/vnnyx/golang-dot-api/validation"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		acceptLanguage string
+		want           string
+	}{
+		{acceptLanguage: "", want: i18n.EnUS},
+		{acceptLanguage: "id-ID,id;q=0.9,en-US;q=0.8", want: i18n.IdID},
+		{acceptLanguage: "id", want: i18n.IdID},
+		{acceptLanguage: "in-ID", want: i18n.IdID},
+		{acceptLanguage: "en-GB", want: i18n.EnUS},
+		{acceptLanguage: "en;q=0.5, id;q=0.8", want: i18n.IdID},
+		{acceptLanguage: "fr-FR, id;q=0.3", want: i18n.IdID},
+		{acceptLanguage: "id;q=0, en", want: i18n.EnUS},
+		{acceptLanguage: "fr-FR", want: i18n.EnUS},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptLanguage, func(t *testing.T) {
+			if got := i18n.Negotiate(tt.acceptLanguage); got != tt.want {
+				t.Errorf("i18n.Negotiate(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	params := map[string]interface{}{"min": 1, "max": 50}
+	if got := i18n.Translate(i18n.IdID, "validation_length_out_of_range", params, ""); got != "panjangnya harus antara 1 dan 50" {
+		t.Errorf("i18n.Translate() = %q", got)
+	}
+	if got := i18n.Translate("fr-FR", "validation_required", nil, ""); got != "cannot be blank" {
+		t.Errorf("i18n.Translate() of an unsupported locale = %q, want the en-US message", got)
+	}
+	if got := i18n.Translate(i18n.IdID, "UNKNOWN_CODE", nil, "fallback"); got != "fallback" {
+		t.Errorf("i18n.Translate() of an unknown code = %q, want the fallback", got)
+	}
+}
+
+// TestCatalogs checks that id-ID translates the codes the validators return,
+// and that en-US keeps the messages they send by default.
+func TestCatalogs(t *testing.T) {
+	errs := []validator.Error{
+		validator.ErrRequired, validator.ErrNotNilRequired, validator.ErrLengthOutOfRange,
+		validator.ErrLengthTooLong, validator.ErrLengthTooShort, validator.ErrMinGreaterEqualThanRequired,
+		validator.ErrMaxLessEqualThanRequired, validator.ErrInInvalid, validator.ErrMatchInvalid,
+		validator.ErrDateInvalid, validator.ErrDateOutOfRange, is.ErrEmail,
+		validation.ErrUnique, validation.ErrPhoneInvalid, validation.ErrCurrencyInvalid,
+		validation.ErrTimezoneInvalid, validation.ErrRegexInvalid, validation.ErrRRuleInvalid,
+		validation.ErrRRuleNoOccurrence, validation.ErrDateBeforeFrom, validation.ErrDateRangeTooLong,
+		validation.ErrGroupRepeated, validation.ErrGroupPeriods, validation.ErrDescriptionRequired,
+		validation.ErrSplitAmountRequired, validation.ErrSplitParticipantInvalid, validation.ErrSplitAmountInvalid,
+		validation.ErrSplitAmountSum, validation.ErrSplitPercentInvalid, validation.ErrSplitPercentSum,
+		validation.ErrSearchTermsRequired, validation.ErrFileTooLarge, validation.ErrJSONObject,
+		validation.ErrImportEmpty, validation.ErrImportTooLarge,
+	}
+	for _, err := range errs {
+		if got := i18n.Translate(i18n.EnUS, err.Code(), nil, ""); got != err.Message() {
+			t.Errorf("en-US message of %s = %q, want %q", err.Code(), got, err.Message())
+		}
+		if got := i18n.Translate(i18n.IdID, err.Code(), nil, ""); got == err.Message() {
+			t.Errorf("id-ID has no translation of %s", err.Code())
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	"github.com/vnnyx/golang-dot-api/service/importer"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestImportService_ImportTransaction(t *testing.T) {
+	content := "date,name,amount,category_id\n" +
+		"2023-03-10,Coffee,-3.50,\n" +
+		"2023-03-10,Coffee,-3.50,\n" +
+		"2023-03-11,Netflix,-186000,\n" +
+		"2023-03-12,Rent,abc,\n" +
+		"2023-03-13,Gift,-10.00,wrong_id\n"
+	coffeeId := util.IdempotentID("import:123:2023-03-10:350:IDR:coffee#1")
+	secondCoffeeId := util.IdempotentID("import:123:2023-03-10:350:IDR:coffee#2")
+	netflixId := util.IdempotentID("import:123:2023-03-11:18600000:IDR:netflix#1")
+	tests := []struct {
+		name         string
+		dryRun       bool
+		existing     []string
+		batchErr     error
+		rowErr       map[string]error
+		wantStatuses []string
+		wantBatch    int
+	}{
+		{
+			name:         "Dry Run Preview",
+			dryRun:       true,
+			wantStatuses: []string{web.ImportStatusReady, web.ImportStatusReady, web.ImportStatusReady, web.ImportStatusInvalid, web.ImportStatusInvalid},
+		},
+		{
+			name:         "Import In One Batch",
+			existing:     []string{coffeeId},
+			wantStatuses: []string{web.ImportStatusDuplicate, web.ImportStatusImported, web.ImportStatusImported, web.ImportStatusInvalid, web.ImportStatusInvalid},
+			wantBatch:    2,
+		},
+		{
+			name:         "Retry Row By Row",
+			batchErr:     errors.New("deadlock"),
+			rowErr:       map[string]error{secondCoffeeId: errors.New("error")},
+			wantStatuses: []string{web.ImportStatusImported, web.ImportStatusFailed, web.ImportStatusImported, web.ImportStatusInvalid, web.ImportStatusInvalid},
+			wantBatch:    3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+			mockCategoryRepository.On("FindCategoryByUserId", ctx, "123").Return([]entity.Category{{CategoryID: "100", UserID: "123", Name: "Subscriptions"}}, nil)
+			mockCategoryRepository.On("FindCategoryRuleByUserId", ctx, "123").Return([]entity.CategoryRule{
+				{RuleID: "1", UserID: "123", CategoryID: "100", Pattern: "netflix", MatchType: entity.RuleMatchContains},
+			}, nil)
+			mockTransactionRepository.On("FindExistingTransactionIDs", ctx, []string{coffeeId, secondCoffeeId, netflixId}).Return(tt.existing, nil)
+			var batch []entity.Transaction
+			mockTransactionRepository.On("InsertTransactionBatch", ctx, mock.Anything).Return(func(ctx context.Context, transactions []entity.Transaction) error {
+				batch = transactions
+				return tt.batchErr
+			})
+			mockTransactionRepository.On("InsertTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+				return transaction
+			}, func(ctx context.Context, transaction entity.Transaction) error {
+				return tt.rowErr[transaction.TransactionID]
+			})
+
+			importService := importer.NewImportService(mockTransactionRepository, mockCategoryRepository)
+			got, err := importService.ImportTransaction(ctx, web.ImportRequest{
+				UserID:  "123",
+				Format:  "csv",
+				Content: strings.NewReader(content),
+				DryRun:  tt.dryRun,
+			})
+			if err != nil {
+				t.Fatalf("service.ImportTransaction() error = %v", err)
+			}
+
+			var statuses []string
+			for _, row := range got.Rows {
+				statuses = append(statuses, row.Status)
+			}
+			if !reflect.DeepEqual(statuses, tt.wantStatuses) {
+				t.Errorf("service.ImportTransaction() statuses = %v, want %v", statuses, tt.wantStatuses)
+			}
+			if len(batch) != tt.wantBatch {
+				t.Errorf("service.ImportTransaction() batch size = %d, want %d", len(batch), tt.wantBatch)
+			}
+			if got.Rows[2].CategoryID != "100" || got.Rows[2].Amount != 18600000 {
+				t.Errorf("service.ImportTransaction() row 3 = %v, want category 100 and amount 18600000", got.Rows[2])
+			}
+			if got.Rows[3].Errors["amount"] == nil || got.Rows[4].Errors["category_id"] == nil {
+				t.Errorf("service.ImportTransaction() errors = %v, %v", got.Rows[3].Errors, got.Rows[4].Errors)
+			}
+			if tt.dryRun {
+				mockTransactionRepository.AssertNotCalled(t, "InsertTransactionBatch", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestImportService_DuplicateExternalID(t *testing.T) {
+	ctx := context.TODO()
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+	mockCategoryRepository.On("FindCategoryByUserId", ctx, "123").Return([]entity.Category{}, nil)
+	mockCategoryRepository.On("FindCategoryRuleByUserId", ctx, "123").Return([]entity.CategoryRule{}, nil)
+	mockTransactionRepository.On("FindExistingTransactionIDs", ctx, mock.Anything).Return([]string{}, nil)
+
+	content := "<OFX><CURDEF>USD<STMTTRN><DTPOSTED>20230310<TRNAMT>-1.00<FITID>A1<NAME>Bus</STMTTRN>" +
+		"<STMTTRN><DTPOSTED>20230310<TRNAMT>-1.00<FITID>A1<NAME>Bus</STMTTRN></OFX>"
+	importService := importer.NewImportService(mockTransactionRepository, mockCategoryRepository)
+	got, err := importService.ImportTransaction(ctx, web.ImportRequest{UserID: "123", Format: "ofx", Content: strings.NewReader(content), DryRun: true})
+	if err != nil {
+		t.Fatalf("service.ImportTransaction() error = %v", err)
+	}
+	if got.Duplicates != 1 || got.Rows[0].TransactionID != util.IdempotentID("import:123:id:A1#1") {
+		t.Errorf("service.ImportTransaction() = %v, want the second A1 row as duplicate", got)
+	}
+}
+
+func TestImportService_CreditRows(t *testing.T) {
+	ctx := context.TODO()
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+
+	mockCategoryRepository.On("FindCategoryByUserId", ctx, "123").Return([]entity.Category{}, nil)
+	mockCategoryRepository.On("FindCategoryRuleByUserId", ctx, "123").Return([]entity.CategoryRule{}, nil)
+	mockTransactionRepository.On("FindExistingTransactionIDs", ctx, mock.Anything).Return([]string{}, nil)
+	var batch []entity.Transaction
+	mockTransactionRepository.On("InsertTransactionBatch", ctx, mock.Anything).Return(func(ctx context.Context, transactions []entity.Transaction) error {
+		batch = transactions
+		return nil
+	})
+
+	content := "date,name,amount\n" +
+		"2023-03-10,Groceries,-250000\n" +
+		"2023-03-25,Salary,15000000\n" +
+		"2023-03-26,Refund Groceries,50000\n" +
+		"2023-03-27,Parking,-5000\n"
+	importService := importer.NewImportService(mockTransactionRepository, mockCategoryRepository)
+	got, err := importService.ImportTransaction(ctx, web.ImportRequest{UserID: "123", Format: "csv", Content: strings.NewReader(content)})
+	if err != nil {
+		t.Fatalf("service.ImportTransaction() error = %v", err)
+	}
+
+	var statuses []string
+	for _, row := range got.Rows {
+		statuses = append(statuses, row.Status)
+	}
+	wantStatuses := []string{web.ImportStatusImported, web.ImportStatusInvalid, web.ImportStatusInvalid, web.ImportStatusImported}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Errorf("service.ImportTransaction() statuses = %v, want %v", statuses, wantStatuses)
+	}
+	if got.Rows[1].Errors["amount"] == nil || got.Rows[2].Errors["amount"] == nil {
+		t.Errorf("service.ImportTransaction() credit errors = %v, %v", got.Rows[1].Errors, got.Rows[2].Errors)
+	}
+	var total int64
+	for _, transaction := range batch {
+		total += transaction.Amount
+	}
+	if got.Imported != 2 || total != 25500000 {
+		t.Errorf("service.ImportTransaction() imported %d rows totalling %d, want 2 rows totalling 25500000", got.Imported, total)
+	}
+}
@@ -0,0 +1,219 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/middleware"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func observedLogger(level zapcore.Level) (*zap.Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(level)
+	return zap.New(infrastructure.NewRedactingCore(core)), logs
+}
+
+func TestRedactingCore(t *testing.T) {
+	zapLogger, logs := observedLogger(zapcore.InfoLevel)
+	zapLogger.With(zap.String("access_token", "eyJhbGciOi")).Info("login",
+		zap.String("username", "vnnyx"),
+		zap.String("Password", "secret"),
+		zap.String("handphone", "+628123456789"),
+		zap.Any("body", map[string]interface{}{
+			"email":   "vnnyx@example.com",
+			"profile": map[string]interface{}{"phone": "+628123456789"},
+		}),
+	)
+
+	assert.Equal(t, map[string]interface{}{
+		"access_token": infrastructure.Redacted,
+		"username":     "vnnyx",
+		"Password":     infrastructure.Redacted,
+		"handphone":    infrastructure.Redacted,
+		"body": map[string]interface{}{
+			"email":   "vnnyx@example.com",
+			"profile": map[string]interface{}{"phone": infrastructure.Redacted},
+		},
+	}, logs.All()[0].ContextMap())
+}
+
+func TestMaskSQL(t *testing.T) {
+	tests := []struct {
+		name string
+		sql  string
+		want string
+	}{
+		{
+			name: "String Values",
+			sql:  "INSERT INTO `users` (`username`,`password`,`handphone`) VALUES ('vnnyx','$2a$10$abc','+628123456789')",
+			want: "INSERT INTO `users` (`username`,`password`,`handphone`) VALUES ('?','?','?')",
+		},
+		{
+			name: "Escaped Quote",
+			sql:  `SELECT * FROM users WHERE username = 'o\'brien' AND deleted_at IS NULL LIMIT 1`,
+			want: `SELECT * FROM users WHERE username = '?' AND deleted_at IS NULL LIMIT 1`,
+		},
+		{
+			name: "Numbers Kept",
+			sql:  "SELECT * FROM `transactions` WHERE amount > 1000 LIMIT 10",
+			want: "SELECT * FROM `transactions` WHERE amount > 1000 LIMIT 10",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, infrastructure.MaskSQL(tt.sql))
+		})
+	}
+}
+
+func TestGormLogger(t *testing.T) {
+	sql := func() (string, int64) {
+		return "SELECT * FROM `users` WHERE handphone = '+628123456789'", 1
+	}
+	ctx := infrastructure.ContextWithRequestID(context.Background(), "request-1")
+
+	tests := []struct {
+		name      string
+		level     zapcore.Level
+		err       error
+		begin     time.Time
+		wantLevel zapcore.Level
+		wantLogs  int
+	}{
+		{
+			name:      "Debug Query",
+			level:     zapcore.DebugLevel,
+			begin:     time.Now(),
+			wantLevel: zapcore.DebugLevel,
+			wantLogs:  1,
+		},
+		{
+			name:     "Info Level Hides Queries",
+			level:    zapcore.InfoLevel,
+			begin:    time.Now(),
+			wantLogs: 0,
+		},
+		{
+			name:     "Record Not Found",
+			level:    zapcore.InfoLevel,
+			err:      gorm.ErrRecordNotFound,
+			begin:    time.Now(),
+			wantLogs: 0,
+		},
+		{
+			name:      "Failed Query",
+			level:     zapcore.InfoLevel,
+			err:       errors.New("connection refused"),
+			begin:     time.Now(),
+			wantLevel: zapcore.ErrorLevel,
+			wantLogs:  1,
+		},
+		{
+			name:      "Slow Query",
+			level:     zapcore.InfoLevel,
+			begin:     time.Now().Add(-time.Second),
+			wantLevel: zapcore.WarnLevel,
+			wantLogs:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zapLogger, logs := observedLogger(tt.level)
+			infrastructure.NewGormLogger(zapLogger).Trace(ctx, tt.begin, sql, tt.err)
+
+			if !assert.Equal(t, tt.wantLogs, logs.Len()) || tt.wantLogs == 0 {
+				return
+			}
+			entry := logs.All()[0]
+			assert.Equal(t, tt.wantLevel, entry.Level)
+			assert.Equal(t, "SELECT * FROM `users` WHERE handphone = '?'", entry.ContextMap()["sql"])
+			assert.Equal(t, "request-1", entry.ContextMap()["request_id"])
+		})
+	}
+
+	zapLogger, logs := observedLogger(zapcore.DebugLevel)
+	infrastructure.NewGormLogger(zapLogger).LogMode(logger.Silent).Trace(ctx, time.Now(), sql, errors.New("connection refused"))
+	assert.Equal(t, 0, logs.Len())
+}
+
+func TestRequestLogger(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        string
+		requestID     string
+		wantStatus    int
+		wantLevel     zapcore.Level
+		wantURI       string
+		wantUserID    string
+		wantRequestID string
+	}{
+		{
+			name:          "Authenticated Request",
+			target:        "/dot-api/transaction?token=abc&page=2",
+			requestID:     "3f1c2a",
+			wantStatus:    http.StatusOK,
+			wantLevel:     zapcore.InfoLevel,
+			wantURI:       "/dot-api/transaction?page=2&token=%5BREDACTED%5D",
+			wantUserID:    "user-1",
+			wantRequestID: "3f1c2a",
+		},
+		{
+			name:       "Not Found",
+			target:     "/dot-api/unknown",
+			wantStatus: http.StatusNotFound,
+			wantLevel:  zapcore.WarnLevel,
+			wantURI:    "/dot-api/unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zapLogger, logs := observedLogger(zapcore.InfoLevel)
+			app := echo.New()
+			app.Use(middleware.RequestID())
+			app.Use(middleware.RequestLogger(zapLogger))
+			var contextRequestID string
+			app.GET("/dot-api/transaction", func(ctx echo.Context) error {
+				ctx.Set("currentId", "user-1")
+				contextRequestID = infrastructure.RequestIDFromContext(ctx.Request().Context())
+				return ctx.NoContent(http.StatusOK)
+			})
+
+			request := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.requestID != "" {
+				request.Header.Set(echo.HeaderXRequestID, tt.requestID)
+			}
+			recorder := httptest.NewRecorder()
+			app.ServeHTTP(recorder, request)
+
+			requestID := recorder.Header().Get(echo.HeaderXRequestID)
+			assert.NotEmpty(t, requestID)
+			if tt.wantRequestID != "" {
+				assert.Equal(t, tt.wantRequestID, requestID)
+				assert.Equal(t, tt.wantRequestID, contextRequestID)
+			}
+
+			if !assert.Equal(t, 1, logs.Len()) {
+				return
+			}
+			entry := logs.All()[0]
+			fields := entry.ContextMap()
+			assert.Equal(t, tt.wantLevel, entry.Level)
+			assert.Equal(t, requestID, fields["request_id"])
+			assert.Equal(t, int64(tt.wantStatus), fields["status"])
+			assert.Equal(t, tt.wantURI, fields["uri"])
+			assert.Equal(t, tt.wantUserID, fields["user_id"])
+			assert.Contains(t, fields, "latency_ms")
+		})
+	}
+}
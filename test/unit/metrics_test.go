@@ -0,0 +1,143 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vnnyx/golang-dot-api/infrastructure/metrics"
+	"github.com/vnnyx/golang-dot-api/middleware"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// sampleCount returns the number of observations of a histogram series.
+func sampleCount(t *testing.T, observer prometheus.Observer) uint64 {
+	var metric dto.Metric
+	require.NoError(t, observer.(prometheus.Metric).Write(&metric))
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	app := echo.New()
+	app.Use(middleware.Metrics())
+	app.GET("/dot-api/transaction/:id", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusOK)
+	})
+	app.GET(middleware.MetricsPath, echo.WrapHandler(promhttp.Handler()))
+
+	tests := []struct {
+		name   string
+		target string
+		route  string
+		status string
+	}{
+		{
+			name:   "Route Template",
+			target: "/dot-api/transaction/123",
+			route:  "/dot-api/transaction/:id",
+			status: "200",
+		},
+		{
+			name:   "Unmatched Route",
+			target: "/unknown/123",
+			route:  "unmatched",
+			status: "404",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histogram := metrics.HTTPRequestDuration.WithLabelValues(http.MethodGet, tt.route, tt.status)
+			before := sampleCount(t, histogram)
+
+			app.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			assert.Equal(t, before+1, sampleCount(t, histogram))
+		})
+	}
+
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, middleware.MetricsPath, nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	for _, name := range []string{"dot_api_http_request_duration_seconds", "dot_api_db_pool_in_use_connections", "dot_api_logins_total", "go_goroutines"} {
+		assert.True(t, strings.Contains(recorder.Body.String(), name), "/metrics does not expose %s", name)
+	}
+}
+
+func TestGormPlugin(t *testing.T) {
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	DB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, DB.Use(metrics.GormPlugin{}))
+	metrics.RegisterDB(db)
+
+	histogram := metrics.DBQueryDuration.WithLabelValues("query", "users")
+	errorCounter := metrics.DBQueryErrors.WithLabelValues("query", "users")
+	queries := sampleCount(t, histogram)
+	failures := testutil.ToFloat64(errorCounter)
+
+	var count int64
+	sqlMock.ExpectQuery("SELECT count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	require.NoError(t, DB.Table("users").Count(&count).Error)
+	sqlMock.ExpectQuery("SELECT count").WillReturnError(errors.New("connection refused"))
+	require.Error(t, DB.Table("users").Count(&count).Error)
+
+	assert.Equal(t, queries+2, sampleCount(t, histogram))
+	assert.Equal(t, failures+1, testutil.ToFloat64(errorCounter))
+}
+
+func TestRedisHook(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantError float64
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name: "Missing Key",
+			err:  redis.Nil,
+		},
+		{
+			name:      "Failure",
+			err:       errors.New("connection refused"),
+			wantError: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histogram := metrics.RedisCommandDuration.WithLabelValues("get")
+			errorCounter := metrics.RedisCommandErrors.WithLabelValues("get")
+			commands := sampleCount(t, histogram)
+			failures := testutil.ToFloat64(errorCounter)
+
+			hook := metrics.RedisHook{}
+			cmd := redis.NewStringCmd(context.Background(), "get", "access_uuid")
+			ctx, err := hook.BeforeProcess(context.Background(), cmd)
+			require.NoError(t, err)
+			cmd.SetErr(tt.err)
+			require.NoError(t, hook.AfterProcess(ctx, cmd))
+
+			assert.Equal(t, commands+1, sampleCount(t, histogram))
+			assert.Equal(t, failures+tt.wantError, testutil.ToFloat64(errorCounter))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package unit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestOffsetSegments(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	tests := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		location *time.Location
+		want     []util.OffsetSegment
+	}{
+		{
+			name:     "Fixed Offset",
+			from:     time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+			to:       time.Date(2023, 4, 1, 0, 0, 0, 0, jakarta),
+			location: jakarta,
+			want: []util.OffsetSegment{
+				{From: time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta), To: time.Date(2023, 4, 1, 0, 0, 0, 0, jakarta), Offset: "+07:00"},
+			},
+		},
+		{
+			name:     "Daylight Saving Starts",
+			from:     time.Date(2023, 3, 1, 0, 0, 0, 0, newYork),
+			to:       time.Date(2023, 4, 1, 0, 0, 0, 0, newYork),
+			location: newYork,
+			want: []util.OffsetSegment{
+				{From: time.Date(2023, 3, 1, 0, 0, 0, 0, newYork), To: time.Date(2023, 3, 12, 7, 0, 0, 0, time.UTC), Offset: "-05:00"},
+				{From: time.Date(2023, 3, 12, 7, 0, 0, 0, time.UTC), To: time.Date(2023, 4, 1, 0, 0, 0, 0, newYork), Offset: "-04:00"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.OffsetSegments(tt.from, tt.to, tt.location)
+			if len(got) != len(tt.want) {
+				t.Fatalf("util.OffsetSegments() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].From.Equal(tt.want[i].From) || !got[i].To.Equal(tt.want[i].To) || got[i].Offset != tt.want[i].Offset {
+					t.Errorf("util.OffsetSegments()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	got := []string{util.FormatOffset(7 * 3600), util.FormatOffset(-(3*3600 + 30*60)), util.FormatOffset(0)}
+	want := []string{"+07:00", "-03:30", "+00:00"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("util.FormatOffset() = %v, want %v", got, want)
+	}
+}
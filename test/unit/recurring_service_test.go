@@ -0,0 +1,344 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	mockRecurringRepository "github.com/vnnyx/golang-dot-api/repository/recurring/mocks"
+	"github.com/vnnyx/golang-dot-api/service/recurring"
+	mockTransactionService "github.com/vnnyx/golang-dot-api/service/transaction/mocks"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestRecurringService_CreateRecurring(t *testing.T) {
+	startAt := time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC)
+	nextRunAt := startAt
+	type args struct {
+		ctx context.Context
+		req web.RecurringCreateRequest
+	}
+	type mockFindCategoryByIDRepository struct {
+		res entity.Category
+		err error
+	}
+	type mockInsertRecurringRepository struct {
+		res entity.RecurringTransaction
+		err error
+	}
+	tests := []struct {
+		name                           string
+		args                           args
+		mockFindCategoryByIDRepository *mockFindCategoryByIDRepository
+		mockInsertRecurringRepository  *mockInsertRecurringRepository
+		want                           web.RecurringResponse
+		wantNextRunAt                  time.Time
+		wantErr                        bool
+	}{
+		{
+			name: "Create Recurring Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.RecurringCreateRequest{
+					UserID:   "123",
+					Name:     "Rent",
+					Amount:   500000000,
+					RRule:    "FREQ=MONTHLY;BYMONTHDAY=-1",
+					Timezone: "UTC",
+					StartAt:  startAt,
+				},
+			},
+			mockInsertRecurringRepository: &mockInsertRecurringRepository{
+				res: entity.RecurringTransaction{
+					RecurringID: "456",
+					UserID:      "123",
+					Name:        "Rent",
+					Amount:      500000000,
+					Currency:    entity.DefaultCurrency,
+					RRule:       "FREQ=MONTHLY;BYMONTHDAY=-1",
+					Timezone:    "UTC",
+					StartAt:     startAt,
+					NextRunAt:   &nextRunAt,
+				},
+				err: nil,
+			},
+			want: web.RecurringResponse{
+				RecurringID: "456",
+				UserID:      "123",
+				Name:        "Rent",
+				Amount:      500000000,
+				Currency:    entity.DefaultCurrency,
+				RRule:       "FREQ=MONTHLY;BYMONTHDAY=-1",
+				Timezone:    "UTC",
+				StartAt:     startAt,
+				NextRunAt:   &nextRunAt,
+			},
+			wantNextRunAt: startAt,
+			wantErr:       false,
+		},
+		{
+			name: "Invalid Currency",
+			args: args{
+				ctx: context.TODO(),
+				req: web.RecurringCreateRequest{
+					UserID:   "123",
+					Name:     "Rent",
+					Amount:   500000000,
+					Currency: "rupiah",
+					RRule:    "FREQ=MONTHLY",
+					Timezone: "UTC",
+					StartAt:  startAt,
+				},
+			},
+			want:    web.RecurringResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Category Belongs To Another User",
+			args: args{
+				ctx: context.TODO(),
+				req: web.RecurringCreateRequest{
+					UserID:     "123",
+					Name:       "Rent",
+					CategoryID: "789",
+					RRule:      "FREQ=MONTHLY",
+					Timezone:   "UTC",
+					StartAt:    startAt,
+				},
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{CategoryID: "789", UserID: "999", Name: "Housing"},
+				err: nil,
+			},
+			want:    web.RecurringResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRecurringRepository := new(mockRecurringRepository.RecurringRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionService := new(mockTransactionService.TransactionService)
+
+			if tt.mockFindCategoryByIDRepository != nil {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByIDRepository.res, tt.mockFindCategoryByIDRepository.err)
+			}
+			if tt.mockInsertRecurringRepository != nil {
+				mockRecurringRepository.On("InsertRecurring", tt.args.ctx, mock.MatchedBy(func(recurring entity.RecurringTransaction) bool {
+					return recurring.NextRunAt != nil && recurring.NextRunAt.Equal(tt.wantNextRunAt) &&
+						recurring.Amount == tt.args.req.Amount && recurring.Currency == entity.DefaultCurrency
+				})).Return(tt.mockInsertRecurringRepository.res, tt.mockInsertRecurringRepository.err)
+			}
+
+			recurringService := recurring.NewRecurringService(mockRecurringRepository, mockCategoryRepository, mockTransactionService)
+			got, err := recurringService.CreateRecurring(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateRecurring() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateRecurring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurringService_RunDueRecurring(t *testing.T) {
+	startAt := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	secondRun := startAt.AddDate(0, 0, 1)
+	thirdRun := startAt.AddDate(0, 0, 2)
+	daily := entity.RecurringTransaction{
+		RecurringID: "456",
+		UserID:      "123",
+		Name:        "Coffee",
+		Amount:      350,
+		Currency:    "USD",
+		RRule:       "FREQ=DAILY",
+		Timezone:    "UTC",
+		StartAt:     startAt,
+		NextRunAt:   &startAt,
+	}
+	firstID := util.IdempotentID("recurring:456:1672563600")
+	secondID := util.IdempotentID("recurring:456:1672650000")
+
+	type mockCreateTransactionService struct {
+		res web.TransactionResponse
+		err error
+	}
+	tests := []struct {
+		name                         string
+		existing                     map[string]bool
+		mockCreateTransactionService *mockCreateTransactionService
+		wantCreated                  int
+		wantCreatedAt                []time.Time
+		wantNextRuns                 []time.Time
+		wantErr                      bool
+	}{
+		{
+			name:     "Materialize Missed Occurrences",
+			existing: map[string]bool{},
+			mockCreateTransactionService: &mockCreateTransactionService{
+				res: web.TransactionResponse{TransactionID: firstID},
+				err: nil,
+			},
+			wantCreated:   2,
+			wantCreatedAt: []time.Time{startAt, secondRun},
+			wantNextRuns:  []time.Time{secondRun, thirdRun},
+			wantErr:       false,
+		},
+		{
+			name:     "Skip Occurrence Already Created",
+			existing: map[string]bool{firstID: true},
+			mockCreateTransactionService: &mockCreateTransactionService{
+				res: web.TransactionResponse{TransactionID: secondID},
+				err: nil,
+			},
+			wantCreated:   1,
+			wantCreatedAt: []time.Time{secondRun},
+			wantNextRuns:  []time.Time{secondRun, thirdRun},
+			wantErr:       false,
+		},
+		{
+			name:     "Keep Next Run When Create Fails",
+			existing: map[string]bool{},
+			mockCreateTransactionService: &mockCreateTransactionService{
+				res: web.TransactionResponse{},
+				err: errors.New("error"),
+			},
+			wantCreated:   0,
+			wantCreatedAt: []time.Time{startAt},
+			wantNextRuns:  nil,
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockRecurringRepository := new(mockRecurringRepository.RecurringRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionService := new(mockTransactionService.TransactionService)
+
+			mockRecurringRepository.On("FindDueRecurring", ctx, now, mock.Anything).Return([]entity.RecurringTransaction{daily}, nil)
+			mockRecurringRepository.On("UpdateRecurringFailure", ctx, mock.Anything).Return(nil)
+			var nextRuns, createdAts []time.Time
+			mockRecurringRepository.On("UpdateRecurringNextRun", ctx, "456", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				nextRuns = append(nextRuns, *args.Get(2).(*time.Time))
+			})
+			mockTransactionService.On("GetTransactionById", ctx, mock.Anything).Return(func(ctx context.Context, transactionId string) web.TransactionResponse {
+				return web.TransactionResponse{TransactionID: transactionId}
+			}, func(ctx context.Context, transactionId string) error {
+				if tt.existing[transactionId] {
+					return nil
+				}
+				return errors.New("TRANSACTION_NOT_FOUND")
+			})
+			mockTransactionService.On("CreateTransaction", ctx, mock.MatchedBy(func(request web.TransactionCreateRequest) bool {
+				return request.Amount == 350 && request.Currency == "USD"
+			})).Return(tt.mockCreateTransactionService.res, tt.mockCreateTransactionService.err).Run(func(args mock.Arguments) {
+				createdAts = append(createdAts, args.Get(1).(web.TransactionCreateRequest).CreatedAt)
+			})
+
+			recurringService := recurring.NewRecurringService(mockRecurringRepository, mockCategoryRepository, mockTransactionService)
+			created, err := recurringService.RunDueRecurring(ctx, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RunDueRecurring() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if created != tt.wantCreated {
+				t.Errorf("service.RunDueRecurring() = %v, want %v", created, tt.wantCreated)
+			}
+			// Each occurrence is dated when it was due, not when it was created.
+			if !reflect.DeepEqual(createdAts, tt.wantCreatedAt) {
+				t.Errorf("occurrences created at %v, want %v", createdAts, tt.wantCreatedAt)
+			}
+			if len(nextRuns) != len(tt.wantNextRuns) {
+				t.Fatalf("next runs = %v, want %v", nextRuns, tt.wantNextRuns)
+			}
+			for i := range nextRuns {
+				if !nextRuns[i].Equal(tt.wantNextRuns[i]) {
+					t.Errorf("next runs = %v, want %v", nextRuns, tt.wantNextRuns)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurringService_RunDueRecurringFailure(t *testing.T) {
+	startAt := time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC)
+	retryAt := now.Add(5 * time.Minute)
+	tests := []struct {
+		name          string
+		failures      int
+		wantFailures  int
+		wantRetryAt   *time.Time
+		wantNextRunAt *time.Time
+	}{
+		{
+			name:          "Back Off After A Failure",
+			failures:      0,
+			wantFailures:  1,
+			wantRetryAt:   &retryAt,
+			wantNextRunAt: &startAt,
+		},
+		{
+			name:          "Disable After Too Many Failures",
+			failures:      4,
+			wantFailures:  5,
+			wantRetryAt:   nil,
+			wantNextRunAt: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockRecurringRepository := new(mockRecurringRepository.RecurringRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTransactionService := new(mockTransactionService.TransactionService)
+
+			// The failing schedule is first in line, as it is the most overdue.
+			broken := entity.RecurringTransaction{RecurringID: "455", UserID: "123", Name: "Gym", Amount: 100, RRule: "FREQ=DAILY", Timezone: "UTC", StartAt: startAt, NextRunAt: &startAt, Failures: tt.failures}
+			daily := entity.RecurringTransaction{RecurringID: "456", UserID: "123", Name: "Coffee", Amount: 350, RRule: "FREQ=DAILY", Timezone: "UTC", StartAt: startAt, NextRunAt: &startAt}
+			mockRecurringRepository.On("FindDueRecurring", ctx, now, mock.Anything).Return([]entity.RecurringTransaction{broken, daily}, nil)
+			var failed entity.RecurringTransaction
+			mockRecurringRepository.On("UpdateRecurringFailure", ctx, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				failed = args.Get(1).(entity.RecurringTransaction)
+			})
+			mockRecurringRepository.On("UpdateRecurringNextRun", ctx, "456", mock.Anything).Return(nil)
+			mockTransactionService.On("GetTransactionById", ctx, mock.Anything).Return(web.TransactionResponse{}, errors.New("TRANSACTION_NOT_FOUND"))
+			mockTransactionService.On("CreateTransaction", ctx, mock.MatchedBy(func(request web.TransactionCreateRequest) bool {
+				return request.Name == "Gym"
+			})).Return(web.TransactionResponse{}, errors.New("CATEGORY_NOT_FOUND"))
+			mockTransactionService.On("CreateTransaction", ctx, mock.MatchedBy(func(request web.TransactionCreateRequest) bool {
+				return request.Name == "Coffee"
+			})).Return(web.TransactionResponse{}, nil)
+
+			recurringService := recurring.NewRecurringService(mockRecurringRepository, mockCategoryRepository, mockTransactionService)
+			created, err := recurringService.RunDueRecurring(ctx, now)
+			if err == nil {
+				t.Errorf("service.RunDueRecurring() error = nil, want the failure of the broken schedule")
+			}
+			if created != 2 {
+				t.Errorf("service.RunDueRecurring() = %v, want the 2 occurrences of the other schedule", created)
+			}
+			mockRecurringRepository.AssertNumberOfCalls(t, "UpdateRecurringNextRun", 2)
+
+			if failed.RecurringID != "455" || failed.Failures != tt.wantFailures || failed.LastError != "CATEGORY_NOT_FOUND" {
+				t.Errorf("recorded failure = %v, want %d failures of schedule 455", failed, tt.wantFailures)
+			}
+			if !reflect.DeepEqual(failed.RetryAt, tt.wantRetryAt) {
+				t.Errorf("recorded retry at = %v, want %v", failed.RetryAt, tt.wantRetryAt)
+			}
+			if !reflect.DeepEqual(failed.NextRunAt, tt.wantNextRunAt) {
+				t.Errorf("recorded next run at = %v, want %v", failed.NextRunAt, tt.wantNextRunAt)
+			}
+		})
+	}
+}
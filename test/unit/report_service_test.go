@@ -0,0 +1,223 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockExchangeRateRepository "github.com/vnnyx/golang-dot-api/repository/exchange/mocks"
+	mockReportRepository "github.com/vnnyx/golang-dot-api/repository/report/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	"github.com/vnnyx/golang-dot-api/service/report"
+)
+
+func TestReportService_GetReport(t *testing.T) {
+	cached := web.ReportResponse{
+		From:     "2023-03-01",
+		To:       "2023-03-31",
+		Timezone: "UTC",
+		GroupBy:  []string{"month"},
+		Rows:     []web.ReportRowResponse{{Period: "2023-03", Sum: 100, Count: 1, Average: 100, Min: 100, Max: 100}},
+	}
+	cachedJSON, _ := json.Marshal(cached)
+	type mockAggregateTransactionRepository struct {
+		res [][]model.ReportRow
+		err error
+	}
+	rates := []entity.ExchangeRate{
+		{Date: time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC), BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.068"},
+		{Date: time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC), BaseCurrency: "EUR", QuoteCurrency: "IDR", Rate: "16400"},
+		{Date: time.Date(2023, 3, 10, 0, 0, 0, 0, time.UTC), BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.07"},
+	}
+	tests := []struct {
+		name                               string
+		req                                web.ReportRequest
+		cache                              string
+		cacheErr                           error
+		mockAggregateTransactionRepository *mockAggregateTransactionRepository
+		wantOffsets                        []string
+		wantGroupBy                        []string
+		want                               web.ReportResponse
+		wantErr                            bool
+	}{
+		{
+			name:     "Cache Hit",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-01", To: "2023-03-31", GroupBy: []string{"month"}},
+			cache:    string(cachedJSON),
+			cacheErr: nil,
+			want:     cached,
+			wantErr:  false,
+		},
+		{
+			name:     "Merge Groups Across Daylight Saving Change",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-01", To: "2023-03-31", Timezone: "America/New_York", GroupBy: []string{"month", "currency"}},
+			cacheErr: redis.Nil,
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{
+					{
+						{Period: "2023-03", Currency: "IDR", Sum: 3000, Count: 2, Min: 1000, Max: 2000},
+						{Period: "2023-03", Currency: "USD", Sum: 5, Count: 1, Min: 5, Max: 5},
+					},
+					{
+						{Period: "2023-03", Currency: "IDR", Sum: 4000, Count: 1, Min: 4000, Max: 4000},
+					},
+				},
+				err: nil,
+			},
+			wantOffsets: []string{"-05:00", "-04:00"},
+			want: web.ReportResponse{
+				From:     "2023-03-01",
+				To:       "2023-03-31",
+				Timezone: "America/New_York",
+				GroupBy:  []string{"month", "currency"},
+				Rows: []web.ReportRowResponse{
+					{Period: "2023-03", Currency: "IDR", Sum: 7000, Count: 3, Average: 2333.33, Min: 1000, Max: 4000},
+					{Period: "2023-03", Currency: "USD", Sum: 5, Count: 1, Average: 5, Min: 5, Max: 5},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Convert Into Reporting Currency",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-06", To: "2023-03-12", GroupBy: []string{"week"}, Currency: "USD"},
+			cacheErr: redis.Nil,
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{
+					{
+						{Period: "2023-03-06", Currency: "IDR", Sum: 1500000, Count: 2, Min: 500000, Max: 1000000},
+						{Period: "2023-03-11", Currency: "EUR", Sum: 150, Count: 1, Min: 150, Max: 150},
+						{Period: "2023-03-11", Currency: "USD", Sum: 250, Count: 1, Min: 250, Max: 250},
+					},
+				},
+				err: nil,
+			},
+			wantOffsets: []string{"+00:00"},
+			wantGroupBy: []string{"day", "currency"},
+			want: web.ReportResponse{
+				From:     "2023-03-06",
+				To:       "2023-03-12",
+				Timezone: "UTC",
+				GroupBy:  []string{"week"},
+				Currency: "USD",
+				Rows:     []web.ReportRowResponse{{Period: "2023-03-06", Sum: 508, Count: 4, Average: 127, Min: 33, Max: 250}},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Convert Keeping Currency And Category Groups",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-06", To: "2023-03-12", GroupBy: []string{"currency", "category"}, Currency: "USD"},
+			cacheErr: redis.Nil,
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{
+					{
+						{Period: "2023-03-06", CategoryID: "100", Currency: "EUR", Sum: 50, Count: 1, Min: 50, Max: 50},
+						{Period: "2023-03-11", CategoryID: "100", Currency: "EUR", Sum: 150, Count: 1, Min: 150, Max: 150},
+					},
+				},
+				err: nil,
+			},
+			wantOffsets: []string{"+00:00"},
+			wantGroupBy: []string{"day", "currency", "category"},
+			want: web.ReportResponse{
+				From:     "2023-03-06",
+				To:       "2023-03-12",
+				Timezone: "UTC",
+				GroupBy:  []string{"currency", "category"},
+				Currency: "USD",
+				Rows:     []web.ReportRowResponse{{CategoryID: "100", Currency: "EUR", Sum: 213, Count: 2, Average: 106.5, Min: 53, Max: 160}},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Missing Exchange Rate",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-06", To: "2023-03-12", Currency: "JPY"},
+			cacheErr: redis.Nil,
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{{{Period: "2023-03-06", Currency: "USD", Sum: 100, Count: 1, Min: 100, Max: 100}}},
+				err: nil,
+			},
+			wantOffsets: []string{"+00:00"},
+			want:        web.ReportResponse{},
+			wantErr:     true,
+		},
+		{
+			name:     "Cache Unavailable",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-01", To: "2023-03-31"},
+			cacheErr: errors.New("connection refused"),
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{{{Sum: 0, Count: 0}}},
+				err: nil,
+			},
+			wantOffsets: []string{"+00:00"},
+			want: web.ReportResponse{
+				From:     "2023-03-01",
+				To:       "2023-03-31",
+				Timezone: "UTC",
+				GroupBy:  []string{},
+				Rows:     []web.ReportRowResponse{},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Error When Aggregate",
+			req:      web.ReportRequest{UserID: "123", From: "2023-03-01", To: "2023-03-31"},
+			cacheErr: redis.Nil,
+			mockAggregateTransactionRepository: &mockAggregateTransactionRepository{
+				res: [][]model.ReportRow{nil},
+				err: errors.New("error"),
+			},
+			wantOffsets: []string{"+00:00"},
+			want:        web.ReportResponse{},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockReportRepository := new(mockReportRepository.ReportRepository)
+
+			mockReportRepository.On("GetReport", ctx, mock.Anything).Return(tt.cache, tt.cacheErr)
+			mockReportRepository.On("StoreReport", ctx, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			mockExchangeRateRepository := new(mockExchangeRateRepository.ExchangeRateRepository)
+			mockExchangeRateRepository.On("FindExchangeRates", ctx, mock.Anything, mock.Anything, mock.Anything).Return(rates, nil)
+			var gotOffsets []string
+			var gotGroupBy []string
+			if tt.mockAggregateTransactionRepository != nil {
+				mockTransactionRepository.On("AggregateTransaction", ctx, "123", mock.Anything).Return(func(ctx context.Context, userId string, filter model.ReportFilter) []model.ReportRow {
+					gotOffsets = append(gotOffsets, filter.Offset)
+					gotGroupBy = filter.GroupBy
+					return tt.mockAggregateTransactionRepository.res[len(gotOffsets)-1]
+				}, tt.mockAggregateTransactionRepository.err)
+			}
+
+			reportService := report.NewReportService(mockTransactionRepository, mockReportRepository, mockExchangeRateRepository, &infrastructure.Config{})
+			got, err := reportService.GetReport(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.GetReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.GetReport() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotOffsets, tt.wantOffsets) {
+				t.Errorf("service.GetReport() offsets = %v, want %v", gotOffsets, tt.wantOffsets)
+			}
+			if tt.wantGroupBy != nil && !reflect.DeepEqual(gotGroupBy, tt.wantGroupBy) {
+				t.Errorf("service.GetReport() group by = %v, want %v", gotGroupBy, tt.wantGroupBy)
+			}
+			if !tt.wantErr && tt.mockAggregateTransactionRepository != nil {
+				mockReportRepository.AssertCalled(t, "StoreReport", ctx, mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
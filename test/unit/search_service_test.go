@@ -0,0 +1,116 @@
+package unit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/infrastructure/search"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	searchService "github.com/vnnyx/golang-dot-api/service/search"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestSearchService_Search(t *testing.T) {
+	index := search.NewMemoryIndex()
+	index.AddTransaction(entity.Transaction{TransactionID: "1", Name: "Coffee", UserID: "123"})
+	index.AddTransaction(entity.Transaction{TransactionID: "2", Name: "Coffee beans & coffee filters", UserID: "123"})
+	index.AddTransaction(entity.Transaction{TransactionID: "3", Name: "Coffeeshop <Downtown>", UserID: "124", Splits: []entity.TransactionSplit{{UserID: "123"}}})
+	index.AddTransaction(entity.Transaction{TransactionID: "4", Name: "Coffee", UserID: "124"})
+	index.AddTransaction(entity.Transaction{TransactionID: "5", Name: "Groceries", UserID: "123"})
+	index.AddUser(entity.User{UserID: "123", Username: "coffee_lover", Email: "lover@gmail.com"})
+	index.AddUser(entity.User{UserID: "124", Username: "username_test", Email: "email_test@gmail.com"})
+
+	tests := []struct {
+		name                string
+		req                 web.SearchRequest
+		wantTransactions    []web.SearchHitResponse
+		wantUsers           []web.SearchHitResponse
+		wantValidationError bool
+	}{
+		{
+			name: "Search By Prefix Ranked By Relevance",
+			req:  web.SearchRequest{UserID: "123", Query: "coff", Types: []string{"transaction"}},
+			wantTransactions: []web.SearchHitResponse{
+				{ID: "2", Score: 2, Highlights: map[string]string{"name": "<mark>Coffee</mark> beans &amp; <mark>coffee</mark> filters"}},
+				{ID: "1", Score: 1, Highlights: map[string]string{"name": "<mark>Coffee</mark>"}},
+				{ID: "3", Score: 1, Highlights: map[string]string{"name": "<mark>Coffeeshop</mark> &lt;Downtown&gt;"}},
+			},
+			wantUsers: []web.SearchHitResponse{},
+		},
+		{
+			name: "Exact Words Rank Above Prefixes",
+			req:  web.SearchRequest{UserID: "123", Query: "coffee", Limit: 2},
+			wantTransactions: []web.SearchHitResponse{
+				{ID: "2", Score: 4, Highlights: map[string]string{"name": "<mark>Coffee</mark> beans &amp; <mark>coffee</mark> filters"}},
+				{ID: "1", Score: 2, Highlights: map[string]string{"name": "<mark>Coffee</mark>"}},
+			},
+			wantUsers: []web.SearchHitResponse{{ID: "123", Score: 1, Highlights: map[string]string{"username": "<mark>coffee_lover</mark>"}}},
+		},
+		{
+			name:             "Every Term Must Match",
+			req:              web.SearchRequest{UserID: "123", Query: "+coffee -beans*", Types: []string{"transaction"}},
+			wantTransactions: []web.SearchHitResponse{{ID: "2", Score: 6, Highlights: map[string]string{"name": "<mark>Coffee</mark> <mark>beans</mark> &amp; <mark>coffee</mark> filters"}}},
+			wantUsers:        []web.SearchHitResponse{},
+		},
+		{
+			name:             "Search Users By Email",
+			req:              web.SearchRequest{UserID: "123", Query: "email_t", Types: []string{"user"}},
+			wantTransactions: []web.SearchHitResponse{},
+			wantUsers:        []web.SearchHitResponse{{ID: "124", Score: 1, Highlights: map[string]string{"email": "<mark>email_test</mark>@gmail.com"}}},
+		},
+		{
+			name:             "Search Users By Username And Email",
+			req:              web.SearchRequest{UserID: "123", Query: "gmail lover", Types: []string{"user"}},
+			wantTransactions: []web.SearchHitResponse{},
+			wantUsers:        []web.SearchHitResponse{{ID: "123", Score: 4, Highlights: map[string]string{"email": "<mark>lover</mark>@<mark>gmail</mark>.com"}}},
+		},
+		{
+			name:                "Query Without Words",
+			req:                 web.SearchRequest{UserID: "123", Query: "*+-"},
+			wantValidationError: true,
+		},
+		{
+			name:                "Unknown Type",
+			req:                 web.SearchRequest{UserID: "123", Query: "coffee", Types: []string{"category"}},
+			wantValidationError: true,
+		},
+		{
+			name:                "Limit Too Large",
+			req:                 web.SearchRequest{UserID: "123", Query: "coffee", Limit: 51},
+			wantValidationError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := searchService.NewSearchService(index)
+			got, err := service.Search(context.TODO(), tt.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.Search() want validation error")
+				return
+			}
+			if err != nil {
+				t.Errorf("service.Search() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got.Transactions, tt.wantTransactions) {
+				t.Errorf("service.Search() transactions = %v, want %v", got.Transactions, tt.wantTransactions)
+			}
+			if !reflect.DeepEqual(got.Users, tt.wantUsers) {
+				t.Errorf("service.Search() users = %v, want %v", got.Users, tt.wantUsers)
+			}
+		})
+	}
+}
+
+func TestSearchTerms(t *testing.T) {
+	got := util.SearchTerms(`Coffee "coffee" +café* (beans) email_test@gmail.com`)
+	want := []string{"coffee", "café", "beans", "email_test", "gmail", "com"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("util.SearchTerms() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,43 @@
+package unit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	healthController "github.com/vnnyx/golang-dot-api/controller/health"
+	"github.com/vnnyx/golang-dot-api/infrastructure/health"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func TestHealthChecker_ShutDown(t *testing.T) {
+	checked := false
+	checker := health.NewChecker(0)
+	checker.Register("mysql", true, func(ctx context.Context) error {
+		checked = true
+		return nil
+	})
+	app := echo.New()
+	healthController.NewHealthController(checker).Route(app)
+
+	checker.ShutDown()
+
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var response struct {
+		Data web.HealthResponse `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, web.HealthStatusUnavailable, response.Data.Status)
+	assert.False(t, checked, "readiness ran the checks while shutting down")
+
+	recorder = httptest.NewRecorder()
+	app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code, "liveness failed while draining")
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestSplitAmount(t *testing.T) {
+	tests := []struct {
+		name         string
+		total        int64
+		method       string
+		participants []web.TransactionSplitParticipant
+		want         []int64
+	}{
+		{
+			name:         "Equal Split Distributes Remainder",
+			total:        100,
+			method:       entity.SplitEqual,
+			participants: []web.TransactionSplitParticipant{{UserID: "a"}, {UserID: "b"}, {UserID: "c"}},
+			want:         []int64{34, 33, 33},
+		},
+		{
+			name:         "Exact Split",
+			total:        100,
+			method:       entity.SplitExact,
+			participants: []web.TransactionSplitParticipant{{UserID: "a", Amount: 70}, {UserID: "b", Amount: 30}},
+			want:         []int64{70, 30},
+		},
+		{
+			name:         "Percent Split Largest Remainder",
+			total:        101,
+			method:       entity.SplitPercent,
+			participants: []web.TransactionSplitParticipant{{UserID: "a", Percent: 33.33}, {UserID: "b", Percent: 33.33}, {UserID: "c", Percent: 33.34}},
+			want:         []int64{34, 33, 34},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := util.SplitAmount(tt.total, tt.method, tt.participants)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("util.SplitAmount() = %v, want %v", got, tt.want)
+			}
+			sum := int64(0)
+			for _, share := range got {
+				sum += share
+			}
+			if sum != tt.total {
+				t.Errorf("util.SplitAmount() sum = %v, want %v", sum, tt.total)
+			}
+		})
+	}
+}
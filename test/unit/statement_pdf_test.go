@@ -0,0 +1,129 @@
+package unit
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+// Run `go test -run TestRenderStatementPDF -update` after an intended layout
+// change to rewrite the golden files, then inspect them before committing.
+var updateGolden = flag.Bool("update", false, "rewrite golden files")
+
+func TestRenderStatementPDF(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	profile := model.MonthlyStatement{
+		UserID:      "123",
+		Username:    "username_test",
+		Email:       "email_test@gmail.com",
+		Handphone:   "08123456789",
+		Period:      time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+		Timezone:    "Asia/Jakarta",
+		GeneratedAt: time.Date(2023, 4, 1, 2, 30, 0, 0, time.UTC),
+	}
+
+	monthly := profile
+	monthly.Balances = []model.StatementBalance{
+		{Currency: "IDR", Opening: 12000000, Spent: 3450050, Closing: 15450050},
+		{Currency: "JPY", Opening: 0, Spent: 1500, Closing: 1500},
+	}
+	monthly.Transactions = []model.StatementLine{
+		{Date: time.Date(2023, 3, 1, 8, 0, 0, 0, jakarta), Name: "Electricity", Category: "Bills", Currency: "IDR", Amount: 450050},
+		{Date: time.Date(2023, 3, 12, 19, 0, 0, 0, jakarta), Name: "Café au lait", Category: "Food & Drinks", Currency: "IDR", Amount: 3000000},
+		{Date: time.Date(2023, 3, 20, 9, 0, 0, 0, jakarta), Name: "Ramen at a very long named restaurant near the station", Currency: "JPY", Amount: 1500},
+	}
+
+	converted := monthly
+	converted.Total = &model.StatementBalance{Currency: "USD", Opening: 73605, Spent: 23115, Closing: 96720}
+	converted.RatesDate = time.Date(2023, 3, 31, 0, 0, 0, 0, jakarta)
+
+	empty := profile
+
+	long := profile
+	long.Balances = []model.StatementBalance{{Currency: "IDR", Spent: 6000000, Closing: 6000000}}
+	for i := 0; i < 60; i++ {
+		long.Transactions = append(long.Transactions, model.StatementLine{
+			Date:     time.Date(2023, 3, 1+i/2, 12, 0, 0, 0, jakarta),
+			Name:     fmt.Sprintf("Groceries #%d", i+1),
+			Category: "Food",
+			Currency: "IDR",
+			Amount:   100000,
+		})
+	}
+
+	tests := []struct {
+		name      string
+		statement model.MonthlyStatement
+		golden    string
+	}{
+		{
+			name:      "Statement With Transactions",
+			statement: monthly,
+			golden:    "statement_monthly.pdf.golden",
+		},
+		{
+			name:      "Statement With Converted Total",
+			statement: converted,
+			golden:    "statement_converted.pdf.golden",
+		},
+		{
+			name:      "Statement Without Transactions",
+			statement: empty,
+			golden:    "statement_empty.pdf.golden",
+		},
+		{
+			name:      "Statement Spanning Several Pages",
+			statement: long,
+			golden:    "statement_multi_page.pdf.golden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bytes.Buffer
+			err := util.RenderStatementPDF(&got, tt.statement)
+			assert.Nil(t, err)
+
+			path := filepath.Join("testdata", tt.golden)
+			if *updateGolden {
+				assert.Nil(t, os.MkdirAll("testdata", 0o755))
+				assert.Nil(t, os.WriteFile(path, got.Bytes(), 0o644))
+			}
+			want, err := os.ReadFile(path)
+			assert.Nil(t, err)
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("util.RenderStatementPDF() output differs from %s, rerun with -update if the change is intended", path)
+			}
+		})
+	}
+}
+
+func TestFormatMinorUnits(t *testing.T) {
+	tests := []struct {
+		amount   int64
+		currency string
+		want     string
+	}{
+		{amount: 123450, currency: "IDR", want: "1,234.50"},
+		{amount: -5, currency: "USD", want: "-0.05"},
+		{amount: 0, currency: "IDR", want: "0.00"},
+		{amount: 1500, currency: "JPY", want: "1,500"},
+		{amount: 1234567, currency: "KWD", want: "1,234.567"},
+		{amount: -9223372036854775808, currency: "IDR", want: "-92,233,720,368,547,758.08"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := util.FormatMinorUnits(tt.amount, tt.currency)
+			if got != tt.want {
+				t.Errorf("util.FormatMinorUnits() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
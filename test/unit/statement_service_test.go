@@ -0,0 +1,167 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockExchangeRateRepository "github.com/vnnyx/golang-dot-api/repository/exchange/mocks"
+	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
+	mockUserRepository "github.com/vnnyx/golang-dot-api/repository/user/mocks"
+	"github.com/vnnyx/golang-dot-api/service/statement"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestStatementService_GetMonthlyStatement(t *testing.T) {
+	now := time.Date(2023, 4, 1, 2, 30, 0, 0, time.UTC)
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	user := entity.User{UserID: "123", Username: "username_test", Email: "email_test@gmail.com", Handphone: "08123456789"}
+	categoryId := "100"
+	transactions := []entity.Transaction{
+		{TransactionID: "1", Name: "Electricity", Amount: 450050, Currency: "IDR", UserID: "123", CategoryID: &categoryId, Category: &entity.Category{CategoryID: categoryId, Name: "Bills"}, CreatedAt: time.Date(2023, 2, 28, 17, 0, 0, 0, time.UTC)},
+		{TransactionID: "2", Name: "Coffee", Amount: 300, Currency: "USD", UserID: "123", CreatedAt: time.Date(2023, 3, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	ratesDate := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+	rates := []entity.ExchangeRate{
+		{Date: ratesDate, BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: "1.0875"},
+		{Date: ratesDate, BaseCurrency: "EUR", QuoteCurrency: "IDR", Rate: "16302.5"},
+		{Date: ratesDate, BaseCurrency: "EUR", QuoteCurrency: "JPY", Rate: "144.83"},
+	}
+	balances := []model.StatementBalance{
+		{Currency: "IDR", Opening: 12000000, Spent: 450050, Closing: 12450050},
+		{Currency: "JPY", Opening: 1500, Spent: 0, Closing: 1500},
+		{Currency: "USD", Opening: 0, Spent: 300, Closing: 300},
+	}
+	lines := []model.StatementLine{
+		{Date: time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta), Name: "Electricity", Category: "Bills", Currency: "IDR", Amount: 450050},
+		{Date: time.Date(2023, 3, 12, 7, 0, 0, 0, jakarta), Name: "Coffee", Currency: "USD", Amount: 300},
+	}
+	tests := []struct {
+		name                string
+		req                 web.StatementRequest
+		userErr             error
+		from                time.Time
+		to                  time.Time
+		opening             []model.CurrencyTotal
+		want                model.MonthlyStatement
+		wantFileName        string
+		wantErr             bool
+		wantValidationError bool
+	}{
+		{
+			name:    "Get Monthly Statement Success",
+			req:     web.StatementRequest{UserID: "123", Period: "2023-03", Timezone: "Asia/Jakarta"},
+			from:    time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+			to:      time.Date(2023, 4, 1, 0, 0, 0, 0, jakarta),
+			opening: []model.CurrencyTotal{{Currency: "IDR", Amount: 12000000}, {Currency: "JPY", Amount: 1500}},
+			want: model.MonthlyStatement{
+				UserID:       "123",
+				Username:     "username_test",
+				Email:        "email_test@gmail.com",
+				Handphone:    "08123456789",
+				Period:       time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+				Timezone:     "Asia/Jakarta",
+				GeneratedAt:  now,
+				Balances:     balances,
+				Transactions: lines,
+			},
+			wantFileName: "statement-2023-03.pdf",
+			wantErr:      false,
+		},
+		{
+			name:    "Get Monthly Statement With Converted Total",
+			req:     web.StatementRequest{UserID: "123", Period: "2023-03", Timezone: "Asia/Jakarta", Currency: "USD"},
+			from:    time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+			to:      time.Date(2023, 4, 1, 0, 0, 0, 0, jakarta),
+			opening: []model.CurrencyTotal{{Currency: "IDR", Amount: 12000000}, {Currency: "JPY", Amount: 1500}},
+			want: model.MonthlyStatement{
+				UserID:       "123",
+				Username:     "username_test",
+				Email:        "email_test@gmail.com",
+				Handphone:    "08123456789",
+				Period:       time.Date(2023, 3, 1, 0, 0, 0, 0, jakarta),
+				Timezone:     "Asia/Jakarta",
+				GeneratedAt:  now,
+				Balances:     balances,
+				Total:        &model.StatementBalance{Currency: "USD", Opening: 1926, Spent: 330, Closing: 2256},
+				RatesDate:    time.Date(2023, 3, 31, 0, 0, 0, 0, jakarta),
+				Transactions: lines,
+			},
+			wantFileName: "statement-2023-03.pdf",
+			wantErr:      false,
+		},
+		{
+			name:    "User Not Found",
+			req:     web.StatementRequest{UserID: "404", Period: "2023-03"},
+			userErr: errors.New("record not found"),
+			wantErr: true,
+		},
+		{
+			name:                "Invalid Period",
+			req:                 web.StatementRequest{UserID: "123", Period: "March"},
+			wantValidationError: true,
+		},
+		{
+			name:                "Invalid Timezone",
+			req:                 web.StatementRequest{UserID: "123", Period: "2023-03", Timezone: "Mars/Olympus"},
+			wantValidationError: true,
+		},
+		{
+			name:                "Invalid Currency",
+			req:                 web.StatementRequest{UserID: "123", Period: "2023-03", Currency: "usd"},
+			wantValidationError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+
+			mockUserRepository.On("FindUserByID", ctx, tt.req.UserID).Return(user, tt.userErr)
+			mockTransactionRepository.On("SumTransactionAmountByCurrency", ctx, user.UserID, tt.from).Return(tt.opening, nil)
+			mockTransactionRepository.On("FindTransactionBetween", ctx, user.UserID, tt.from, tt.to).Return(transactions, nil)
+			mockExchangeRateRepository := new(mockExchangeRateRepository.ExchangeRateRepository)
+			mockExchangeRateRepository.On("FindExchangeRates", ctx, mock.Anything, mock.Anything, mock.Anything).Return(rates, nil)
+
+			clock := gomonkey.ApplyFunc(time.Now, func() time.Time {
+				return now
+			})
+			defer clock.Reset()
+
+			service := statement.NewStatementService(mockUserRepository, mockTransactionRepository, mockExchangeRateRepository)
+			got, err := service.GetMonthlyStatement(ctx, tt.req)
+			if tt.wantValidationError {
+				var validationError exception.ValidationError
+				assert.ErrorAs(t, err, &validationError, "service.GetMonthlyStatement() want validation error")
+				return
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.GetMonthlyStatement() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			assert.Equal(t, tt.wantFileName, got.FileName)
+			assert.Equal(t, "application/pdf", got.ContentType)
+
+			var document, want bytes.Buffer
+			assert.Nil(t, got.Write(&document))
+			assert.Nil(t, util.RenderStatementPDF(&want, tt.want))
+			if !bytes.Equal(document.Bytes(), want.Bytes()) {
+				t.Errorf("service.GetMonthlyStatement() rendered a different statement than %+v", tt.want)
+			}
+		})
+	}
+}
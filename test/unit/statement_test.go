@@ -0,0 +1,120 @@
+package unit
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func TestParseCSVStatement(t *testing.T) {
+	content := "\ufeffTanggal,Keterangan,Jumlah,Mata Uang\n" +
+		"10/03/2023,Indomaret,\"-25,000.00\",idr\n" +
+		"31/02/2023,Broken,-1.00,IDR\n"
+	got, err := util.ParseCSVStatement(strings.NewReader(content), model.StatementMapping{
+		Name:     "Keterangan",
+		Amount:   "Jumlah",
+		Date:     "Tanggal",
+		Currency: "Mata Uang",
+	}, "02/01/2006")
+	if err != nil {
+		t.Fatalf("util.ParseCSVStatement() error = %v", err)
+	}
+	want := []model.StatementEntry{
+		{Row: 1, Date: time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC), Name: "Indomaret", Amount: "-25,000.00", Currency: "IDR"},
+		{Row: 2, Name: "Broken", Amount: "-1.00", Currency: "IDR", Error: "invalid date"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("util.ParseCSVStatement() = %v, want %v", got, want)
+	}
+
+	_, err = util.ParseCSVStatement(strings.NewReader("a,b\n1,2\n"), model.StatementMapping{}, "")
+	if err == nil {
+		t.Errorf("util.ParseCSVStatement() want error for missing columns")
+	}
+}
+
+func TestParseOFXStatement(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "SGML",
+			content: "OFXHEADER:100\nDATA:OFXSGML\n\n<OFX>\n<BANKMSGSRSV1><STMTTRNRS><STMTRS>\n<CURDEF>USD\n<BANKTRANLIST>\n" +
+				"<STMTTRN>\n<TRNTYPE>DEBIT\n<DTPOSTED>20230310120000.000[-5:EST]\n<TRNAMT>-12.50\n<FITID>A1\n<NAME>COFFEE SHOP\n</STMTTRN>\n" +
+				"<STMTTRN>\n<TRNTYPE>DEBIT\n<DTPOSTED>20230311\n<TRNAMT>-3.00\n<FITID>A2\n<MEMO>Parking\n</STMTTRN>\n" +
+				"</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1>\n</OFX>\n",
+		},
+		{
+			name: "XML",
+			content: `<?xml version="1.0"?><?OFX OFXHEADER="200" VERSION="220"?><OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>USD</CURDEF><BANKTRANLIST>` +
+				`<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20230310</DTPOSTED><TRNAMT>-12.50</TRNAMT><FITID>A1</FITID><NAME>COFFEE SHOP</NAME></STMTTRN>` +
+				`<STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20230311</DTPOSTED><TRNAMT>-3.00</TRNAMT><FITID>A2</FITID><MEMO>Parking</MEMO></STMTTRN>` +
+				`</BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`,
+		},
+	}
+	want := []model.StatementEntry{
+		{Row: 1, ExternalID: "A1", Date: time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC), Name: "COFFEE SHOP", Amount: "-12.50", Currency: "USD"},
+		{Row: 2, ExternalID: "A2", Date: time.Date(2023, 3, 11, 12, 0, 0, 0, time.UTC), Name: "Parking", Amount: "-3.00", Currency: "USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := util.ParseOFXStatement(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatalf("util.ParseOFXStatement() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("util.ParseOFXStatement() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseQIFStatement(t *testing.T) {
+	content := "!Type:Bank\nD03/10'23\nT-1,250.00\nPGrocery Store\n^\nD3/11/2023\nT-40.00\nMFuel\n^\nDnot a date\nT-1.00\nPBad\n^\n"
+	got, err := util.ParseQIFStatement(strings.NewReader(content), "")
+	if err != nil {
+		t.Fatalf("util.ParseQIFStatement() error = %v", err)
+	}
+	want := []model.StatementEntry{
+		{Row: 1, Date: time.Date(2023, 3, 10, 12, 0, 0, 0, time.UTC), Name: "Grocery Store", Amount: "-1,250.00"},
+		{Row: 2, Date: time.Date(2023, 3, 11, 12, 0, 0, 0, time.UTC), Name: "Fuel", Amount: "-40.00"},
+		{Row: 3, Name: "Bad", Amount: "-1.00", Error: "invalid date"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("util.ParseQIFStatement() = %v, want %v", got, want)
+	}
+}
+
+func TestParseMinorUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     int64
+		wantErr  bool
+	}{
+		{value: "-1,234.5", currency: "USD", want: -123450},
+		{value: "25000", currency: "IDR", want: 2500000},
+		{value: "1500", currency: "JPY", want: 1500},
+		{value: "1.234", currency: "KWD", want: 1234},
+		{value: "1.234", currency: "USD", wantErr: true},
+		{value: "12a", currency: "USD", wantErr: true},
+		{value: "", currency: "USD", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value+" "+tt.currency, func(t *testing.T) {
+			got, err := util.ParseMinorUnits(tt.value, tt.currency)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("util.ParseMinorUnits() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("util.ParseMinorUnits() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package unit
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/golang-dot-api/infrastructure/storage"
+)
+
+// fakeS3 is a minimal in-process stand-in for an S3 compatible endpoint that
+// understands path-style PUT, GET, HEAD and DELETE object requests.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (s3 *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s3.mu.Lock()
+	defer s3.mu.Unlock()
+
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-Amz-Content-Sha256") == "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+			body = decodeAwsChunked(body)
+		}
+		s3.objects[key] = body
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet, http.MethodHead:
+		body, ok := s3.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`)
+			}
+			return
+		}
+		w.Header().Set("ETag", `"etag"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		w.Header().Set("Content-Type", "application/octet-stream")
+		http.ServeContent(w, r, key, time.Time{}, bytes.NewReader(body))
+	case http.MethodDelete:
+		delete(s3.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// decodeAwsChunked strips the per-chunk signatures clients add to streaming
+// uploads over plain HTTP.
+func decodeAwsChunked(body []byte) (decoded []byte) {
+	for len(body) > 0 {
+		line := bytes.SplitN(body, []byte("\r\n"), 2)
+		if len(line) < 2 {
+			break
+		}
+		size, err := strconv.ParseInt(string(bytes.SplitN(line[0], []byte(";"), 2)[0]), 16, 64)
+		if err != nil || size == 0 || int64(len(line[1])) < size {
+			break
+		}
+		decoded = append(decoded, line[1][:size]...)
+		body = bytes.TrimPrefix(line[1][size:], []byte("\r\n"))
+	}
+	return decoded
+}
+
+func testStorage(t *testing.T, store storage.Storage) {
+	ctx := context.TODO()
+	content := []byte("receipt content")
+
+	err := store.Put(ctx, "transactions/1/receipt", "application/pdf", bytes.NewReader(content), int64(len(content)))
+	assert.Nil(t, err)
+
+	reader, err := store.Get(ctx, "transactions/1/receipt")
+	assert.Nil(t, err)
+	got, _ := io.ReadAll(reader)
+	_ = reader.Close()
+	assert.Equal(t, content, got)
+
+	err = store.Delete(ctx, "transactions/1/receipt")
+	assert.Nil(t, err)
+
+	_, err = store.Get(ctx, "transactions/1/receipt")
+	assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+}
+
+func TestLocalStorage(t *testing.T) {
+	baseDir := t.TempDir()
+	testStorage(t, storage.NewLocalStorage(baseDir))
+
+	err := storage.NewLocalStorage(baseDir).Put(context.TODO(), "../escape", "image/png", bytes.NewReader(pngContent), int64(len(pngContent)))
+	assert.Nil(t, err)
+	reader, err := storage.NewLocalStorage(baseDir).Get(context.TODO(), "escape")
+	assert.Nil(t, err)
+	_ = reader.Close()
+}
+
+func TestS3Storage(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{objects: make(map[string][]byte)})
+	defer server.Close()
+
+	endpoint, _ := url.Parse(server.URL)
+	store, err := storage.NewS3Storage(endpoint.Host, "us-east-1", "dot-api", "access", "secret", false)
+	assert.Nil(t, err)
+	testStorage(t, store)
+}
@@ -0,0 +1,171 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	mockTagRepository "github.com/vnnyx/golang-dot-api/repository/tag/mocks"
+	"github.com/vnnyx/golang-dot-api/service/tag"
+)
+
+func TestTagService_CreateTag(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.TagCreateRequest
+	}
+	type mockFindOrInsertTagRepository struct {
+		res entity.Tag
+		err error
+	}
+	tests := []struct {
+		name                          string
+		args                          args
+		mockFindOrInsertTagRepository *mockFindOrInsertTagRepository
+		want                          web.TagResponse
+		wantErr                       bool
+	}{
+		{
+			name: "Create Tag Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TagCreateRequest{
+					UserID: "123",
+					Name:   "subscription",
+				},
+			},
+			mockFindOrInsertTagRepository: &mockFindOrInsertTagRepository{
+				res: entity.Tag{TagID: "456", UserID: "123", Name: "subscription"},
+				err: nil,
+			},
+			want: web.TagResponse{
+				TagID:  "456",
+				UserID: "123",
+				Name:   "subscription",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Error When Insert data to DB",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TagCreateRequest{
+					UserID: "123",
+					Name:   "subscription",
+				},
+			},
+			mockFindOrInsertTagRepository: &mockFindOrInsertTagRepository{
+				res: entity.Tag{},
+				err: errors.New("error"),
+			},
+			want:    web.TagResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTagRepository := new(mockTagRepository.TagRepository)
+
+			if tt.mockFindOrInsertTagRepository != nil {
+				mockTagRepository.On("FindOrInsertTag", tt.args.ctx, mock.Anything).Return(tt.mockFindOrInsertTagRepository.res, tt.mockFindOrInsertTagRepository.err)
+			}
+
+			tagService := tag.NewTagService(mockTagRepository)
+			got, err := tagService.CreateTag(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.CreateTag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagService_RemoveTag(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		userId string
+		tagId  string
+	}
+	type mockFindTagByIDRepository struct {
+		res entity.Tag
+		err error
+	}
+	type mockDeleteTagRepository struct {
+		err error
+	}
+	tests := []struct {
+		name                      string
+		args                      args
+		mockFindTagByIDRepository *mockFindTagByIDRepository
+		mockDeleteTagRepository   *mockDeleteTagRepository
+		wantErr                   bool
+	}{
+		{
+			name: "Remove Tag Success",
+			args: args{
+				ctx:    context.TODO(),
+				userId: "123",
+				tagId:  "456",
+			},
+			mockFindTagByIDRepository: &mockFindTagByIDRepository{
+				res: entity.Tag{TagID: "456", UserID: "123", Name: "subscription"},
+				err: nil,
+			},
+			mockDeleteTagRepository: &mockDeleteTagRepository{
+				err: nil,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Tag Belongs To Another User",
+			args: args{
+				ctx:    context.TODO(),
+				userId: "123",
+				tagId:  "456",
+			},
+			mockFindTagByIDRepository: &mockFindTagByIDRepository{
+				res: entity.Tag{TagID: "456", UserID: "999", Name: "subscription"},
+				err: nil,
+			},
+			wantErr: true,
+		},
+		{
+			name: "Tag Not Found",
+			args: args{
+				ctx:    context.TODO(),
+				userId: "123",
+				tagId:  "404",
+			},
+			mockFindTagByIDRepository: &mockFindTagByIDRepository{
+				res: entity.Tag{},
+				err: errors.New("record not found"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockTagRepository := new(mockTagRepository.TagRepository)
+
+			if tt.mockFindTagByIDRepository != nil {
+				mockTagRepository.On("FindTagByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTagByIDRepository.res, tt.mockFindTagByIDRepository.err)
+			}
+			if tt.mockDeleteTagRepository != nil {
+				mockTagRepository.On("DeleteTag", tt.args.ctx, mock.Anything).Return(tt.mockDeleteTagRepository.err)
+			}
+
+			tagService := tag.NewTagService(mockTagRepository)
+			err := tagService.RemoveTag(tt.args.ctx, tt.args.userId, tt.args.tagId)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.RemoveTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package unit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-redis/redis/v8"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	transactionController "github.com/vnnyx/golang-dot-api/controller/transaction"
+	"github.com/vnnyx/golang-dot-api/infrastructure"
+	"github.com/vnnyx/golang-dot-api/infrastructure/notifier"
+	"github.com/vnnyx/golang-dot-api/infrastructure/tracing"
+	"github.com/vnnyx/golang-dot-api/middleware"
+	"github.com/vnnyx/golang-dot-api/repository/budget"
+	"github.com/vnnyx/golang-dot-api/repository/category"
+	"github.com/vnnyx/golang-dot-api/repository/tag"
+	transactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"github.com/vnnyx/golang-dot-api/service/transaction"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// recordSpans installs a tracer provider that keeps finished spans in memory
+// until the test ends.
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(infrastructure.NewPropagator())
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+	return exporter
+}
+
+func spanAttribute(span tracetest.SpanStub, key string) string {
+	for _, attribute := range span.Attributes {
+		if string(attribute.Key) == key {
+			return attribute.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestTracing_CreateTransaction(t *testing.T) {
+	exporter := recordSpans(t)
+
+	db, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	DB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{Logger: logger.Discard})
+	require.NoError(t, err)
+	require.NoError(t, DB.Use(tracing.GormPlugin{}))
+
+	sqlMock.ExpectQuery("SELECT \\* FROM `users`").WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "username"}).AddRow("user-1", "vnnyx"))
+	sqlMock.ExpectQuery("SELECT \\* FROM `category_rules`").WithArgs("user-1").
+		WillReturnRows(sqlmock.NewRows([]string{"rule_id"}))
+	sqlMock.ExpectBegin()
+	sqlMock.ExpectExec("INSERT INTO `transactions`").WillReturnResult(sqlmock.NewResult(1, 1))
+	sqlMock.ExpectCommit()
+
+	service := transaction.NewTransactionService(
+		transactionRepository.NewTransactionRepository(DB),
+		user.NewUserRepository(DB),
+		category.NewCategoryRepository(DB),
+		tag.NewTagRepository(DB),
+		budget.NewBudgetRepository(DB),
+		notifier.NewLogNotifier(),
+	)
+	controller := transactionController.NewTransactionController(service, nil)
+	app := echo.New()
+	app.Use(middleware.Tracing())
+	app.POST("/dot-api/transaction", controller.CreateTransaction)
+
+	const (
+		traceId      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanId = "00f067aa0ba902b7"
+	)
+	request := httptest.NewRequest(http.MethodPost, "/dot-api/transaction?user_id=user-1", strings.NewReader(`{"name":"Coffee","amount":25000}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set("traceparent", "00-"+traceId+"-"+parentSpanId+"-01")
+	recorder := httptest.NewRecorder()
+	app.ServeHTTP(recorder, request)
+
+	require.Equal(t, http.StatusCreated, recorder.Code, recorder.Body.String())
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 4)
+	server := spans[len(spans)-1]
+	assert.Equal(t, "POST /dot-api/transaction", server.Name)
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind)
+	assert.Equal(t, traceId, server.SpanContext.TraceID().String())
+	assert.Equal(t, parentSpanId, server.Parent.SpanID().String())
+	assert.True(t, server.Parent.IsRemote())
+	assert.Equal(t, "201", spanAttribute(server, "http.status_code"))
+
+	wantQueries := []struct {
+		name  string
+		table string
+	}{
+		{name: "gorm.query", table: "users"},
+		{name: "gorm.query", table: "category_rules"},
+		{name: "gorm.create", table: "transactions"},
+	}
+	for i, want := range wantQueries {
+		span := spans[i]
+		assert.Equal(t, want.name, span.Name)
+		assert.Equal(t, trace.SpanKindClient, span.SpanKind)
+		assert.Equal(t, server.SpanContext.SpanID(), span.Parent.SpanID(), "%s %s is not a child of the request", want.name, want.table)
+		assert.Equal(t, want.table, spanAttribute(span, "db.sql.table"))
+		assert.NotContains(t, spanAttribute(span, "db.statement"), "user-1")
+	}
+}
+
+func TestTracing_RedisHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{
+			name:       "Missing Key",
+			err:        redis.Nil,
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "Failure",
+			err:        errors.New("connection refused"),
+			wantStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := recordSpans(t)
+			ctx, parent := otel.Tracer(tracing.TracerName).Start(context.Background(), "GET /dot-api/user")
+
+			hook := tracing.RedisHook{}
+			cmd := redis.NewStringCmd(ctx, "get", "access_uuid")
+			hookCtx, err := hook.BeforeProcess(ctx, cmd)
+			require.NoError(t, err)
+			cmd.SetErr(tt.err)
+			require.NoError(t, hook.AfterProcess(hookCtx, cmd))
+			parent.End()
+
+			spans := exporter.GetSpans()
+			require.Len(t, spans, 2)
+			assert.Equal(t, "redis.get", spans[0].Name)
+			assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+			assert.Equal(t, tt.wantStatus, spans[0].Status.Code)
+			assert.Equal(t, "get", spanAttribute(spans[0], "db.operation"))
+		})
+	}
+}
@@ -5,15 +5,23 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/golang-dot-api/exception"
+	mockNotifier "github.com/vnnyx/golang-dot-api/infrastructure/notifier/mocks"
+	"github.com/vnnyx/golang-dot-api/model"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	mockBudgetRepository "github.com/vnnyx/golang-dot-api/repository/budget/mocks"
+	mockCategoryRepository "github.com/vnnyx/golang-dot-api/repository/category/mocks"
+	mockTagRepository "github.com/vnnyx/golang-dot-api/repository/tag/mocks"
 	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
 	mockUserRepository "github.com/vnnyx/golang-dot-api/repository/user/mocks"
 	"github.com/vnnyx/golang-dot-api/service/transaction"
+	"github.com/vnnyx/golang-dot-api/validation"
 )
 
 func TestTransactionService_CreateTransaction(t *testing.T) {
@@ -25,17 +33,34 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 		res entity.User
 		err error
 	}
+	type mockFindCategoryByIDRepository struct {
+		res entity.Category
+		err error
+	}
+	type mockFindCategoryRuleByUserIdRepository struct {
+		res []entity.CategoryRule
+		err error
+	}
+	type mockFindOrInsertTagRepository struct {
+		res entity.Tag
+		err error
+	}
 	type mockInsertTransactionRepository struct {
 		res entity.Transaction
 		err error
 	}
+	categoryId := "789"
 	tests := []struct {
-		name                            string
-		args                            args
-		mockFindUserByIDRepository      *mockFindUserByIDRepository
-		mockInsertTransactionRepository *mockInsertTransactionRepository
-		want                            web.TransactionResponse
-		wantErr                         bool
+		name                                   string
+		args                                   args
+		mockFindUserByIDRepository             *mockFindUserByIDRepository
+		mockFindCategoryByIDRepository         *mockFindCategoryByIDRepository
+		mockFindCategoryRuleByUserIdRepository *mockFindCategoryRuleByUserIdRepository
+		mockFindOrInsertTagRepository          *mockFindOrInsertTagRepository
+		mockInsertTransactionRepository        *mockInsertTransactionRepository
+		wantCategoryID                         *string
+		want                                   web.TransactionResponse
+		wantErr                                bool
 	}{
 		{
 			name: "Transaction CreateTransaction Success",
@@ -56,6 +81,10 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 				},
 				err: nil,
 			},
+			mockFindCategoryRuleByUserIdRepository: &mockFindCategoryRuleByUserIdRepository{
+				res: []entity.CategoryRule{},
+				err: nil,
+			},
 			mockInsertTransactionRepository: &mockInsertTransactionRepository{
 				res: entity.Transaction{
 					TransactionID: "456",
@@ -71,6 +100,100 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Category Assigned By Rule",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionCreateRequest{
+					Name:   "Monthly Netflix",
+					Tags:   []string{"subscription", "subscription"},
+					UserID: "123",
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+				},
+				err: nil,
+			},
+			mockFindCategoryRuleByUserIdRepository: &mockFindCategoryRuleByUserIdRepository{
+				res: []entity.CategoryRule{
+					{
+						RuleID:     "1",
+						UserID:     "123",
+						CategoryID: "000",
+						MatchType:  entity.RuleMatchPrefix,
+						Pattern:    "grocery",
+					},
+					{
+						RuleID:     "2",
+						UserID:     "123",
+						CategoryID: categoryId,
+						MatchType:  entity.RuleMatchContains,
+						Pattern:    "netflix",
+					},
+				},
+				err: nil,
+			},
+			mockFindOrInsertTagRepository: &mockFindOrInsertTagRepository{
+				res: entity.Tag{
+					TagID:  "321",
+					UserID: "123",
+					Name:   "subscription",
+				},
+				err: nil,
+			},
+			mockInsertTransactionRepository: &mockInsertTransactionRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "Monthly Netflix",
+					UserID:        "123",
+					CategoryID:    &categoryId,
+					Tags: []entity.Tag{
+						{TagID: "321", UserID: "123", Name: "subscription"},
+					},
+				},
+				err: nil,
+			},
+			wantCategoryID: &categoryId,
+			want: web.TransactionResponse{
+				TransactionID: "456",
+				Name:          "Monthly Netflix",
+				UserID:        "123",
+				CategoryID:    categoryId,
+				Tags:          []string{"subscription"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Category Belongs To Another User",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionCreateRequest{
+					Name:       "product_test",
+					CategoryID: categoryId,
+					UserID:     "123",
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+				},
+				err: nil,
+			},
+			mockFindCategoryByIDRepository: &mockFindCategoryByIDRepository{
+				res: entity.Category{
+					CategoryID: categoryId,
+					UserID:     "999",
+					Name:       "Bills",
+				},
+				err: nil,
+			},
+			want:    web.TransactionResponse{},
+			wantErr: true,
+		},
 		{
 			name: "Error When Find User By ID",
 			args: args{
@@ -106,6 +229,10 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 				},
 				err: nil,
 			},
+			mockFindCategoryRuleByUserIdRepository: &mockFindCategoryRuleByUserIdRepository{
+				res: []entity.CategoryRule{},
+				err: nil,
+			},
 			mockInsertTransactionRepository: &mockInsertTransactionRepository{
 				res: entity.Transaction{},
 				err: errors.New("error"),
@@ -118,12 +245,27 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindUserByIDRepository != nil {
 				mockUserRepository.On("FindUserByID", tt.args.ctx, mock.Anything).Return(tt.mockFindUserByIDRepository.res, tt.mockFindUserByIDRepository.err)
 			}
+			if tt.mockFindCategoryByIDRepository != nil {
+				mockCategoryRepository.On("FindCategoryByID", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByIDRepository.res, tt.mockFindCategoryByIDRepository.err)
+			}
+			if tt.mockFindCategoryRuleByUserIdRepository != nil {
+				mockCategoryRepository.On("FindCategoryRuleByUserId", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryRuleByUserIdRepository.res, tt.mockFindCategoryRuleByUserIdRepository.err)
+			}
+			if tt.mockFindOrInsertTagRepository != nil {
+				mockTagRepository.On("FindOrInsertTag", tt.args.ctx, mock.Anything).Return(tt.mockFindOrInsertTagRepository.res, tt.mockFindOrInsertTagRepository.err).Once()
+			}
 			if tt.mockInsertTransactionRepository != nil {
-				mockTransactionRepository.On("InsertTransaction", tt.args.ctx, mock.Anything).Return(tt.mockInsertTransactionRepository.res, tt.mockInsertTransactionRepository.err)
+				mockTransactionRepository.On("InsertTransaction", tt.args.ctx, mock.MatchedBy(func(transaction entity.Transaction) bool {
+					return reflect.DeepEqual(transaction.CategoryID, tt.wantCategoryID)
+				})).Return(tt.mockInsertTransactionRepository.res, tt.mockInsertTransactionRepository.err)
 			}
 
 			transactionId := gomonkey.ApplyFunc(uuid.NewString, func() string {
@@ -131,7 +273,7 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 			})
 			defer transactionId.Reset()
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			got, err := transactionService.CreateTransaction(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.CreateTransaction() error = %v, wantErr %v", err, tt.wantErr)
@@ -144,6 +286,289 @@ func TestTransactionService_CreateTransaction(t *testing.T) {
 	}
 }
 
+func TestTransactionService_CreateSplitTransaction(t *testing.T) {
+	tests := []struct {
+		name       string
+		split      *web.TransactionSplitRequest
+		users      map[string]bool
+		wantSplits []entity.TransactionSplit
+		wantErr    bool
+	}{
+		{
+			name: "Split Equally",
+			split: &web.TransactionSplitRequest{
+				Method: entity.SplitEqual,
+				Participants: []web.TransactionSplitParticipant{
+					{UserID: "123"}, {UserID: "124"}, {UserID: "125"},
+				},
+			},
+			users: map[string]bool{"123": true, "124": true, "125": true},
+			wantSplits: []entity.TransactionSplit{
+				{UserID: "123", Amount: 3334},
+				{UserID: "124", Amount: 3333},
+				{UserID: "125", Amount: 3333},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Split By Percentage",
+			split: &web.TransactionSplitRequest{
+				Method: entity.SplitPercent,
+				Participants: []web.TransactionSplitParticipant{
+					{UserID: "123", Percent: 62.5}, {UserID: "124", Percent: 37.5},
+				},
+			},
+			users: map[string]bool{"123": true, "124": true},
+			wantSplits: []entity.TransactionSplit{
+				{UserID: "123", Amount: 6250, PercentBasisPoints: 6250},
+				{UserID: "124", Amount: 3750, PercentBasisPoints: 3750},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Participant Not Found",
+			split: &web.TransactionSplitRequest{
+				Method: entity.SplitExact,
+				Participants: []web.TransactionSplitParticipant{
+					{UserID: "123", Amount: 4000}, {UserID: "404", Amount: 6000},
+				},
+			},
+			users:   map[string]bool{"123": true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			mockUserRepository.On("FindUserByID", ctx, mock.Anything).Return(func(ctx context.Context, userId string) entity.User {
+				return entity.User{UserID: userId}
+			}, func(ctx context.Context, userId string) error {
+				if tt.users[userId] {
+					return nil
+				}
+				return errors.New("user not found")
+			})
+			mockCategoryRepository.On("FindCategoryRuleByUserId", ctx, mock.Anything).Return([]entity.CategoryRule{}, nil)
+			var gotSplits []entity.TransactionSplit
+			mockTransactionRepository.On("InsertTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+				gotSplits = transaction.Splits
+				return transaction
+			}, nil)
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			_, err := transactionService.CreateTransaction(ctx, web.TransactionCreateRequest{
+				Name:   "Dinner",
+				Amount: 10000,
+				UserID: "123",
+				Split:  tt.split,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(gotSplits, tt.wantSplits) {
+				t.Errorf("service.CreateTransaction() splits = %v, want %v", gotSplits, tt.wantSplits)
+			}
+		})
+	}
+}
+
+func TestTransactionService_CreateTransactionBudgetAlert(t *testing.T) {
+	categoryId := "101"
+	createdAt := time.Date(2023, 3, 31, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		timezone      string
+		currency      string
+		spent         int64
+		alerted       map[int]bool
+		wantThreshold int
+		wantPeriod    string
+	}{
+		{
+			name:          "Crossed 80 Percent",
+			timezone:      "UTC",
+			spent:         8500,
+			wantThreshold: 80,
+			wantPeriod:    "2023-03",
+		},
+		{
+			name:          "Crossed 100 Percent After 80 Percent Alert",
+			timezone:      "UTC",
+			spent:         12000,
+			alerted:       map[int]bool{80: true},
+			wantThreshold: 100,
+			wantPeriod:    "2023-03",
+		},
+		{
+			name:          "Period In Budget Timezone",
+			timezone:      "Asia/Jakarta",
+			spent:         10000,
+			wantThreshold: 100,
+			wantPeriod:    "2023-04",
+		},
+		{
+			name:     "Already Alerted",
+			timezone: "UTC",
+			spent:    9000,
+			alerted:  map[int]bool{80: true},
+		},
+		{
+			name:     "Below Threshold",
+			timezone: "UTC",
+			spent:    5000,
+		},
+		{
+			name:     "Budget In Another Currency",
+			timezone: "UTC",
+			currency: "USD",
+			spent:    12000,
+		},
+	}
+	for _, tt := range tests {
+		// The budget check may still be returning from its last mock call
+		// when the subtest ends.
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			parentId := "100"
+			categories := []entity.Category{
+				{CategoryID: parentId, UserID: "123", Name: "Bills"},
+				{CategoryID: categoryId, UserID: "123", ParentID: &parentId, Name: "Internet"},
+			}
+			currency := tt.currency
+			if currency == "" {
+				currency = "IDR"
+			}
+			budget := entity.Budget{BudgetID: "1", UserID: "123", CategoryID: parentId, Amount: 10000, Currency: currency, Timezone: tt.timezone}
+
+			mockUserRepository.On("FindUserByID", ctx, "123").Return(entity.User{UserID: "123"}, nil)
+			mockCategoryRepository.On("FindCategoryByID", ctx, categoryId).Return(categories[1], nil)
+			mockTransactionRepository.On("InsertTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+				transaction.CreatedAt = createdAt
+				return transaction
+			}, nil)
+
+			done := make(chan struct{})
+			mockCategoryRepository.On("FindCategoryByUserId", mock.Anything, "123").Return(categories, nil)
+			mockBudgetRepository.On("FindBudgetByCategoryIds", mock.Anything, "123", []string{categoryId, parentId}).Return([]entity.Budget{budget}, nil).Run(func(args mock.Arguments) {
+				// An IDR transaction does not count towards a USD budget.
+				if budget.Currency != "IDR" {
+					close(done)
+				}
+			})
+			mockTransactionRepository.On("SumTransactionAmount", mock.Anything, "123", "IDR", []string{parentId, categoryId}, mock.Anything, mock.Anything).Return(tt.spent, nil).Run(func(args mock.Arguments) {
+				if tt.spent*100 < budget.Amount*80 {
+					close(done)
+				}
+			})
+			// done is closed by the last call the budget check makes.
+			mockBudgetRepository.On("InsertBudgetAlert", mock.Anything, mock.Anything).Return(func(ctx context.Context, alert entity.BudgetAlert) bool {
+				return !tt.alerted[alert.Threshold]
+			}, nil).Run(func(args mock.Arguments) {
+				if args.Get(1).(entity.BudgetAlert).Threshold == 80 && tt.wantThreshold == 0 {
+					close(done)
+				}
+			})
+			var got model.Notification
+			mockNotifier.On("Notify", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				got = args.Get(1).(model.Notification)
+				close(done)
+			})
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			_, err := transactionService.CreateTransaction(ctx, web.TransactionCreateRequest{
+				Name:       "Fiber",
+				Amount:     1000,
+				UserID:     "123",
+				CategoryID: categoryId,
+			})
+			if err != nil {
+				t.Fatalf("service.CreateTransaction() error = %v", err)
+			}
+
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("budget check did not finish")
+			}
+			if tt.wantThreshold == 0 {
+				mockNotifier.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+				if budget.Currency != "IDR" {
+					mockTransactionRepository.AssertNotCalled(t, "SumTransactionAmount", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				}
+				return
+			}
+			if got.Type != model.NotificationBudgetThreshold || got.Data["threshold"] != tt.wantThreshold || got.Data["period"] != tt.wantPeriod {
+				t.Errorf("notifier.Notify() notification = %v, want threshold %d period %s", got, tt.wantThreshold, tt.wantPeriod)
+			}
+		})
+	}
+}
+
+func TestTransactionService_WaitBudgetChecks(t *testing.T) {
+	ctx := context.TODO()
+	mockUserRepository := new(mockUserRepository.UserRepository)
+	mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+	mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+	mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+	categoryId := "101"
+	category := entity.Category{CategoryID: categoryId, UserID: "123", Name: "Internet"}
+
+	mockUserRepository.On("FindUserByID", ctx, "123").Return(entity.User{UserID: "123"}, nil)
+	mockCategoryRepository.On("FindCategoryByID", ctx, categoryId).Return(category, nil)
+	mockTransactionRepository.On("InsertTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+		return transaction
+	}, nil)
+	started, release := make(chan struct{}), make(chan struct{})
+	mockCategoryRepository.On("FindCategoryByUserId", mock.Anything, "123").Return([]entity.Category{category}, nil).Run(func(args mock.Arguments) {
+		close(started)
+		<-release
+	})
+	mockBudgetRepository.On("FindBudgetByCategoryIds", mock.Anything, "123", []string{categoryId}).Return([]entity.Budget{}, nil)
+
+	transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, new(mockTagRepository.TagRepository), mockBudgetRepository, new(mockNotifier.Notifier))
+	_, err := transactionService.CreateTransaction(ctx, web.TransactionCreateRequest{
+		Name:       "Fiber",
+		Amount:     1000,
+		UserID:     "123",
+		CategoryID: categoryId,
+	})
+	if err != nil {
+		t.Fatalf("service.CreateTransaction() error = %v", err)
+	}
+	<-started
+
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if err := transactionService.WaitBudgetChecks(waitCtx); err != context.DeadlineExceeded {
+		t.Errorf("service.WaitBudgetChecks() error = %v, want %v while a check is running", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	waitCtx, cancel = context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	if err := transactionService.WaitBudgetChecks(waitCtx); err != nil {
+		t.Fatalf("service.WaitBudgetChecks() error = %v", err)
+	}
+	// The check has returned, so it must have finished its lookups.
+	mockBudgetRepository.AssertCalled(t, "FindBudgetByCategoryIds", mock.Anything, "123", []string{categoryId})
+}
+
 func TestTransactionService_GetTransactionById(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -199,6 +624,10 @@ func TestTransactionService_GetTransactionById(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindTransactionByIDRepository != nil {
 				mockTransactionRepository.On("FindTransactionByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByIDRepository.res, tt.mockFindTransactionByIDRepository.err)
@@ -209,7 +638,7 @@ func TestTransactionService_GetTransactionById(t *testing.T) {
 			})
 			defer transactionId.Reset()
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			got, err := transactionService.GetTransactionById(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetTransactionById() error = %v, wantErr %v", err, tt.wantErr)
@@ -277,6 +706,10 @@ func TestTransactionService_GetAllTransaction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindAllTransactionRepository != nil {
 				mockTransactionRepository.On("FindAllTransaction", tt.args.ctx, mock.Anything).Return(tt.mockFindAllTransactionRepository.res, tt.mockFindAllTransactionRepository.err)
@@ -287,7 +720,7 @@ func TestTransactionService_GetAllTransaction(t *testing.T) {
 			})
 			defer transactionId.Reset()
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			got, err := transactionService.GetAllTransaction(tt.args.ctx)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetAllTransaction() error = %v, wantErr %v", err, tt.wantErr)
@@ -303,21 +736,29 @@ func TestTransactionService_GetAllTransaction(t *testing.T) {
 func TestTransactionService_GetTransactionByUserId(t *testing.T) {
 	type args struct {
 		ctx context.Context
-		req string
+		req web.TransactionListRequest
 	}
 	type mockFindUserByIDRepository struct {
 		res entity.User
 		err error
 	}
+	type mockFindCategoryByUserIdRepository struct {
+		res []entity.Category
+		err error
+	}
 	type mockFindTransactionByUserIdRepository struct {
 		res []entity.Transaction
 		err error
 	}
+	parentId := "789"
+	shareAmount := int64(5000)
 	tests := []struct {
 		name                                  string
 		args                                  args
 		mockFindUserByIDRepository            *mockFindUserByIDRepository
+		mockFindCategoryByUserIdRepository    *mockFindCategoryByUserIdRepository
 		mockFindTransactionByUserIdRepository *mockFindTransactionByUserIdRepository
+		wantFilter                            model.TransactionFilter
 		want                                  []web.TransactionResponse
 		wantErr                               bool
 	}{
@@ -325,7 +766,7 @@ func TestTransactionService_GetTransactionByUserId(t *testing.T) {
 			name: "GetTransaction By User ID Success",
 			args: args{
 				ctx: context.TODO(),
-				req: "123",
+				req: web.TransactionListRequest{UserID: "123"},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
 				res: entity.User{
@@ -356,11 +797,109 @@ func TestTransactionService_GetTransactionByUserId(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Participant Sees Their Share",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionListRequest{UserID: "123"},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+				},
+				err: nil,
+			},
+			mockFindTransactionByUserIdRepository: &mockFindTransactionByUserIdRepository{
+				res: []entity.Transaction{
+					{
+						TransactionID: "456",
+						Name:          "Dinner",
+						Amount:        10000,
+						UserID:        "999",
+						SplitMethod:   entity.SplitEqual,
+						Splits: []entity.TransactionSplit{
+							{TransactionID: "456", UserID: "999", Amount: 5000},
+							{TransactionID: "456", UserID: "123", Amount: 5000},
+						},
+					},
+				},
+				err: nil,
+			},
+			want: []web.TransactionResponse{
+				{
+					TransactionID: "456",
+					Name:          "Dinner",
+					Amount:        10000,
+					UserID:        "999",
+					SplitMethod:   entity.SplitEqual,
+					Splits: []web.TransactionSplitResponse{
+						{UserID: "999", Amount: 5000},
+						{UserID: "123", Amount: 5000},
+					},
+					Share: &shareAmount,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Filter By Category Includes Subcategories",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionListRequest{UserID: "123", CategoryID: parentId, Tag: "subscription"},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+				},
+				err: nil,
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: []entity.Category{
+					{CategoryID: parentId, UserID: "123", Name: "Bills"},
+					{CategoryID: "790", UserID: "123", ParentID: &parentId, Name: "Internet"},
+					{CategoryID: "800", UserID: "123", Name: "Food"},
+				},
+				err: nil,
+			},
+			mockFindTransactionByUserIdRepository: &mockFindTransactionByUserIdRepository{
+				res: []entity.Transaction{},
+				err: nil,
+			},
+			wantFilter: model.TransactionFilter{
+				CategoryIDs: []string{parentId, "790"},
+				Tag:         "subscription",
+			},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name: "Filter By Unknown Category",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionListRequest{UserID: "123", CategoryID: "999"},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+				},
+				err: nil,
+			},
+			mockFindCategoryByUserIdRepository: &mockFindCategoryByUserIdRepository{
+				res: []entity.Category{
+					{CategoryID: parentId, UserID: "123", Name: "Bills"},
+				},
+				err: nil,
+			},
+			wantErr: true,
+		},
 		{
 			name: "Error When Find User ID",
 			args: args{
 				ctx: context.TODO(),
-				req: "1234",
+				req: web.TransactionListRequest{UserID: "1234"},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
 				res: entity.User{},
@@ -372,7 +911,7 @@ func TestTransactionService_GetTransactionByUserId(t *testing.T) {
 			name: "Error When Get Transaction Data",
 			args: args{
 				ctx: context.TODO(),
-				req: "123",
+				req: web.TransactionListRequest{UserID: "123"},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
 				res: entity.User{
@@ -395,15 +934,22 @@ func TestTransactionService_GetTransactionByUserId(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindUserByIDRepository != nil {
 				mockUserRepository.On("FindUserByID", tt.args.ctx, mock.Anything).Return(tt.mockFindUserByIDRepository.res, tt.mockFindUserByIDRepository.err)
 			}
+			if tt.mockFindCategoryByUserIdRepository != nil {
+				mockCategoryRepository.On("FindCategoryByUserId", tt.args.ctx, mock.Anything).Return(tt.mockFindCategoryByUserIdRepository.res, tt.mockFindCategoryByUserIdRepository.err)
+			}
 			if tt.mockFindTransactionByUserIdRepository != nil {
-				mockTransactionRepository.On("FindTransactionByUserId", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByUserIdRepository.res, tt.mockFindTransactionByUserIdRepository.err)
+				mockTransactionRepository.On("FindTransactionByUserId", tt.args.ctx, mock.Anything, tt.wantFilter).Return(tt.mockFindTransactionByUserIdRepository.res, tt.mockFindTransactionByUserIdRepository.err)
 			}
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			got, err := transactionService.GetTransactionByUserId(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetTransactionByUserId() error = %v, wantErr %v", err, tt.wantErr)
@@ -485,11 +1031,36 @@ func TestTransactionService_UpdateTransaction(t *testing.T) {
 			want:    web.TransactionResponse{},
 			wantErr: true,
 		},
+		{
+			name: "Transaction On Hold",
+			args: args{
+				ctx: context.TODO(),
+				req: web.TransactionUpdateRequest{
+					TransactionID: "456",
+					Name:          "product_test_update",
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+					OnHold:        true,
+				},
+				err: nil,
+			},
+			want:    web.TransactionResponse{},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindTransactionByIDRepository != nil {
 				mockTransactionRepository.On("FindTransactionByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByIDRepository.res, tt.mockFindTransactionByIDRepository.err)
@@ -503,7 +1074,7 @@ func TestTransactionService_UpdateTransaction(t *testing.T) {
 			})
 			defer transactionId.Reset()
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			got, err := transactionService.UpdateTransaction(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.UpdateTransaction() error = %v, wantErr %v", err, tt.wantErr)
@@ -516,6 +1087,182 @@ func TestTransactionService_UpdateTransaction(t *testing.T) {
 	}
 }
 
+func TestTransactionService_PatchTransaction(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.PatchRequest
+	}
+	type mockFindTransactionByIDRepository struct {
+		res entity.Transaction
+		err error
+	}
+	type mockUpdateTransactionRepository struct {
+		res entity.Transaction
+		err error
+	}
+	tests := []struct {
+		name                              string
+		args                              args
+		mockFindTransactionByIDRepository *mockFindTransactionByIDRepository
+		mockUpdateTransactionRepository   *mockUpdateTransactionRepository
+		want                              web.TransactionResponse
+		wantErr                           bool
+	}{
+		{
+			name: "Merge Patch Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "456",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"name":"product_test_update"}`),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			mockUpdateTransactionRepository: &mockUpdateTransactionRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test_update",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			want: web.TransactionResponse{
+				TransactionID: "456",
+				Name:          "product_test_update",
+				UserID:        "123",
+			},
+			wantErr: false,
+		},
+		{
+			name: "JSON Patch Success",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "456",
+					ContentType: web.JSONPatchContentType,
+					Patch:       []byte(`[{"op":"replace","path":"/name","value":"product_test_update"}]`),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			mockUpdateTransactionRepository: &mockUpdateTransactionRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test_update",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			want: web.TransactionResponse{
+				TransactionID: "456",
+				Name:          "product_test_update",
+				UserID:        "123",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Unsupported Media Type",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "456",
+					ContentType: "text/plain",
+					Patch:       []byte(`name=product_test_update`),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			want:    web.TransactionResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Invalid JSON Patch",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "456",
+					ContentType: web.JSONPatchContentType,
+					Patch:       []byte(`[{"op":"remove","path":"/missing"}]`),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+				},
+				err: nil,
+			},
+			want:    web.TransactionResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Transaction Not Found",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "4567",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"name":"product_test_update"}`),
+				},
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{},
+				err: errors.New("transaction not found"),
+			},
+			want:    web.TransactionResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			if tt.mockFindTransactionByIDRepository != nil {
+				mockTransactionRepository.On("FindTransactionByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByIDRepository.res, tt.mockFindTransactionByIDRepository.err)
+			}
+			if tt.mockUpdateTransactionRepository != nil {
+				mockTransactionRepository.On("UpdateTransaction", tt.args.ctx, mock.Anything).Return(tt.mockUpdateTransactionRepository.res, tt.mockUpdateTransactionRepository.err)
+			}
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			got, err := transactionService.PatchTransaction(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.PatchTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.PatchTransaction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTransactionService_RemoveTransaction(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -566,6 +1313,23 @@ func TestTransactionService_RemoveTransaction(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Transaction On Hold",
+			args: args{
+				ctx: context.TODO(),
+				req: "456",
+			},
+			mockFindTransactionByIDRepository: &mockFindTransactionByIDRepository{
+				res: entity.Transaction{
+					TransactionID: "456",
+					Name:          "product_test",
+					UserID:        "123",
+					OnHold:        true,
+				},
+				err: nil,
+			},
+			wantErr: true,
+		},
 		{
 			name: "Error When Remove Transaction",
 			args: args{
@@ -590,6 +1354,10 @@ func TestTransactionService_RemoveTransaction(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserRepository := new(mockUserRepository.UserRepository)
 			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
 
 			if tt.mockFindTransactionByIDRepository != nil {
 				mockTransactionRepository.On("FindTransactionByID", tt.args.ctx, mock.Anything).Return(tt.mockFindTransactionByIDRepository.res, tt.mockFindTransactionByIDRepository.err)
@@ -598,7 +1366,7 @@ func TestTransactionService_RemoveTransaction(t *testing.T) {
 				mockTransactionRepository.On("DeleteTransaction", tt.args.ctx, mock.Anything).Return(tt.mockDeleteTransaction.err)
 			}
 
-			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository)
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
 			err := transactionService.RemoveTransaction(tt.args.ctx, tt.args.req)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("service.GetTransactionById() error = %v, wantErr %v", err, tt.wantErr)
@@ -607,3 +1375,303 @@ func TestTransactionService_RemoveTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionService_CreateTransactionBatch(t *testing.T) {
+	valid := web.TransactionCreateRequest{Name: "product_test", Amount: 10000}
+	tests := []struct {
+		name         string
+		req          web.TransactionBatchCreateRequest
+		batchErr     error
+		insertErrs   map[string]error
+		wantStatuses []string
+		wantErrors   map[int]map[string]interface{}
+		wantErr      bool
+	}{
+		{
+			name:         "Create All Items Atomically",
+			req:          web.TransactionBatchCreateRequest{UserID: "123", Items: []web.TransactionCreateRequest{valid, valid}},
+			wantStatuses: []string{web.BatchStatusCreated, web.BatchStatusCreated},
+		},
+		{
+			name:         "Atomic Batch With An Invalid Item Saves Nothing",
+			req:          web.TransactionBatchCreateRequest{UserID: "123", Items: []web.TransactionCreateRequest{valid, {Amount: 10000}}},
+			wantStatuses: []string{web.BatchStatusSkipped, web.BatchStatusFailed},
+			wantErrors:   map[int]map[string]interface{}{1: {"name": "cannot be blank"}},
+		},
+		{
+			name: "Best Effort Batch Saves The Valid Items",
+			req: web.TransactionBatchCreateRequest{UserID: "123", Mode: web.BatchModeBestEffort, Items: []web.TransactionCreateRequest{
+				{Name: "product_test", Amount: 10000, CategoryID: "404"}, valid, {Name: "product_test", Amount: -1},
+			}},
+			wantStatuses: []string{web.BatchStatusFailed, web.BatchStatusCreated, web.BatchStatusFailed},
+			wantErrors: map[int]map[string]interface{}{
+				0: {"category_id": "NOT_FOUND"},
+				2: {"amount": "must be no less than 0"},
+			},
+		},
+		{
+			name:         "Best Effort Batch Retries Items One By One",
+			req:          web.TransactionBatchCreateRequest{UserID: "123", Mode: web.BatchModeBestEffort, Items: []web.TransactionCreateRequest{valid, valid}},
+			batchErr:     errors.New("error"),
+			insertErrs:   map[string]error{"1": errors.New("error")},
+			wantStatuses: []string{web.BatchStatusFailed, web.BatchStatusCreated},
+			wantErrors:   map[int]map[string]interface{}{0: {"item": "could not be saved"}},
+		},
+		{
+			name:     "Atomic Batch Fails When Saving Fails",
+			req:      web.TransactionBatchCreateRequest{UserID: "123", Items: []web.TransactionCreateRequest{valid}},
+			batchErr: errors.New("error"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			mockUserRepository.On("FindUserByID", ctx, "123").Return(entity.User{UserID: "123"}, nil)
+			mockCategoryRepository.On("FindCategoryByID", ctx, "404").Return(entity.Category{}, errors.New("record not found"))
+			mockCategoryRepository.On("FindCategoryRuleByUserId", ctx, "123").Return([]entity.CategoryRule{}, nil)
+			mockTransactionRepository.On("InsertTransactionBatch", ctx, mock.Anything).Return(tt.batchErr)
+			mockTransactionRepository.On("InsertTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+				return transaction
+			}, func(ctx context.Context, transaction entity.Transaction) error {
+				return tt.insertErrs[transaction.TransactionID]
+			})
+
+			count := 0
+			transactionId := gomonkey.ApplyFunc(uuid.NewString, func() string {
+				count++
+				return string(rune('0' + count))
+			})
+			defer transactionId.Reset()
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			got, err := transactionService.CreateTransactionBatch(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.CreateTransactionBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			assertBatchResults(t, got, tt.wantStatuses, tt.wantErrors)
+			if tt.batchErr == nil && !containsStatus(tt.wantStatuses, web.BatchStatusCreated) {
+				mockTransactionRepository.AssertNotCalled(t, "InsertTransactionBatch", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestTransactionService_UpdateTransactionBatch(t *testing.T) {
+	stored := map[string]entity.Transaction{
+		"456": {TransactionID: "456", Name: "product_test", Amount: 10000, Currency: "IDR", UserID: "123"},
+		"457": {TransactionID: "457", Name: "product_test", Amount: 20000, Currency: "IDR", UserID: "123"},
+	}
+	update := web.TransactionUpdateRequest{Name: "product_update", Amount: 5000}
+	tests := []struct {
+		name         string
+		req          web.TransactionBatchUpdateRequest
+		saveErr      error
+		wantStatuses []string
+		wantErrors   map[int]map[string]interface{}
+		wantErr      bool
+	}{
+		{
+			name: "Update All Items Atomically",
+			req: web.TransactionBatchUpdateRequest{Items: []web.TransactionBatchUpdateItem{
+				{TransactionID: "456", TransactionUpdateRequest: update},
+				{TransactionID: "457", TransactionUpdateRequest: update},
+			}},
+			wantStatuses: []string{web.BatchStatusUpdated, web.BatchStatusUpdated},
+		},
+		{
+			name: "Atomic Batch With A Missing Transaction Saves Nothing",
+			req: web.TransactionBatchUpdateRequest{Items: []web.TransactionBatchUpdateItem{
+				{TransactionID: "456", TransactionUpdateRequest: update},
+				{TransactionID: "404", TransactionUpdateRequest: update},
+			}},
+			wantStatuses: []string{web.BatchStatusSkipped, web.BatchStatusFailed},
+			wantErrors:   map[int]map[string]interface{}{1: {"transaction_id": "NOT_FOUND"}},
+		},
+		{
+			name: "Best Effort Batch Reports Repeated Items",
+			req: web.TransactionBatchUpdateRequest{Mode: web.BatchModeBestEffort, Items: []web.TransactionBatchUpdateItem{
+				{TransactionID: "456", TransactionUpdateRequest: update},
+				{TransactionID: "456", TransactionUpdateRequest: update},
+				{TransactionID: "457", TransactionUpdateRequest: web.TransactionUpdateRequest{Amount: 5000}},
+			}},
+			wantStatuses: []string{web.BatchStatusUpdated, web.BatchStatusFailed, web.BatchStatusFailed},
+			wantErrors: map[int]map[string]interface{}{
+				1: {"transaction_id": "is repeated in the batch"},
+				2: {"name": "cannot be blank"},
+			},
+		},
+		{
+			name: "Atomic Batch Fails When Saving Fails",
+			req: web.TransactionBatchUpdateRequest{Items: []web.TransactionBatchUpdateItem{
+				{TransactionID: "456", TransactionUpdateRequest: update},
+			}},
+			saveErr: errors.New("error"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			mockTransactionRepository.On("FindTransactionByID", ctx, mock.Anything).Return(func(ctx context.Context, transactionId string) entity.Transaction {
+				return stored[transactionId]
+			}, func(ctx context.Context, transactionId string) error {
+				if _, ok := stored[transactionId]; !ok {
+					return errors.New("record not found")
+				}
+				return nil
+			})
+			mockTransactionRepository.On("UpdateTransactionBatch", ctx, mock.Anything).Return(tt.saveErr)
+			mockTransactionRepository.On("UpdateTransaction", ctx, mock.Anything).Return(func(ctx context.Context, transaction entity.Transaction) entity.Transaction {
+				return transaction
+			}, tt.saveErr)
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			got, err := transactionService.UpdateTransactionBatch(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.UpdateTransactionBatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			assertBatchResults(t, got, tt.wantStatuses, tt.wantErrors)
+			for _, result := range got.Results {
+				if result.Status == web.BatchStatusUpdated && (result.Transaction == nil || result.Transaction.Name != "product_update") {
+					t.Errorf("service.UpdateTransactionBatch() result %d = %+v, want the updated transaction", result.Index, result.Transaction)
+				}
+			}
+		})
+	}
+}
+
+func TestTransactionService_RemoveTransactionBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          web.TransactionBatchDeleteRequest
+		held         []string
+		wantDeleted  []string
+		wantStatuses []string
+		wantErrors   map[int]map[string]interface{}
+	}{
+		{
+			name:         "Remove All Items Atomically",
+			req:          web.TransactionBatchDeleteRequest{TransactionIDs: []string{"456", "457"}},
+			wantDeleted:  []string{"456", "457"},
+			wantStatuses: []string{web.BatchStatusDeleted, web.BatchStatusDeleted},
+		},
+		{
+			name:         "Atomic Batch With A Missing Transaction Removes Nothing",
+			req:          web.TransactionBatchDeleteRequest{TransactionIDs: []string{"456", "404"}},
+			wantStatuses: []string{web.BatchStatusSkipped, web.BatchStatusFailed},
+			wantErrors:   map[int]map[string]interface{}{1: {"transaction_id": "NOT_FOUND"}},
+		},
+		{
+			name:         "Atomic Batch With A Held Transaction Removes Nothing",
+			req:          web.TransactionBatchDeleteRequest{TransactionIDs: []string{"456", "457"}},
+			held:         []string{"457"},
+			wantStatuses: []string{web.BatchStatusSkipped, web.BatchStatusFailed},
+			wantErrors:   map[int]map[string]interface{}{1: {"transaction_id": "is on hold by an open dispute"}},
+		},
+		{
+			name:         "Best Effort Batch Removes The Existing Items",
+			req:          web.TransactionBatchDeleteRequest{Mode: web.BatchModeBestEffort, TransactionIDs: []string{"456", "404", "456", "457"}},
+			wantDeleted:  []string{"456", "457"},
+			wantStatuses: []string{web.BatchStatusDeleted, web.BatchStatusFailed, web.BatchStatusFailed, web.BatchStatusDeleted},
+			wantErrors: map[int]map[string]interface{}{
+				1: {"transaction_id": "NOT_FOUND"},
+				2: {"transaction_id": "is repeated in the batch"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			mockCategoryRepository := new(mockCategoryRepository.CategoryRepository)
+			mockTagRepository := new(mockTagRepository.TagRepository)
+			mockBudgetRepository := new(mockBudgetRepository.BudgetRepository)
+			mockNotifier := new(mockNotifier.Notifier)
+
+			mockTransactionRepository.On("FindExistingTransactionIDs", ctx, tt.req.TransactionIDs).Return([]string{"456", "457"}, nil)
+			mockTransactionRepository.On("FindHeldTransactionIDs", ctx, []string{"456", "457"}).Return(tt.held, nil)
+			mockTransactionRepository.On("DeleteTransactionBatch", ctx, tt.wantDeleted).Return(nil)
+
+			transactionService := transaction.NewTransactionService(mockTransactionRepository, mockUserRepository, mockCategoryRepository, mockTagRepository, mockBudgetRepository, mockNotifier)
+			got, err := transactionService.RemoveTransactionBatch(ctx, tt.req)
+			if err != nil {
+				t.Errorf("service.RemoveTransactionBatch() error = %v", err)
+				return
+			}
+			assertBatchResults(t, got, tt.wantStatuses, tt.wantErrors)
+			if tt.wantDeleted == nil {
+				mockTransactionRepository.AssertNotCalled(t, "DeleteTransactionBatch", ctx, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestTransactionService_TransactionBatchTooLarge(t *testing.T) {
+	transactionIds := make([]string, validation.TransactionBatchMaxItems+1)
+	transactionService := transaction.NewTransactionService(new(mockTransactionRepository.TransactionRepository), new(mockUserRepository.UserRepository), new(mockCategoryRepository.CategoryRepository), new(mockTagRepository.TagRepository), new(mockBudgetRepository.BudgetRepository), new(mockNotifier.Notifier))
+	_, err := transactionService.RemoveTransactionBatch(context.TODO(), web.TransactionBatchDeleteRequest{TransactionIDs: transactionIds})
+	if _, ok := err.(exception.ValidationError); !ok {
+		t.Errorf("service.RemoveTransactionBatch() error = %v, want a validation error", err)
+	}
+}
+
+func assertBatchResults(t *testing.T, got web.TransactionBatchResponse, wantStatuses []string, wantErrors map[int]map[string]interface{}) {
+	t.Helper()
+	var statuses []string
+	succeeded, failed := 0, 0
+	for i, result := range got.Results {
+		statuses = append(statuses, result.Status)
+		if result.Index != i {
+			t.Errorf("result %d has index %d", i, result.Index)
+		}
+		want := wantErrors[i]
+		if want == nil && result.Errors != nil || want != nil && !reflect.DeepEqual(result.Errors, want) {
+			t.Errorf("result %d errors = %v, want %v", i, result.Errors, want)
+		}
+		switch result.Status {
+		case web.BatchStatusCreated, web.BatchStatusUpdated, web.BatchStatusDeleted:
+			succeeded++
+		case web.BatchStatusFailed:
+			failed++
+		}
+	}
+	if !reflect.DeepEqual(statuses, wantStatuses) {
+		t.Errorf("result statuses = %v, want %v", statuses, wantStatuses)
+	}
+	if got.Total != len(wantStatuses) || got.Succeeded != succeeded || got.Failed != failed {
+		t.Errorf("batch counts = %d/%d/%d, want %d/%d/%d", got.Total, got.Succeeded, got.Failed, len(wantStatuses), succeeded, failed)
+	}
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/vnnyx/golang-dot-api/exception"
 	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
 	mockTransactionRepository "github.com/vnnyx/golang-dot-api/repository/transaction/mocks"
@@ -45,7 +46,7 @@ func TestUserService_CreateUser(t *testing.T) {
 				req: web.UserCreateRequest{
 					Username:             "username_test",
 					Email:                "email@test.com",
-					Handphone:            "08123456789",
+					Handphone:            "+628123456789",
 					Password:             "password",
 					PasswordConfirmation: "password",
 				},
@@ -55,7 +56,7 @@ func TestUserService_CreateUser(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -63,7 +64,7 @@ func TestUserService_CreateUser(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test",
 				Email:     "email@test.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 			},
 			wantErrGeneratePassword: false,
 			wantErr:                 false,
@@ -75,7 +76,7 @@ func TestUserService_CreateUser(t *testing.T) {
 				req: web.UserCreateRequest{
 					Username:             "username_test",
 					Email:                "email@test.com",
-					Handphone:            "08123456789",
+					Handphone:            "+628123456789",
 					Password:             "password",
 					PasswordConfirmation: "password",
 				},
@@ -90,7 +91,7 @@ func TestUserService_CreateUser(t *testing.T) {
 				req: web.UserCreateRequest{
 					Username:             "username_test",
 					Email:                "email@test.com",
-					Handphone:            "08123456789",
+					Handphone:            "+628123456789",
 					Password:             "password",
 					PasswordConfirmation: "password",
 				},
@@ -116,6 +117,8 @@ func TestUserService_CreateUser(t *testing.T) {
 			require.NoError(t, err)
 			defer db.Close()
 
+			mockUserRepository.On("FindUserByUsername", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
+			mockUserRepository.On("FindUserByEmail", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
 			if tt.mockCreateUserRepository != nil {
 				mockUserRepository.On("InsertUser", tt.args.ctx, mock.Anything).Return(tt.mockCreateUserRepository.res, tt.mockCreateUserRepository.err)
 			}
@@ -145,6 +148,83 @@ func TestUserService_CreateUser(t *testing.T) {
 	}
 }
 
+func TestUserService_CreateUserValidation(t *testing.T) {
+	lookupErr := errors.New("connection refused")
+	request := web.UserCreateRequest{
+		Username:             "username_test",
+		Email:                "email@test.com",
+		Handphone:            "+628123456789",
+		Password:             "password",
+		PasswordConfirmation: "password",
+	}
+	type mockFindUser struct {
+		res entity.User
+		err error
+	}
+	tests := []struct {
+		name               string
+		handphone          string
+		mockFindByUsername mockFindUser
+		mockFindByEmail    mockFindUser
+		wantFields         map[string]interface{}
+		wantErr            error
+	}{
+		{
+			name:               "Username Taken",
+			mockFindByUsername: mockFindUser{res: entity.User{UserID: "456"}},
+			mockFindByEmail:    mockFindUser{err: gorm.ErrRecordNotFound},
+			wantFields:         map[string]interface{}{"username": "must be unique"},
+		},
+		{
+			name:               "Email Taken",
+			mockFindByUsername: mockFindUser{err: gorm.ErrRecordNotFound},
+			mockFindByEmail:    mockFindUser{res: entity.User{UserID: "456"}},
+			wantFields:         map[string]interface{}{"email": "must be unique"},
+		},
+		{
+			name:               "Handphone Not E.164",
+			handphone:          "08123456789",
+			mockFindByUsername: mockFindUser{err: gorm.ErrRecordNotFound},
+			mockFindByEmail:    mockFindUser{err: gorm.ErrRecordNotFound},
+			wantFields:         map[string]interface{}{"handphone": "must be an E.164 phone number"},
+		},
+		{
+			name:               "Lookup Failed",
+			mockFindByUsername: mockFindUser{err: lookupErr},
+			mockFindByEmail:    mockFindUser{err: gorm.ErrRecordNotFound},
+			wantErr:            lookupErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockUserRepository.On("FindUserByUsername", context.TODO(), request.Username).Return(tt.mockFindByUsername.res, tt.mockFindByUsername.err)
+			mockUserRepository.On("FindUserByEmail", context.TODO(), request.Email).Return(tt.mockFindByEmail.res, tt.mockFindByEmail.err)
+
+			req := request
+			if tt.handphone != "" {
+				req.Handphone = tt.handphone
+			}
+			userService := user.NewUserService(mockUserRepository, new(mockTransactionRepository.TransactionRepository), nil)
+			_, err := userService.CreateUser(context.TODO(), req)
+			if tt.wantErr != nil {
+				if _, ok := err.(exception.ValidationError); ok || !errors.Is(err, tt.wantErr) {
+					t.Errorf("service.CreateUser() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			validationError, ok := err.(exception.ValidationError)
+			if !ok {
+				t.Fatalf("service.CreateUser() error = %v, want a validation error", err)
+			}
+			if !reflect.DeepEqual(validationError.Fields(), tt.wantFields) {
+				t.Errorf("service.CreateUser() errors = %v, want %v", validationError.Fields(), tt.wantFields)
+			}
+			mockUserRepository.AssertNotCalled(t, "InsertUser", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestUserService_GetUserById(t *testing.T) {
 	type args struct {
 		ctx context.Context
@@ -172,7 +252,7 @@ func TestUserService_GetUserById(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -180,7 +260,7 @@ func TestUserService_GetUserById(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test",
 				Email:     "email@test.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
 			},
 			wantErr: false,
 		},
@@ -254,7 +334,7 @@ func TestUserService_GetAllUser(t *testing.T) {
 						UserID:    "123",
 						Username:  "username_test",
 						Email:     "email@test.com",
-						Handphone: "08123456789",
+						Handphone: "+628123456789",
 					},
 				},
 				err: nil,
@@ -264,7 +344,7 @@ func TestUserService_GetAllUser(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 			},
 			wantErr: false,
@@ -330,6 +410,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 		mockFindUserByIDRepository *mockFindUserByIDRepository
 		mockUpdateUserRepository   *mockUpdateUserRepository
 		want                       web.UserResponse
+		wantUpdated                *entity.User
 		wantErr                    bool
 	}{
 		{
@@ -340,7 +421,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test_updated",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
@@ -348,7 +429,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -357,7 +438,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test_updated",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -365,7 +446,59 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 				UserID:    "123",
 				Username:  "username_test_updated",
 				Email:     "email@test.com",
-				Handphone: "08123456789",
+				Handphone: "+628123456789",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Admin Keeps Role And Locale",
+			args: args{
+				ctx: context.TODO(),
+				req: web.UserUpdateProfileRequest{
+					UserID:    "123",
+					Username:  "admin_updated",
+					Email:     "admin@test.com",
+					Handphone: "+628123456789",
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "admin",
+					Email:     "admin@test.com",
+					Handphone: "+628123456789",
+					Password:  "hashed",
+					Role:      entity.UserRoleAdmin,
+					Locale:    "id",
+				},
+				err: nil,
+			},
+			mockUpdateUserRepository: &mockUpdateUserRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "admin_updated",
+					Email:     "admin@test.com",
+					Handphone: "+628123456789",
+					Role:      entity.UserRoleAdmin,
+					Locale:    "id",
+				},
+				err: nil,
+			},
+			want: web.UserResponse{
+				UserID:    "123",
+				Username:  "admin_updated",
+				Email:     "admin@test.com",
+				Handphone: "+628123456789",
+				Locale:    "id",
+			},
+			wantUpdated: &entity.User{
+				UserID:    "123",
+				Username:  "admin_updated",
+				Email:     "admin@test.com",
+				Handphone: "+628123456789",
+				Password:  "hashed",
+				Role:      entity.UserRoleAdmin,
+				Locale:    "id",
 			},
 			wantErr: false,
 		},
@@ -377,7 +510,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test_updated",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
@@ -395,7 +528,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test_updated",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 			},
 			mockFindUserByIDRepository: &mockFindUserByIDRepository{
@@ -403,7 +536,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -412,7 +545,7 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test_updated",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: errors.New("error"),
 			},
@@ -433,6 +566,8 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 			require.NoError(t, err)
 			defer db.Close()
 
+			mockUserRepository.On("FindUserByUsername", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
+			mockUserRepository.On("FindUserByEmail", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
 			if tt.mockFindUserByIDRepository != nil {
 				mockUserRepository.On("FindUserByID", tt.args.ctx, mock.Anything).Return(tt.mockFindUserByIDRepository.res, tt.mockFindUserByIDRepository.err)
 			}
@@ -449,6 +584,197 @@ func TestUserService_UpdateUserProfile(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("service.UpdateUserProfile() = %v, want %v", got, tt.want)
 			}
+			if tt.wantUpdated != nil {
+				mockUserRepository.AssertCalled(t, "UpdateUser", tt.args.ctx, *tt.wantUpdated)
+			}
+		})
+	}
+}
+
+func TestUserService_PatchUserProfile(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req web.PatchRequest
+	}
+	type mockFindUserByIDRepository struct {
+		res entity.User
+		err error
+	}
+	type mockUpdateUserRepository struct {
+		res entity.User
+		err error
+	}
+	tests := []struct {
+		name                       string
+		args                       args
+		mockFindUserByIDRepository *mockFindUserByIDRepository
+		mockUpdateUserRepository   *mockUpdateUserRepository
+		want                       web.UserResponse
+		wantErr                    bool
+	}{
+		{
+			name: "UserService PatchUserProfile Clear Handphone",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "123",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"handphone":null}`),
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email@test.com",
+					Handphone: "+628123456789",
+				},
+				err: nil,
+			},
+			mockUpdateUserRepository: &mockUpdateUserRepository{
+				res: entity.User{
+					UserID:   "123",
+					Username: "username_test",
+					Email:    "email@test.com",
+				},
+				err: nil,
+			},
+			want: web.UserResponse{
+				UserID:   "123",
+				Username: "username_test",
+				Email:    "email@test.com",
+			},
+			wantErr: false,
+		},
+		{
+			name: "Keep Handphone Saved Before E.164",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "123",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"email":"email_updated@test.com"}`),
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email@test.com",
+					Handphone: "08123456789",
+				},
+				err: nil,
+			},
+			mockUpdateUserRepository: &mockUpdateUserRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email_updated@test.com",
+					Handphone: "08123456789",
+				},
+				err: nil,
+			},
+			want: web.UserResponse{
+				UserID:    "123",
+				Username:  "username_test",
+				Email:     "email_updated@test.com",
+				Handphone: "08123456789",
+			},
+			wantErr: false,
+		},
+		{
+			name: "New Handphone Not In E.164",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "123",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"handphone":"08987654321"}`),
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email@test.com",
+					Handphone: "08123456789",
+				},
+				err: nil,
+			},
+			want:    web.UserResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Unsupported Media Type",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "123",
+					ContentType: "application/xml",
+					Patch:       []byte(`<handphone/>`),
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email@test.com",
+					Handphone: "+628123456789",
+				},
+				err: nil,
+			},
+			want:    web.UserResponse{},
+			wantErr: true,
+		},
+		{
+			name: "Error When Find Record",
+			args: args{
+				ctx: context.TODO(),
+				req: web.PatchRequest{
+					ID:          "123",
+					ContentType: web.MergePatchContentType,
+					Patch:       []byte(`{"username":"username_test_updated"}`),
+				},
+			},
+			mockFindUserByIDRepository: &mockFindUserByIDRepository{
+				res: entity.User{},
+				err: errors.New("error"),
+			},
+			want:    web.UserResponse{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUserRepository := new(mockUserRepository.UserRepository)
+			mockTransactionRepository := new(mockTransactionRepository.TransactionRepository)
+			db, _, err := sqlmock.New()
+			require.NoError(t, err)
+			DB, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      db,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{})
+			require.NoError(t, err)
+			defer db.Close()
+
+			mockUserRepository.On("FindUserByUsername", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
+			mockUserRepository.On("FindUserByEmail", tt.args.ctx, mock.Anything).Return(entity.User{}, gorm.ErrRecordNotFound)
+			if tt.mockFindUserByIDRepository != nil {
+				mockUserRepository.On("FindUserByID", tt.args.ctx, mock.Anything).Return(tt.mockFindUserByIDRepository.res, tt.mockFindUserByIDRepository.err)
+			}
+			if tt.mockUpdateUserRepository != nil {
+				mockUserRepository.On("UpdateUser", tt.args.ctx, mock.Anything).Return(tt.mockUpdateUserRepository.res, tt.mockUpdateUserRepository.err)
+			}
+
+			userService := user.NewUserService(mockUserRepository, mockTransactionRepository, DB)
+			got, err := userService.PatchUserProfile(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("service.PatchUserProfile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("service.PatchUserProfile() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
@@ -474,6 +800,7 @@ func TestUserService_RemoveUser(t *testing.T) {
 		mockFindUserByIdRepository               *mockFindUserByIdRepository
 		mockDeleteTransactionByUserIdRespository *mockDeleteTransactionByUserIdRespository
 		mockDeleteUserRepository                 *mockDeleteUserRepository
+		held                                     bool
 		wantErr                                  bool
 	}{
 		{
@@ -487,7 +814,7 @@ func TestUserService_RemoveUser(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -522,7 +849,7 @@ func TestUserService_RemoveUser(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -531,6 +858,24 @@ func TestUserService_RemoveUser(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Transaction On Hold",
+			args: args{
+				ctx: context.TODO(),
+				req: "123",
+			},
+			mockFindUserByIdRepository: &mockFindUserByIdRepository{
+				res: entity.User{
+					UserID:    "123",
+					Username:  "username_test",
+					Email:     "email@test.com",
+					Handphone: "+628123456789",
+				},
+				err: nil,
+			},
+			held:    true,
+			wantErr: true,
+		},
 		{
 			name: "Error When Delete User By ID",
 			args: args{
@@ -542,7 +887,7 @@ func TestUserService_RemoveUser(t *testing.T) {
 					UserID:    "123",
 					Username:  "username_test",
 					Email:     "email@test.com",
-					Handphone: "08123456789",
+					Handphone: "+628123456789",
 				},
 				err: nil,
 			},
@@ -573,6 +918,10 @@ func TestUserService_RemoveUser(t *testing.T) {
 			}
 
 			sqlmock.ExpectBegin()
+			if tt.held {
+				sqlmock.ExpectRollback()
+			}
+			mockTransactionRepository.On("HasHeldTransaction", tt.args.ctx, mock.Anything, tt.args.req).Return(tt.held, nil)
 			if tt.mockDeleteTransactionByUserIdRespository != nil {
 				if tt.mockDeleteTransactionByUserIdRespository.err != nil {
 					sqlmock.ExpectRollback()
@@ -596,6 +945,11 @@ func TestUserService_RemoveUser(t *testing.T) {
 				t.Errorf("service.RemoveUser() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.held {
+				require.ErrorIs(t, err, user.ErrTransactionOnHold)
+				mockTransactionRepository.AssertNotCalled(t, "DeleteTransactionByUserId", tt.args.ctx, mock.Anything, tt.args.req)
+				require.NoError(t, sqlmock.ExpectationsWereMet())
+			}
 		})
 	}
 }
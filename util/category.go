@@ -0,0 +1,77 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+// MatchCategoryRule returns the first rule whose pattern matches name. Rules
+// are expected to be sorted by priority already.
+func MatchCategoryRule(rules []entity.CategoryRule, name string) (entity.CategoryRule, bool) {
+	lowerName := strings.ToLower(name)
+	for _, rule := range rules {
+		pattern := strings.ToLower(rule.Pattern)
+		var matched bool
+		switch rule.MatchType {
+		case entity.RuleMatchContains:
+			matched = strings.Contains(lowerName, pattern)
+		case entity.RuleMatchPrefix:
+			matched = strings.HasPrefix(lowerName, pattern)
+		case entity.RuleMatchSuffix:
+			matched = strings.HasSuffix(lowerName, pattern)
+		case entity.RuleMatchExact:
+			matched = lowerName == pattern
+		case entity.RuleMatchRegex:
+			re, err := regexp.Compile("(?i)" + rule.Pattern)
+			matched = err == nil && re.MatchString(name)
+		}
+		if matched {
+			return rule, true
+		}
+	}
+	return entity.CategoryRule{}, false
+}
+
+// CategoryDescendantIDs returns rootId followed by the ids of every category
+// nested below it.
+func CategoryDescendantIDs(categories []entity.Category, rootId string) []string {
+	children := make(map[string][]string)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			children[*category.ParentID] = append(children[*category.ParentID], category.CategoryID)
+		}
+	}
+
+	ids := []string{rootId}
+	visited := map[string]bool{rootId: true}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range children[ids[i]] {
+			if !visited[child] {
+				visited[child] = true
+				ids = append(ids, child)
+			}
+		}
+	}
+	return ids
+}
+
+// CategoryAncestorIDs returns categoryId followed by the ids of its parents up
+// to the root category.
+func CategoryAncestorIDs(categories []entity.Category, categoryId string) []string {
+	parents := make(map[string]string)
+	for _, category := range categories {
+		if category.ParentID != nil {
+			parents[category.CategoryID] = *category.ParentID
+		}
+	}
+
+	ids := []string{categoryId}
+	visited := map[string]bool{categoryId: true}
+	for parent, ok := parents[categoryId]; ok && !visited[parent]; parent, ok = parents[parent] {
+		visited[parent] = true
+		ids = append(ids, parent)
+	}
+	return ids
+}
@@ -0,0 +1,285 @@
+package util
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+)
+
+const (
+	// MaxRateAgeDays is how long a rate stays usable, so days without a
+	// published rate such as weekends and bank holidays use the last one.
+	MaxRateAgeDays = 7
+	rateDateLayout = "2006-01-02"
+	// rateDecimals matches the scale of the exchange_rates.rate column.
+	rateDecimals = 12
+)
+
+var currencyCodePattern = regexp.MustCompile("^[A-Z]{3}$")
+
+var ErrExchangeRateNotFound = apperror.New(http.StatusBadRequest, "EXCHANGE_RATE_NOT_FOUND", "currency", "no exchange rate loaded for some of the amounts")
+
+// RoundHalfEven rounds value to the nearest integer and rounds halves to the
+// even neighbour (banker's rounding), so rounding many amounts does not
+// drift in one direction.
+func RoundHalfEven(value *big.Rat) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+	// QuoRem truncates towards zero, so a remainder above one half, or
+	// exactly one half next to an odd quotient, rounds away from zero.
+	twice := remainder.Abs(remainder).Lsh(remainder, 1)
+	half := twice.Cmp(value.Denom())
+	if half > 0 || half == 0 && quotient.Bit(0) == 1 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+	return quotient
+}
+
+// ConvertMinorUnits converts amount, in minor units of from, into minor
+// units of to at rate, rounding half to even.
+func ConvertMinorUnits(amount int64, from string, to string, rate *big.Rat) (int64, error) {
+	value := new(big.Rat).Mul(new(big.Rat).SetInt64(amount), rate)
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(CurrencyExponent(to)-CurrencyExponent(from)))), nil)
+	if CurrencyExponent(to) > CurrencyExponent(from) {
+		value.Mul(value, new(big.Rat).SetInt(scale))
+	} else {
+		value.Quo(value, new(big.Rat).SetInt(scale))
+	}
+	converted := RoundHalfEven(value)
+	if !converted.IsInt64() {
+		return 0, errors.New("amount is too large")
+	}
+	return converted.Int64(), nil
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+type datedRate struct {
+	date time.Time
+	rate *big.Rat
+}
+
+// RateTable looks up exchange rates by day. A pair without a rate of its own
+// is derived from its inverse or crossed through a currency both sides are
+// quoted against, such as EUR for the ECB reference rates.
+type RateTable struct {
+	rates      map[[2]string][]datedRate
+	currencies []string
+}
+
+func NewRateTable(rates []entity.ExchangeRate) (*RateTable, error) {
+	table := &RateTable{rates: make(map[[2]string][]datedRate)}
+	seen := make(map[string]bool)
+	for _, rate := range rates {
+		value, ok := new(big.Rat).SetString(rate.Rate)
+		if !ok || value.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for %s/%s", rate.Rate, rate.BaseCurrency, rate.QuoteCurrency)
+		}
+		pair := [2]string{rate.BaseCurrency, rate.QuoteCurrency}
+		table.rates[pair] = append(table.rates[pair], datedRate{date: rateDay(rate.Date), rate: value})
+		for _, currency := range pair {
+			if !seen[currency] {
+				seen[currency] = true
+				table.currencies = append(table.currencies, currency)
+			}
+		}
+	}
+	for _, dated := range table.rates {
+		sort.SliceStable(dated, func(i, j int) bool {
+			return dated[i].date.Before(dated[j].date)
+		})
+	}
+	sort.Strings(table.currencies)
+	return table, nil
+}
+
+// Rate returns how many units of to one unit of from bought on date, using
+// the latest rate at most MaxRateAgeDays old.
+func (table *RateTable) Rate(from string, to string, date time.Time) (*big.Rat, bool) {
+	if from == to {
+		return big.NewRat(1, 1), true
+	}
+	day := rateDay(date)
+	if rate, ok := table.pairRate(from, to, day); ok {
+		return rate, true
+	}
+	for _, pivot := range table.currencies {
+		if pivot == from || pivot == to {
+			continue
+		}
+		first, ok := table.pairRate(from, pivot, day)
+		if !ok {
+			continue
+		}
+		second, ok := table.pairRate(pivot, to, day)
+		if !ok {
+			continue
+		}
+		return first.Mul(first, second), true
+	}
+	return nil, false
+}
+
+// Convert converts amount, in minor units of from, into minor units of to
+// at the rate of date. It fails with ErrExchangeRateNotFound when the table
+// has no usable rate for that day.
+func (table *RateTable) Convert(amount int64, from string, to string, date time.Time) (int64, error) {
+	rate, ok := table.Rate(from, to, date)
+	if !ok {
+		return 0, ErrExchangeRateNotFound
+	}
+	return ConvertMinorUnits(amount, from, to, rate)
+}
+
+func (table *RateTable) pairRate(from string, to string, day time.Time) (*big.Rat, bool) {
+	if rate, ok := table.latestRate(from, to, day); ok {
+		return new(big.Rat).Set(rate), true
+	}
+	if rate, ok := table.latestRate(to, from, day); ok {
+		return new(big.Rat).Inv(rate), true
+	}
+	return nil, false
+}
+
+func (table *RateTable) latestRate(base string, quote string, day time.Time) (*big.Rat, bool) {
+	dated := table.rates[[2]string{base, quote}]
+	i := sort.Search(len(dated), func(i int) bool {
+		return dated[i].date.After(day)
+	})
+	if i == 0 || day.Sub(dated[i-1].date) > MaxRateAgeDays*24*time.Hour {
+		return nil, false
+	}
+	return dated[i-1].rate, true
+}
+
+// rateDay is the calendar day of date, in date's own location, as midnight
+// UTC like the dates stored with the rates.
+func rateDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ParseCSVExchangeRates reads a CSV file with a date, base, quote and rate
+// column, e.g. "2023-03-10,USD,IDR,15437.5". Dates are formatted as
+// YYYY-MM-DD. Any invalid row fails the whole file.
+func ParseCSVExchangeRates(r io.Reader) ([]entity.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("missing header row")
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	columns := make(map[string]int)
+	for _, name := range []string{"date", "base", "quote", "rate"} {
+		i, ok := index[name]
+		if !ok {
+			return nil, errors.New("header must contain the date, base, quote and rate columns")
+		}
+		columns[name] = i
+	}
+
+	var rates []entity.ExchangeRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		value := func(name string) string {
+			return strings.TrimSpace(record[columns[name]])
+		}
+		rate, err := newExchangeRate(value("date"), value("base"), value("quote"), value("rate"))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		rates = append(rates, rate)
+	}
+	if len(rates) == 0 {
+		return nil, errors.New("no exchange rates found")
+	}
+	return rates, nil
+}
+
+// ecbEnvelope is the layout of the ECB euro reference rate files, where
+// every rate is the price of one euro.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBExchangeRates reads an ECB euro reference rate XML file into EUR
+// based rates. Any invalid rate fails the whole file.
+func ParseECBExchangeRates(r io.Reader) ([]entity.ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, errors.New("not an ECB reference rate file")
+	}
+
+	var rates []entity.ExchangeRate
+	for _, day := range envelope.Days {
+		for _, quote := range day.Rates {
+			rate, err := newExchangeRate(day.Time, "EUR", quote.Currency, quote.Rate)
+			if err != nil {
+				return nil, fmt.Errorf("%s %s: %v", day.Time, quote.Currency, err)
+			}
+			rates = append(rates, rate)
+		}
+	}
+	if len(rates) == 0 {
+		return nil, errors.New("no exchange rates found")
+	}
+	return rates, nil
+}
+
+func newExchangeRate(date string, base string, quote string, rate string) (entity.ExchangeRate, error) {
+	day, err := time.Parse(rateDateLayout, date)
+	if err != nil {
+		return entity.ExchangeRate{}, errors.New("date must be formatted as YYYY-MM-DD")
+	}
+	base, quote = strings.ToUpper(base), strings.ToUpper(quote)
+	if !currencyCodePattern.MatchString(base) || !currencyCodePattern.MatchString(quote) {
+		return entity.ExchangeRate{}, errors.New("currencies must be ISO 4217 codes")
+	}
+	if base == quote {
+		return entity.ExchangeRate{}, errors.New("base and quote must differ")
+	}
+	// Fractions such as "1/3" are accepted by big.Rat but are not decimals.
+	value, ok := new(big.Rat).SetString(rate)
+	if !ok || strings.Contains(rate, "/") || value.Sign() <= 0 {
+		return entity.ExchangeRate{}, fmt.Errorf("invalid rate %q", rate)
+	}
+	decimal := strings.TrimRight(strings.TrimRight(value.FloatString(rateDecimals), "0"), ".")
+	if decimal == "0" {
+		return entity.ExchangeRate{}, fmt.Errorf("rate %q is too small", rate)
+	}
+	return entity.ExchangeRate{Date: day, BaseCurrency: base, QuoteCurrency: quote, Rate: decimal}, nil
+}
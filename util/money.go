@@ -0,0 +1,84 @@
+package util
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// currencyExponents lists ISO 4217 currencies whose minor unit is not a
+// hundredth of the major unit.
+var currencyExponents = map[string]int{
+	"BHD": 3, "CLP": 0, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// CurrencyExponent returns how many decimal places the currency's minor unit
+// has.
+func CurrencyExponent(currency string) int {
+	if exponent, ok := currencyExponents[currency]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// ParseMinorUnits converts a decimal amount such as "-1,234.50" into the
+// currency's smallest unit. Commas are treated as thousands separators and
+// more decimals than the currency has are rejected rather than rounded.
+func ParseMinorUnits(value string, currency string) (int64, error) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", "")
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimLeft(value, "+-")
+
+	whole, fraction, _ := strings.Cut(value, ".")
+	exponent := CurrencyExponent(currency)
+	if whole == "" && fraction == "" || len(fraction) > exponent {
+		return 0, errors.New("invalid amount")
+	}
+	digits := whole + fraction + strings.Repeat("0", exponent-len(fraction))
+
+	var amount int64
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return 0, errors.New("invalid amount")
+		}
+		if amount > (1<<63-1-9)/10 {
+			return 0, errors.New("amount is too large")
+		}
+		amount = amount*10 + int64(digit-'0')
+	}
+	if negative {
+		amount = -amount
+	}
+	return amount, nil
+}
+
+// FormatMinorUnits is the inverse of ParseMinorUnits, formatting an amount
+// with thousands separators, e.g. 123450 IDR as "1,234.50".
+func FormatMinorUnits(amount int64, currency string) string {
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = uint64(-amount)
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	exponent := CurrencyExponent(currency)
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-exponent], digits[len(digits)-exponent:]
+
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	if fraction == "" {
+		return sign + grouped.String()
+	}
+	return sign + grouped.String() + "." + fraction
+}
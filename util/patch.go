@@ -0,0 +1,46 @@
+package util
+
+import (
+	"mime"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/vnnyx/golang-dot-api/exception/apperror"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+var (
+	ErrInvalidPatch         = apperror.New(http.StatusBadRequest, "INVALID_PATCH", "patch", "invalid patch document")
+	ErrUnsupportedPatchType = apperror.New(http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", "content_type", "must be application/merge-patch+json or application/json-patch+json")
+)
+
+// ApplyPatch applies an RFC 7396 merge patch or an RFC 6902 JSON patch to
+// document, depending on contentType. Plain application/json bodies are
+// treated as merge patches so existing clients keep working.
+func ApplyPatch(contentType string, document []byte, patch []byte) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = web.MergePatchContentType
+	}
+
+	switch mediaType {
+	case web.MergePatchContentType, "application/json":
+		merged, err := jsonpatch.MergePatch(document, patch)
+		if err != nil {
+			return nil, ErrInvalidPatch.Wrap(err)
+		}
+		return merged, nil
+	case web.JSONPatchContentType:
+		operations, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, ErrInvalidPatch.Wrap(err)
+		}
+		patched, err := operations.Apply(document)
+		if err != nil {
+			return nil, ErrInvalidPatch.Wrap(err)
+		}
+		return patched, nil
+	default:
+		return nil, ErrUnsupportedPatchType
+	}
+}
@@ -0,0 +1,74 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+const PeriodLayout = "2006-01"
+
+// MonthRange returns the first instant of the month containing t in location
+// and the first instant of the following month.
+func MonthRange(t time.Time, location *time.Location) (start time.Time, end time.Time) {
+	local := t.In(location)
+	start = time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, location)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// OffsetSegment is a part of a time range during which location keeps the
+// same UTC offset, formatted as "+07:00".
+type OffsetSegment struct {
+	From   time.Time
+	To     time.Time
+	Offset string
+}
+
+// OffsetSegments splits [from, to) at every UTC offset change of location, so
+// each part can be bucketed into local days with a fixed offset.
+func OffsetSegments(from time.Time, to time.Time, location *time.Location) (segments []OffsetSegment) {
+	for start := from; start.Before(to); {
+		_, offset := start.In(location).Zone()
+		end := nextOffsetChange(start, to, location, offset)
+		segments = append(segments, OffsetSegment{From: start, To: end, Offset: FormatOffset(offset)})
+		start = end
+	}
+	return segments
+}
+
+// nextOffsetChange returns the first instant after start and before to whose
+// offset differs from offset, or to when there is none.
+func nextOffsetChange(start time.Time, to time.Time, location *time.Location, offset int) time.Time {
+	same := start
+	for {
+		next := same.Add(24 * time.Hour)
+		if !next.Before(to) {
+			next = to
+		}
+		if _, nextOffset := next.In(location).Zone(); nextOffset != offset {
+			// Narrow down to the second the offset changed.
+			for next.Sub(same) > time.Second {
+				middle := same.Add(next.Sub(same) / 2).Truncate(time.Second)
+				if _, middleOffset := middle.In(location).Zone(); middleOffset == offset {
+					same = middle
+				} else {
+					next = middle
+				}
+			}
+			return next
+		}
+		if next.Equal(to) {
+			return to
+		}
+		same = next
+	}
+}
+
+// FormatOffset formats an offset in seconds east of UTC as "+07:00".
+func FormatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, offset/3600, offset%3600/60)
+}
@@ -0,0 +1,40 @@
+package util
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/teambition/rrule-go"
+)
+
+// ParseRecurrence builds an RFC 5545 recurrence rule anchored at startAt in
+// the given IANA timezone, so wall-clock times survive DST changes.
+func ParseRecurrence(rule string, timezone string, startAt time.Time) (*rrule.RRule, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+	option, err := rrule.StrToROptionInLocation(rule, location)
+	if err != nil {
+		return nil, err
+	}
+	option.Dtstart = startAt.In(location)
+	return rrule.NewRRule(*option)
+}
+
+// NextOccurrence returns the first occurrence after the given time, or nil
+// when the rule is exhausted. inclusive also accepts an occurrence equal to after.
+func NextOccurrence(rule *rrule.RRule, after time.Time, inclusive bool) *time.Time {
+	next := rule.After(after, inclusive)
+	if next.IsZero() {
+		return nil
+	}
+	next = next.UTC()
+	return &next
+}
+
+// IdempotentID derives a stable identifier from an idempotency key so that
+// retrying the same write always targets the same primary key.
+func IdempotentID(key string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(key)).String()
+}
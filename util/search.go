@@ -0,0 +1,76 @@
+package util
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// maxSearchTerms bounds the number of terms taken from a search query.
+const maxSearchTerms = 10
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// SearchTerms splits a free text query into distinct lower case words. Any
+// other character, including full-text operators, only separates words.
+func SearchTerms(query string) (terms []string) {
+	seen := make(map[string]bool)
+	for _, term := range SearchWords(query) {
+		if seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+		if len(terms) == maxSearchTerms {
+			break
+		}
+	}
+	return terms
+}
+
+// SearchWords returns the lower case words of value.
+func SearchWords(value string) []string {
+	return strings.FieldsFunc(strings.ToLower(value), func(r rune) bool { return !isWordRune(r) })
+}
+
+// Highlight HTML-escapes value and wraps every word that starts with one of
+// terms in <mark> tags. It reports whether any word was marked.
+func Highlight(value string, terms []string) (string, bool) {
+	var builder strings.Builder
+	marked := false
+	runes := []rune(value)
+	for start := 0; start < len(runes); {
+		end := start + 1
+		if !isWordRune(runes[start]) {
+			for end < len(runes) && !isWordRune(runes[end]) {
+				end++
+			}
+			builder.WriteString(html.EscapeString(string(runes[start:end])))
+			start = end
+			continue
+		}
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		word := string(runes[start:end])
+		if matchesTerm(strings.ToLower(word), terms) {
+			marked = true
+			builder.WriteString("<mark>" + html.EscapeString(word) + "</mark>")
+		} else {
+			builder.WriteString(html.EscapeString(word))
+		}
+		start = end
+	}
+	return builder.String(), marked
+}
+
+func matchesTerm(word string, terms []string) bool {
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
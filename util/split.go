@@ -0,0 +1,63 @@
+package util
+
+import (
+	"math"
+	"sort"
+
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+// PercentToBasisPoints converts a percentage with up to two decimals into
+// hundredths of a percent.
+func PercentToBasisPoints(percent float64) int64 {
+	return int64(math.Round(percent * 100))
+}
+
+// SplitAmount divides total between the participants according to method.
+// Equal and percentage splits hand out the minor units lost to integer
+// division one by one, largest remainder first, so the shares always add up
+// to total exactly.
+func SplitAmount(total int64, method string, participants []web.TransactionSplitParticipant) []int64 {
+	shares := make([]int64, len(participants))
+	if len(participants) == 0 {
+		return shares
+	}
+
+	switch method {
+	case entity.SplitExact:
+		for i, participant := range participants {
+			shares[i] = participant.Amount
+		}
+		return shares
+	case entity.SplitPercent:
+		remainders := make([]int64, len(participants))
+		allocated := int64(0)
+		for i, participant := range participants {
+			portion := total * PercentToBasisPoints(participant.Percent)
+			shares[i] = portion / 10000
+			remainders[i] = portion % 10000
+			allocated += shares[i]
+		}
+		order := make([]int, len(participants))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return remainders[order[a]] > remainders[order[b]]
+		})
+		for i := int64(0); i < total-allocated; i++ {
+			shares[order[i%int64(len(order))]]++
+		}
+		return shares
+	default:
+		count := int64(len(participants))
+		for i := range shares {
+			shares[i] = total / count
+			if int64(i) < total%count {
+				shares[i]++
+			}
+		}
+		return shares
+	}
+}
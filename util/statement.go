@@ -0,0 +1,218 @@
+package util
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+// Statement dates carry no time of day, so entries are placed at noon UTC
+// which falls on the same calendar day in almost every timezone.
+const statementHour = 12
+
+var ofxTagPattern = regexp.MustCompile(`<(/?)([A-Za-z0-9.]+)>([^<]*)`)
+
+// ParseCSVStatement reads a CSV file with a header row. mapping names the
+// header of each field; empty names fall back to the field's own name.
+func ParseCSVStatement(r io.Reader, mapping model.StatementMapping, dateFormat string) ([]model.StatementEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("missing header row")
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	column := func(name string, fallback string) int {
+		if name == "" {
+			name = fallback
+		}
+		if i, ok := index[strings.ToLower(name)]; ok {
+			return i
+		}
+		return -1
+	}
+	nameColumn := column(mapping.Name, "name")
+	amountColumn := column(mapping.Amount, "amount")
+	dateColumn := column(mapping.Date, "date")
+	currencyColumn := column(mapping.Currency, "currency")
+	categoryColumn := column(mapping.CategoryID, "category_id")
+	if nameColumn < 0 || amountColumn < 0 || dateColumn < 0 {
+		return nil, errors.New("header must contain the name, amount and date columns")
+	}
+
+	var entries []model.StatementEntry
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		entry := model.StatementEntry{Row: row}
+		if err != nil {
+			entry.Error = err.Error()
+			entries = append(entries, entry)
+			continue
+		}
+		value := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+		entry.Name = value(nameColumn)
+		entry.Amount = value(amountColumn)
+		entry.Currency = strings.ToUpper(value(currencyColumn))
+		entry.CategoryID = value(categoryColumn)
+		entry.Date, err = parseStatementDate(value(dateColumn), dateFormat)
+		if err != nil {
+			entry.Error = "invalid date"
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ParseOFXStatement reads the STMTTRN records of an OFX file. Both the SGML
+// (OFX 1.x) and XML (OFX 2.x) variants are accepted.
+func ParseOFXStatement(r io.Reader) ([]model.StatementEntry, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(strings.ToUpper(string(content)), "<OFX>") {
+		return nil, errors.New("not an OFX file")
+	}
+
+	var entries []model.StatementEntry
+	var entry *model.StatementEntry
+	currency, memo := "", ""
+	for _, match := range ofxTagPattern.FindAllStringSubmatch(string(content), -1) {
+		closing, tag, value := match[1] == "/", strings.ToUpper(match[2]), strings.TrimSpace(match[3])
+		switch {
+		case tag == "CURDEF" && !closing:
+			currency = strings.ToUpper(value)
+		case tag == "STMTTRN" && !closing:
+			entry = &model.StatementEntry{Row: len(entries) + 1}
+			memo = ""
+		case tag == "STMTTRN" && closing && entry != nil:
+			if entry.Name == "" {
+				entry.Name = memo
+			}
+			if entry.Currency == "" {
+				entry.Currency = currency
+			}
+			if entry.Error == "" && entry.Date.IsZero() {
+				entry.Error = "missing date"
+			}
+			entries = append(entries, *entry)
+			entry = nil
+		case entry != nil && !closing:
+			switch tag {
+			case "FITID":
+				entry.ExternalID = value
+			case "TRNAMT":
+				entry.Amount = value
+			case "NAME":
+				entry.Name = value
+			case "MEMO":
+				memo = value
+			case "CURSYM":
+				entry.Currency = strings.ToUpper(value)
+			case "DTPOSTED":
+				date, err := parseOFXDate(value)
+				if err != nil {
+					entry.Error = "invalid date"
+				}
+				entry.Date = date
+			}
+		}
+	}
+	return entries, nil
+}
+
+// ParseQIFStatement reads a QIF file. Each record ends with a "^" line.
+func ParseQIFStatement(r io.Reader, dateFormat string) ([]model.StatementEntry, error) {
+	if dateFormat == "" {
+		dateFormat = "1/2/2006"
+	}
+	scanner := bufio.NewScanner(r)
+	var entries []model.StatementEntry
+	entry := model.StatementEntry{Row: 1}
+	started := false
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "!") {
+			continue
+		}
+		code, value := line[0], strings.TrimSpace(line[1:])
+		switch code {
+		case '^':
+			if started {
+				if entry.Error == "" && entry.Date.IsZero() {
+					entry.Error = "missing date"
+				}
+				entries = append(entries, entry)
+			}
+			entry = model.StatementEntry{Row: len(entries) + 1}
+			started = false
+			continue
+		case 'D':
+			date, err := parseStatementDate(strings.ReplaceAll(value, "'", "/"), dateFormat)
+			if err != nil {
+				entry.Error = "invalid date"
+			}
+			entry.Date = date
+		case 'T', 'U':
+			entry.Amount = value
+		case 'P':
+			entry.Name = value
+		case 'M':
+			if entry.Name == "" {
+				entry.Name = value
+			}
+		}
+		started = true
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if started {
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// parseStatementDate parses value with layout, also accepting a two digit
+// year, and returns noon UTC of that day.
+func parseStatementDate(value string, layout string) (time.Time, error) {
+	if layout == "" {
+		layout = "2006-01-02"
+	}
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		date, err = time.Parse(strings.Replace(layout, "2006", "06", 1), value)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), statementHour, 0, 0, 0, time.UTC), nil
+}
+
+// parseOFXDate reads the date part of an OFX datetime such as
+// "20230310120000.000[-5:EST]".
+func parseOFXDate(value string) (time.Time, error) {
+	if len(value) < 8 {
+		return time.Time{}, errors.New("invalid date")
+	}
+	return parseStatementDate(value[:8], "20060102")
+}
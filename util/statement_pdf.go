@@ -0,0 +1,170 @@
+package util
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/vnnyx/golang-dot-api/model"
+)
+
+const (
+	statementDateLayout = "02 Jan 2006"
+	statementRowHeight  = 6
+	statementMargin     = 15
+)
+
+// statementColumn is a column of a table on the statement; widths are in
+// millimetres and together span the printable width of an A4 page.
+type statementColumn struct {
+	title string
+	width float64
+	align string
+}
+
+var (
+	statementBalanceColumns = []statementColumn{
+		{title: "Currency", width: 30, align: "L"},
+		{title: "Opening balance", width: 50, align: "R"},
+		{title: "Spent this month", width: 50, align: "R"},
+		{title: "Closing balance", width: 50, align: "R"},
+	}
+	statementTransactionColumns = []statementColumn{
+		{title: "Date", width: 26, align: "L"},
+		{title: "Description", width: 66, align: "L"},
+		{title: "Category", width: 40, align: "L"},
+		{title: "Currency", width: 18, align: "L"},
+		{title: "Amount", width: 30, align: "R"},
+	}
+)
+
+// RenderStatementPDF writes the statement as an A4 PDF. It only uses the
+// core PDF fonts, so no font files are needed at runtime, and the output is
+// byte-for-byte reproducible for the same statement.
+func RenderStatementPDF(w io.Writer, statement model.MonthlyStatement) error {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(statementMargin, statementMargin, statementMargin)
+	pdf.SetAutoPageBreak(true, statementMargin+5)
+	pdf.SetCreationDate(statement.GeneratedAt)
+	pdf.SetModificationDate(statement.GeneratedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetCreator("golang-dot-api", true)
+	pdf.AliasNbPages("")
+
+	// Core fonts are encoded in cp1252, so anything outside it is replaced
+	// rather than rendered as mojibake.
+	text := pdf.UnicodeTranslatorFromDescriptor("")
+	title := fmt.Sprintf("Statement for %s", statement.Period.Format("January 2006"))
+	pdf.SetTitle(title, true)
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-statementMargin)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.CellFormat(90, 5, fmt.Sprintf("Generated %s UTC", statement.GeneratedAt.UTC().Format("2006-01-02 15:04")), "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, 5, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 16)
+	pdf.CellFormat(0, 10, text(title), "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.CellFormat(0, 5, text("Times are shown in "+statement.Timezone), "", 1, "L", false, 0, "")
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 7, "Account holder", "", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for _, field := range [][2]string{
+		{"Username", statement.Username},
+		{"Email", statement.Email},
+		{"Phone", statement.Handphone},
+		{"User ID", statement.UserID},
+	} {
+		pdf.CellFormat(30, statementRowHeight, field[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(0, statementRowHeight, text(field[1]), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 7, "Summary", "", 1, "L", false, 0, "")
+	writeStatementHeader(pdf, statementBalanceColumns)
+	pdf.SetFont("Helvetica", "", 10)
+	if len(statement.Balances) == 0 {
+		pdf.CellFormat(0, statementRowHeight, "No spending recorded yet.", "B", 1, "L", false, 0, "")
+	}
+	for _, balance := range statement.Balances {
+		writeStatementRow(pdf, statementBalanceColumns, []string{
+			balance.Currency,
+			FormatMinorUnits(balance.Opening, balance.Currency),
+			FormatMinorUnits(balance.Spent, balance.Currency),
+			FormatMinorUnits(balance.Closing, balance.Currency),
+		})
+	}
+	if statement.Total != nil {
+		pdf.SetFont("Helvetica", "B", 10)
+		writeStatementRow(pdf, statementBalanceColumns, []string{
+			"Total " + statement.Total.Currency,
+			FormatMinorUnits(statement.Total.Opening, statement.Total.Currency),
+			FormatMinorUnits(statement.Total.Spent, statement.Total.Currency),
+			FormatMinorUnits(statement.Total.Closing, statement.Total.Currency),
+		})
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.CellFormat(0, 5, fmt.Sprintf("Total converted at the exchange rates of %s.", statement.RatesDate.Format(statementDateLayout)), "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	pdf.SetFont("Helvetica", "B", 11)
+	pdf.CellFormat(0, 7, "Transactions", "", 1, "L", false, 0, "")
+	writeStatementHeader(pdf, statementTransactionColumns)
+	pdf.SetFont("Helvetica", "", 10)
+	if len(statement.Transactions) == 0 {
+		pdf.CellFormat(0, statementRowHeight, "No transactions in this period.", "B", 1, "L", false, 0, "")
+	}
+	_, pageHeight := pdf.GetPageSize()
+	for _, line := range statement.Transactions {
+		// Start a new page ourselves so the column titles are repeated on it.
+		if pdf.GetY()+statementRowHeight > pageHeight-statementMargin-5 {
+			pdf.AddPage()
+			writeStatementHeader(pdf, statementTransactionColumns)
+			pdf.SetFont("Helvetica", "", 10)
+		}
+		writeStatementRow(pdf, statementTransactionColumns, []string{
+			line.Date.Format(statementDateLayout),
+			fitCell(pdf, text(line.Name), statementTransactionColumns[1].width),
+			fitCell(pdf, text(line.Category), statementTransactionColumns[2].width),
+			line.Currency,
+			FormatMinorUnits(line.Amount, line.Currency),
+		})
+	}
+
+	return pdf.Output(w)
+}
+
+func writeStatementHeader(pdf *fpdf.Fpdf, columns []statementColumn) {
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.SetFillColor(230, 230, 230)
+	for _, column := range columns {
+		pdf.CellFormat(column.width, statementRowHeight+1, column.title, "B", 0, column.align, true, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+func writeStatementRow(pdf *fpdf.Fpdf, columns []statementColumn, values []string) {
+	for i, column := range columns {
+		pdf.CellFormat(column.width, statementRowHeight, values[i], "B", 0, column.align, false, 0, "")
+	}
+	pdf.Ln(-1)
+}
+
+// fitCell shortens value with an ellipsis until it fits in a cell of width.
+// value is already cp1252 encoded, so it is cut byte by byte.
+func fitCell(pdf *fpdf.Fpdf, value string, width float64) string {
+	const padding = 2
+	if pdf.GetStringWidth(value) <= width-padding {
+		return value
+	}
+	for len(value) > 0 && pdf.GetStringWidth(value+"...") > width-padding {
+		value = value[:len(value)-1]
+	}
+	return value + "..."
+}
@@ -0,0 +1,111 @@
+package util
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Export formats supported by NewTableWriter.
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// TableWriter writes a table row by row. Flush completes the output and
+// Close releases the writer's resources whether or not Flush was called.
+type TableWriter interface {
+	WriteRow(values []string) error
+	Flush() error
+	Close() error
+}
+
+// NewTableWriter returns a TableWriter producing format on w.
+func NewTableWriter(format string, w io.Writer) (TableWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvTableWriter{writer: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		file := excelize.NewFile()
+		stream, err := file.NewStreamWriter("Sheet1")
+		if err != nil {
+			return nil, err
+		}
+		return &xlsxTableWriter{file: file, stream: stream, out: w}, nil
+	}
+	return nil, errors.New("unsupported format " + format)
+}
+
+type csvTableWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvTableWriter) WriteRow(values []string) error {
+	escaped := make([]string, len(values))
+	for i, value := range values {
+		escaped[i] = escapeFormula(value)
+	}
+	return w.writer.Write(escaped)
+}
+
+// escapeFormula prefixes values a spreadsheet would run as a formula with a
+// quote so an opened CSV shows them as text.
+func escapeFormula(value string) string {
+	if value == "" {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	switch value[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + value
+	}
+	return value
+}
+
+func (w *csvTableWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvTableWriter) Close() error {
+	return nil
+}
+
+// xlsxTableWriter uses excelize's stream writer, which spills rows to a
+// temporary file instead of keeping the sheet in memory.
+type xlsxTableWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	out    io.Writer
+	rows   int
+}
+
+func (w *xlsxTableWriter) WriteRow(values []string) error {
+	w.rows++
+	cell, err := excelize.CoordinatesToCellName(1, w.rows)
+	if err != nil {
+		return err
+	}
+	row := make([]interface{}, len(values))
+	for i, value := range values {
+		row[i] = value
+	}
+	return w.stream.SetRow(cell, row)
+}
+
+func (w *xlsxTableWriter) Flush() error {
+	err := w.stream.Flush()
+	if err != nil {
+		return err
+	}
+	return w.file.Write(w.out)
+}
+
+func (w *xlsxTableWriter) Close() error {
+	return w.file.Close()
+}
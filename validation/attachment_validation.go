@@ -0,0 +1,23 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var attachmentContentTypes = []interface{}{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+	"application/pdf",
+}
+
+// UploadAttachmentValidation expects the sniffed content type rather than the
+// one declared by the client, which is trivially spoofed.
+func UploadAttachmentValidation(fileName string, contentType string, size int64, maxSize int64) error {
+	err := validator.Errors{
+		"file_name":    validator.Validate(fileName, validator.Required, validator.Length(1, 255)),
+		"content_type": validator.Validate(contentType, validator.In(attachmentContentTypes...)),
+		"size":         validator.Validate(size, validator.Required, validator.Max(maxSize)),
+	}.Filter()
+	return fieldErrors(err)
+}
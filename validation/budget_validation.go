@@ -0,0 +1,33 @@
+package validation
+
+import (
+	"time"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func CreateBudgetValidation(request web.BudgetCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.CategoryID, validator.Required),
+		validator.Field(&request.Amount, validator.Required, validator.Min(1)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)),
+		validator.Field(&request.Timezone, validator.By(timezoneRule)))
+	return fieldErrors(err)
+}
+
+func UpdateBudgetValidation(request web.BudgetUpdateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Amount, validator.Required, validator.Min(1)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)),
+		validator.Field(&request.Timezone, validator.By(timezoneRule)))
+	return fieldErrors(err)
+}
+
+func timezoneRule(value interface{}) error {
+	_, err := time.LoadLocation(value.(string))
+	if err != nil {
+		return ErrTimezoneInvalid
+	}
+	return nil
+}
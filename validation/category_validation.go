@@ -0,0 +1,46 @@
+package validation
+
+import (
+	"regexp"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func CreateCategoryValidation(request web.CategoryCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required, validator.Length(1, 50)))
+	return fieldErrors(err)
+}
+
+func UpdateCategoryValidation(request web.CategoryUpdateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required, validator.Length(1, 50)))
+	return fieldErrors(err)
+}
+
+func CreateCategoryRuleValidation(request web.CategoryRuleCreateRequest) error {
+	patternRules := []validator.Rule{validator.Required, validator.Length(1, 255)}
+	if request.MatchType == entity.RuleMatchRegex {
+		patternRules = append(patternRules, validator.By(func(value interface{}) error {
+			_, err := regexp.Compile(value.(string))
+			if err != nil {
+				return ErrRegexInvalid
+			}
+			return nil
+		}))
+	}
+
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.CategoryID, validator.Required),
+		validator.Field(&request.MatchType, validator.Required, validator.In(
+			entity.RuleMatchContains,
+			entity.RuleMatchPrefix,
+			entity.RuleMatchSuffix,
+			entity.RuleMatchExact,
+			entity.RuleMatchRegex,
+		)),
+		validator.Field(&request.Pattern, patternRules...))
+	return fieldErrors(err)
+}
@@ -0,0 +1,14 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+const CommentMaxLength = 2000
+
+func CommentValidation(request web.CommentRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Body, validator.Required, validator.RuneLength(1, CommentMaxLength)))
+	return fieldErrors(err)
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/entity"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+const (
+	DisputeDescriptionMaxLength = 1000
+	DisputeMaxEvidence          = 10
+)
+
+func DisputeCreateValidation(request web.DisputeCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.ReasonCode, validator.Required, validator.In(
+			entity.DisputeReasonDuplicate, entity.DisputeReasonFraudulent, entity.DisputeReasonNotReceived,
+			entity.DisputeReasonIncorrectAmount, entity.DisputeReasonCancelled, entity.DisputeReasonOther,
+		)),
+		validator.Field(&request.Description, validator.RuneLength(0, DisputeDescriptionMaxLength), validator.By(func(value interface{}) error {
+			if request.ReasonCode == entity.DisputeReasonOther && value.(string) == "" {
+				return ErrDescriptionRequired
+			}
+			return nil
+		})),
+		validator.Field(&request.EvidenceIDs, validator.Length(0, DisputeMaxEvidence), validator.Each(validator.Required)))
+	return fieldErrors(err)
+}
+
+func DisputeEvidenceValidation(request web.DisputeEvidenceRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.EvidenceIDs, validator.Required, validator.Length(1, DisputeMaxEvidence), validator.Each(validator.Required)))
+	return fieldErrors(err)
+}
+
+func DisputeListValidation(request web.DisputeListRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Status, validator.Each(validator.In(
+			entity.DisputeStatusOpen, entity.DisputeStatusUnderReview, entity.DisputeStatusWon, entity.DisputeStatusLost,
+		))))
+	return fieldErrors(err)
+}
+
+func DisputeResolveValidation(request web.DisputeResolveRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Outcome, validator.Required, validator.In(entity.DisputeStatusWon, entity.DisputeStatusLost)),
+		validator.Field(&request.ResolutionNote, validator.RuneLength(0, DisputeDescriptionMaxLength)))
+	return fieldErrors(err)
+}
@@ -0,0 +1,23 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+// ExportValidation checks the format and that every requested column is one
+// of the allowed columns.
+func ExportValidation(format string, columns []string, allowed []string) error {
+	in := make([]interface{}, len(allowed))
+	for i, column := range allowed {
+		in[i] = column
+	}
+	request := struct {
+		Format  string   `json:"format"`
+		Columns []string `json:"columns"`
+	}{format, columns}
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Format, validator.In(util.FormatCSV, util.FormatXLSX)),
+		validator.Field(&request.Columns, validator.Each(validator.In(in...))))
+	return fieldErrors(err)
+}
@@ -0,0 +1,25 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func ImportValidation(request web.ImportRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Format, validator.Required, validator.In(model.StatementCSV, model.StatementOFX, model.StatementQIF)),
+		validator.Field(&request.Content, validator.NotNil))
+	return fieldErrors(err)
+}
+
+// TransactionRowErrors runs CreateTransactionValidation on a single imported
+// row and returns its field errors instead of failing the whole import.
+func TransactionRowErrors(request web.TransactionCreateRequest) map[string]interface{} {
+	validationError, ok := CreateTransactionValidation(request).(exception.ValidationError)
+	if !ok {
+		return nil
+	}
+	return validationError.Fields()
+}
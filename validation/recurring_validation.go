@@ -0,0 +1,34 @@
+package validation
+
+import (
+	"time"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func CreateRecurringValidation(request web.RecurringCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required),
+		validator.Field(&request.Amount, validator.Min(0)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)),
+		validator.Field(&request.Tags, validator.Each(validator.Required, validator.Length(1, 50))),
+		validator.Field(&request.Timezone, validator.Required, validator.By(timezoneRule)),
+		validator.Field(&request.StartAt, validator.Required),
+		validator.Field(&request.RRule, validator.Required, validator.Length(1, 255), validator.By(func(value interface{}) error {
+			timezone := request.Timezone
+			if _, err := time.LoadLocation(timezone); err != nil {
+				timezone = "UTC"
+			}
+			rule, err := util.ParseRecurrence(value.(string), timezone, request.StartAt)
+			if err != nil {
+				return ErrRRuleInvalid
+			}
+			if util.NextOccurrence(rule, request.StartAt, true) == nil {
+				return ErrRRuleNoOccurrence
+			}
+			return nil
+		})))
+	return fieldErrors(err)
+}
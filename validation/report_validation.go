@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"time"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+const (
+	reportDateLayout = "2006-01-02"
+	// reportMaxDays bounds the date range so a single report cannot scan
+	// years of transactions.
+	reportMaxDays = 366
+)
+
+func ReportValidation(request web.ReportRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.From, validator.Required, validator.Date(reportDateLayout)),
+		validator.Field(&request.To, validator.Required, validator.Date(reportDateLayout), validator.By(func(value interface{}) error {
+			from, err := time.Parse(reportDateLayout, request.From)
+			if err != nil {
+				return nil
+			}
+			to, err := time.Parse(reportDateLayout, value.(string))
+			if err != nil {
+				return nil
+			}
+			if to.Before(from) {
+				return ErrDateBeforeFrom
+			}
+			if to.Sub(from) >= reportMaxDays*24*time.Hour {
+				return ErrDateRangeTooLong
+			}
+			return nil
+		})),
+		validator.Field(&request.Timezone, validator.By(timezoneRule)),
+		validator.Field(&request.GroupBy, validator.Each(validator.In(
+			model.ReportGroupDay, model.ReportGroupWeek, model.ReportGroupMonth,
+			model.ReportGroupCategory, model.ReportGroupCurrency, model.ReportGroupUser,
+		)), validator.By(reportGroupRule)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)))
+	return fieldErrors(err)
+}
+
+func reportGroupRule(value interface{}) error {
+	seen := make(map[string]bool)
+	periods := 0
+	for _, group := range value.([]string) {
+		if seen[group] {
+			return ErrGroupRepeated
+		}
+		seen[group] = true
+		switch group {
+		case model.ReportGroupDay, model.ReportGroupWeek, model.ReportGroupMonth:
+			periods++
+		}
+	}
+	if periods > 1 {
+		return ErrGroupPeriods
+	}
+	return nil
+}
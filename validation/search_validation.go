@@ -0,0 +1,24 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+// SearchMaxLimit is the most results returned per type.
+const SearchMaxLimit = 50
+
+func SearchValidation(request web.SearchRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Query, validator.Required, validator.Length(1, 100), validator.By(func(value interface{}) error {
+			if len(util.SearchTerms(value.(string))) == 0 {
+				return ErrSearchTermsRequired
+			}
+			return nil
+		})),
+		validator.Field(&request.Types, validator.Each(validator.In(model.SearchTypeTransaction, model.SearchTypeUser))),
+		validator.Field(&request.Limit, validator.Min(0), validator.Max(SearchMaxLimit)))
+	return fieldErrors(err)
+}
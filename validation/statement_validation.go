@@ -0,0 +1,15 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
+)
+
+func StatementValidation(request web.StatementRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Period, validator.Required, validator.Date(util.PeriodLayout)),
+		validator.Field(&request.Timezone, validator.By(timezoneRule)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)))
+	return fieldErrors(err)
+}
@@ -0,0 +1,12 @@
+package validation
+
+import (
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/web"
+)
+
+func CreateTagValidation(request web.TagCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required, validator.Length(1, 50)))
+	return fieldErrors(err)
+}
@@ -1,33 +1,113 @@
 package validation
 
 import (
-	"encoding/json"
+	"math"
+	"regexp"
 
-	validator "github.com/go-ozzo/ozzo-validation"
-	"github.com/vnnyx/golang-dot-api/exception"
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/util"
 )
 
-func CreateTransactionValidation(request web.TransactionCreateRequest) {
+var currencyPattern = regexp.MustCompile("^[A-Z]{3}$")
+
+// TransactionBatchMaxItems is the most items a single batch request may hold.
+const TransactionBatchMaxItems = 100
+
+func CreateTransactionValidation(request web.TransactionCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required),
+		validator.Field(&request.Amount, validator.Min(0)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)),
+		validator.Field(&request.Tags, validator.Each(validator.Required, validator.Length(1, 50))),
+		validator.Field(&request.Split, validator.By(splitRule(request.Amount))))
+	return fieldErrors(err)
+}
+
+func UpdateTransactionValidation(request web.TransactionUpdateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Name, validator.Required),
+		validator.Field(&request.Amount, validator.Min(0)),
+		validator.Field(&request.Currency, validator.Match(currencyPattern).ErrorObject(ErrCurrencyInvalid)),
+		validator.Field(&request.Tags, validator.Each(validator.Required, validator.Length(1, 50))),
+		validator.Field(&request.Split, validator.By(splitRule(request.Amount))))
+	return fieldErrors(err)
+}
+
+func TransactionBatchCreateValidation(request web.TransactionBatchCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Mode, validator.In(web.BatchModeAtomic, web.BatchModeBestEffort)),
+		validator.Field(&request.Items, validator.Required, validator.Length(1, TransactionBatchMaxItems)))
+	return fieldErrors(err)
+}
+
+func TransactionBatchUpdateValidation(request web.TransactionBatchUpdateRequest) error {
 	err := validator.ValidateStruct(&request,
-		validator.Field(&request.Name, validator.Required))
-	if err != nil {
-		b, _ := json.Marshal(err)
-		err = exception.ValidationError{
-			Message: string(b),
+		validator.Field(&request.Mode, validator.In(web.BatchModeAtomic, web.BatchModeBestEffort)),
+		validator.Field(&request.Items, validator.Required, validator.Length(1, TransactionBatchMaxItems)))
+	return fieldErrors(err)
+}
+
+func TransactionBatchDeleteValidation(request web.TransactionBatchDeleteRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Mode, validator.In(web.BatchModeAtomic, web.BatchModeBestEffort)),
+		validator.Field(&request.TransactionIDs, validator.Required, validator.Length(1, TransactionBatchMaxItems)))
+	return fieldErrors(err)
+}
+
+func splitRule(amount int64) validator.RuleFunc {
+	return func(value interface{}) error {
+		split, _ := value.(*web.TransactionSplitRequest)
+		if split == nil {
+			return nil
 		}
-		exception.PanicIfNeeded(err)
+		if amount <= 0 {
+			return ErrSplitAmountRequired
+		}
+		return validator.ValidateStruct(split,
+			validator.Field(&split.Method, validator.Required, validator.In(entity.SplitEqual, entity.SplitExact, entity.SplitPercent)),
+			validator.Field(&split.Participants, validator.Required, validator.Length(1, 50), validator.By(splitParticipantsRule(amount, split.Method))))
 	}
 }
 
-func UpdateTransactionValidation(request web.TransactionUpdateRequest) {
-	err := validator.ValidateStruct(&request,
-		validator.Field(&request.Name, validator.Required))
-	if err != nil {
-		b, _ := json.Marshal(err)
-		err = exception.ValidationError{
-			Message: string(b),
+func splitParticipantsRule(amount int64, method string) validator.RuleFunc {
+	return func(value interface{}) error {
+		participants := value.([]web.TransactionSplitParticipant)
+
+		seen := make(map[string]bool)
+		for _, participant := range participants {
+			if participant.UserID == "" || seen[participant.UserID] {
+				return ErrSplitParticipantInvalid
+			}
+			seen[participant.UserID] = true
+		}
+
+		switch method {
+		case entity.SplitExact:
+			sum := int64(0)
+			for _, participant := range participants {
+				if participant.Amount <= 0 {
+					return ErrSplitAmountInvalid
+				}
+				sum += participant.Amount
+			}
+			if sum != amount {
+				return ErrSplitAmountSum
+			}
+		case entity.SplitPercent:
+			sum := int64(0)
+			for _, participant := range participants {
+				basisPoints := util.PercentToBasisPoints(participant.Percent)
+				if basisPoints <= 0 || math.Abs(participant.Percent*100-float64(basisPoints)) > 1e-6 {
+					return ErrSplitPercentInvalid
+				}
+				sum += basisPoints
+			}
+			if sum != 10000 {
+				return ErrSplitPercentSum
+			}
 		}
-		exception.PanicIfNeeded(err)
+		return nil
 	}
 }
@@ -1,40 +1,112 @@
 package validation
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
+	"regexp"
 
-	validator "github.com/go-ozzo/ozzo-validation"
+	validator "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
-	"github.com/vnnyx/golang-dot-api/exception"
+	"github.com/vnnyx/golang-dot-api/i18n"
+	"github.com/vnnyx/golang-dot-api/model/entity"
 	"github.com/vnnyx/golang-dot-api/model/web"
+	"github.com/vnnyx/golang-dot-api/repository/user"
+	"gorm.io/gorm"
 )
 
-func CreateUserValidation(request web.UserCreateRequest) {
-	err := validator.ValidateStruct(&request,
-		validator.Field(&request.Username, validator.Required),
-		validator.Field(&request.Email, validator.Required, is.Email),
-		validator.Field(&request.Handphone, validator.Required, is.Digit),
-		validator.Field(&request.Password, validator.Required),
-		validator.Field(&request.PasswordConfirmation, validator.Required))
-	if err != nil {
-		b, _ := json.Marshal(err)
-		err = exception.ValidationError{
-			Message: string(b),
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+
+// PhoneNumber accepts phone numbers in E.164 format, such as +628123456789.
+var PhoneNumber = validator.Match(phonePattern).ErrorObject(ErrPhoneInvalid)
+
+// changedPhoneNumber only checks a phone number that differs from stored.
+// Numbers saved before E.164 was required then survive patches that leave
+// them untouched.
+func changedPhoneNumber(stored string) validator.Rule {
+	return validator.By(func(value interface{}) error {
+		handphone, _ := value.(string)
+		if handphone == stored {
+			return nil
 		}
-		exception.PanicIfNeeded(err)
+		return PhoneNumber.Validate(handphone)
+	})
+}
+
+// supportedLocale accepts the locales error messages can be translated to.
+var supportedLocale = validator.By(func(value interface{}) error {
+	locale, _ := value.(string)
+	if locale == "" || i18n.Supported(locale) {
+		return nil
 	}
+	return validator.ErrInInvalid
+})
+
+// UniqueUsername fails when a user other than userId already has the
+// username. userId is empty for new users.
+func UniqueUsername(ctx context.Context, userRepository user.UserRepository, userId string) validator.Rule {
+	return validator.By(func(value interface{}) error {
+		username, _ := value.(string)
+		if username == "" {
+			return nil
+		}
+		existing, err := userRepository.FindUserByUsername(ctx, username)
+		return uniqueUser(existing, err, userId)
+	})
 }
 
-func UpdateUserProfileValidation(request web.UserUpdateProfileRequest) {
-	err := validator.ValidateStruct(&request,
-		validator.Field(&request.Username, validator.Required),
-		validator.Field(&request.Email, validator.Required, is.Email),
-		validator.Field(&request.Handphone, validator.Required, is.Digit))
-	if err != nil {
-		b, _ := json.Marshal(err)
-		err = exception.ValidationError{
-			Message: string(b),
+// UniqueEmail fails when a user other than userId already has the email.
+// userId is empty for new users.
+func UniqueEmail(ctx context.Context, userRepository user.UserRepository, userId string) validator.Rule {
+	return validator.By(func(value interface{}) error {
+		email, _ := value.(string)
+		if email == "" {
+			return nil
 		}
-		exception.PanicIfNeeded(err)
+		existing, err := userRepository.FindUserByEmail(ctx, email)
+		return uniqueUser(existing, err, userId)
+	})
+}
+
+func uniqueUser(existing entity.User, err error, userId string) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
 	}
+	if err != nil {
+		return validator.NewInternalError(err)
+	}
+	if existing.UserID == userId {
+		return nil
+	}
+	return ErrUnique
+}
+
+func CreateUserValidation(ctx context.Context, userRepository user.UserRepository, request web.UserCreateRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Username, validator.Required, UniqueUsername(ctx, userRepository, "")),
+		validator.Field(&request.Email, validator.Required, is.Email, UniqueEmail(ctx, userRepository, "")),
+		validator.Field(&request.Handphone, validator.Required, PhoneNumber),
+		validator.Field(&request.Password, validator.Required),
+		validator.Field(&request.PasswordConfirmation, validator.Required),
+		validator.Field(&request.Locale, supportedLocale))
+	return fieldErrors(err)
+}
+
+func UpdateUserProfileValidation(ctx context.Context, userRepository user.UserRepository, request web.UserUpdateProfileRequest) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Username, validator.Required, UniqueUsername(ctx, userRepository, request.UserID)),
+		validator.Field(&request.Email, validator.Required, is.Email, UniqueEmail(ctx, userRepository, request.UserID)),
+		validator.Field(&request.Handphone, validator.Required, PhoneNumber),
+		validator.Field(&request.Locale, supportedLocale))
+	return fieldErrors(err)
+}
+
+// PatchUserProfileValidation validates the patched profile. storedHandphone
+// is the phone number before the patch.
+func PatchUserProfileValidation(ctx context.Context, userRepository user.UserRepository, request web.UserUpdateProfileRequest, storedHandphone string) error {
+	err := validator.ValidateStruct(&request,
+		validator.Field(&request.Username, validator.Required, UniqueUsername(ctx, userRepository, request.UserID)),
+		validator.Field(&request.Email, validator.Required, is.Email, UniqueEmail(ctx, userRepository, request.UserID)),
+		validator.Field(&request.Handphone, changedPhoneNumber(storedHandphone)),
+		validator.Field(&request.Locale, supportedLocale))
+	return fieldErrors(err)
 }
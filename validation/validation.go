@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"encoding/json"
+
+	validator "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/vnnyx/golang-dot-api/exception"
+)
+
+// The errors of the rules declared in this package. Like the built-in rules,
+// each has a code its message is translated by.
+var (
+	ErrUnique                  = validator.NewError("validation_unique", "must be unique")
+	ErrPhoneInvalid            = validator.NewError("validation_phone_invalid", "must be an E.164 phone number")
+	ErrCurrencyInvalid         = validator.NewError("validation_currency_invalid", "must be an ISO 4217 code")
+	ErrTimezoneInvalid         = validator.NewError("validation_timezone_invalid", "must be a valid IANA timezone")
+	ErrRegexInvalid            = validator.NewError("validation_regex_invalid", "must be a valid regular expression")
+	ErrRRuleInvalid            = validator.NewError("validation_rrule_invalid", "must be a valid RRULE")
+	ErrRRuleNoOccurrence       = validator.NewError("validation_rrule_no_occurrence", "must produce at least one occurrence")
+	ErrDateBeforeFrom          = validator.NewError("validation_date_before_from", "must not be before from")
+	ErrDateRangeTooLong        = validator.NewError("validation_date_range_too_long", "must be less than a year after from")
+	ErrGroupRepeated           = validator.NewError("validation_group_repeated", "must not repeat a key")
+	ErrGroupPeriods            = validator.NewError("validation_group_periods", "must contain at most one of day, week or month")
+	ErrDescriptionRequired     = validator.NewError("validation_description_required", "is required when the reason is other")
+	ErrSplitAmountRequired     = validator.NewError("validation_split_amount_required", "requires a positive amount")
+	ErrSplitParticipantInvalid = validator.NewError("validation_split_participant_invalid", "must have a unique, non blank user_id")
+	ErrSplitAmountInvalid      = validator.NewError("validation_split_amount_invalid", "amount must be greater than zero")
+	ErrSplitAmountSum          = validator.NewError("validation_split_amount_sum", "amounts must add up to the transaction amount")
+	ErrSplitPercentInvalid     = validator.NewError("validation_split_percent_invalid", "percent must be greater than zero with at most two decimals")
+	ErrSplitPercentSum         = validator.NewError("validation_split_percent_sum", "percentages must add up to 100")
+	ErrSearchTermsRequired     = validator.NewError("validation_search_terms_required", "must contain a letter or digit")
+	ErrFileTooLarge            = validator.NewError("validation_file_too_large", "must be at most {{.max}}MB")
+	ErrJSONObject              = validator.NewError("validation_json_object", "must be a JSON object")
+	ErrImportEmpty             = validator.NewError("validation_import_empty", "contains no transactions")
+	ErrImportTooLarge          = validator.NewError("validation_import_too_large", "must contain at most {{.max}} transactions")
+)
+
+// FieldError returns the validation error of a single field, for checks that
+// do not go through a validator such as reading an uploaded file.
+func FieldError(field string, err error) error {
+	return fieldErrors(validator.Errors{field: err})
+}
+
+// fieldErrors converts the result of validating a request into the error
+// validators return. A rule that could not run, such as a failed repository
+// lookup, is returned as its own cause, and anything else becomes an
+// exception.ValidationError with the message of each field.
+func fieldErrors(err error) error {
+	if err == nil {
+		return nil
+	}
+	if internalError, ok := err.(validator.InternalError); ok {
+		return internalError.InternalError()
+	}
+	b, _ := json.Marshal(err)
+	validationError := exception.ValidationError{
+		Message: string(b),
+	}
+	validationError.Errors, _ = err.(validator.Errors)
+	return validationError
+}